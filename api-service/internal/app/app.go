@@ -11,6 +11,7 @@ import (
 	"github.com/smarrog/task-board/api-service/internal/config"
 	"github.com/smarrog/task-board/api-service/internal/middleware"
 	"github.com/smarrog/task-board/api-service/internal/transport/http"
+	authv1 "github.com/smarrog/task-board/shared/proto/auth/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -34,7 +35,13 @@ func New(cfg *config.Config, log *zerolog.Logger) *App {
 }
 
 func (a *App) Init() error {
-	conn, err := grpc.NewClient(a.cfg.CoreGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// запас сверх размера вложения — на остальные поля сообщения
+	maxMsgSize := a.cfg.AttachmentMaxSize + 1<<20
+	conn, err := grpc.NewClient(
+		a.cfg.CoreGRPCAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxMsgSize), grpc.MaxCallRecvMsgSize(maxMsgSize)),
+	)
 	if err != nil {
 		return err
 	}
@@ -54,6 +61,8 @@ func (a *App) Init() error {
 		IdleTimeout:  a.cfg.FiberIdleTimeout,
 		ReadTimeout:  a.cfg.FiberReadTimeout,
 		WriteTimeout: a.cfg.FiberWriteTimeout,
+		ProxyHeader:  a.cfg.FiberProxyHeader, // например X-Forwarded-For, если gateway стоит за балансировщиком
+		BodyLimit:    maxMsgSize,
 	})
 
 	app.Get("/healthz", func(c *fiber.Ctx) error { return c.SendString("ok") })
@@ -69,8 +78,19 @@ func (a *App) Init() error {
 
 	v1.Post("/auth/register", authHandler.Register)
 	v1.Post("/auth/login", authHandler.Login)
+	v1.Post("/auth/2fa/verify", authHandler.VerifySecondFactor)
+	v1.Get("/auth/oidc/login", authHandler.OidcLogin)
+	v1.Get("/auth/oidc/callback", authHandler.OidcCallback)
 
-	protected := v1.Group("", middleware.JWT(a.cfg.JWTSecret))
+	tokens := middleware.NewTokenIntrospector(authv1.NewAuthServiceClient(a.authConn), a.cfg.TokenCacheTTL, a.cfg.RequestTimeout)
+	protected := v1.Group("", middleware.JWT(a.cfg.JWTSecret, tokens))
+
+	protected.Post("/me/2fa/totp", middleware.SessionOnly(), authHandler.EnrollTotp)
+	protected.Post("/me/2fa/totp/confirm", middleware.SessionOnly(), authHandler.ConfirmTotp)
+
+	protected.Post("/me/tokens", middleware.SessionOnly(), authHandler.CreateToken)
+	protected.Get("/me/tokens", middleware.SessionOnly(), authHandler.ListTokens)
+	protected.Delete("/me/tokens/:tokenId", middleware.SessionOnly(), authHandler.RevokeToken)
 
 	handler.Register(protected)
 
@@ -22,6 +22,11 @@ type Config struct {
 	FiberIdleTimeout  time.Duration
 	FiberReadTimeout  time.Duration
 	FiberWriteTimeout time.Duration
+	FiberProxyHeader  string
+
+	TokenCacheTTL time.Duration
+
+	AttachmentMaxSize int
 }
 
 func Load() *Config {
@@ -39,6 +44,12 @@ func Load() *Config {
 		FiberIdleTimeout:  env.GetDuration("FIBER_IDLE_TIMEOUT", 30*time.Second),
 		FiberReadTimeout:  env.GetDuration("FIBER_READ_TIMEOUT", 30*time.Second),
 		FiberWriteTimeout: env.GetDuration("FIBER_WRITE_TIMEOUT", 30*time.Second),
+		FiberProxyHeader:  env.GetString("FIBER_PROXY_HEADER", ""),
+
+		TokenCacheTTL: env.GetDuration("TOKEN_CACHE_TTL", 30*time.Second),
+
+		// должен совпадать с ATTACHMENT_MAX_SIZE в core-service
+		AttachmentMaxSize: env.GetInt("ATTACHMENT_MAX_SIZE", 10<<20),
 	}
 
 	return cfg
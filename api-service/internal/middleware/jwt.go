@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
@@ -8,10 +9,27 @@ import (
 )
 
 const (
-	LocalUserID = "user_id"
+	LocalUserID   = "user_id"
+	LocalAuthKind = "auth_kind"
 )
 
-func JWT(secret string) fiber.Handler {
+const (
+	AuthKindJWT   = "jwt"
+	AuthKindToken = "token"
+)
+
+const (
+	personalTokenPrefix = "tbp_"
+	scopeWrite          = "write"
+)
+
+type Introspector interface {
+	Introspect(ctx context.Context, token string) (*TokenInfo, error)
+}
+
+// JWT пропускает запросы с JWT, а если передан tokens — ещё и с персональными токенами.
+// Токен только со scope read может лишь читать (GET/HEAD).
+func JWT(secret string, tokens Introspector) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		auth := c.Get("Authorization")
 		if auth == "" || !strings.HasPrefix(strings.ToLower(auth), "bearer ") {
@@ -22,6 +40,10 @@ func JWT(secret string) fiber.Handler {
 
 		raw := strings.TrimSpace(auth[len("Bearer "):])
 
+		if tokens != nil && strings.HasPrefix(raw, personalTokenPrefix) {
+			return personalToken(c, tokens, raw)
+		}
+
 		tok, err := jwt.Parse(raw, func(t *jwt.Token) (any, error) {
 			if t.Method.Alg() != jwt.SigningMethodHS256.Alg() {
 				return nil, fiber.NewError(fiber.StatusUnauthorized, "unexpected_jwt_alg")
@@ -52,6 +74,47 @@ func JWT(secret string) fiber.Handler {
 		}
 
 		c.Locals(LocalUserID, sub)
+		c.Locals(LocalAuthKind, AuthKindJWT)
 		return c.Next()
 	}
 }
+
+// SessionOnly закрывает маршрут от персональных токенов: например, токеном нельзя выпустить новый токен.
+func SessionOnly() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Locals(LocalAuthKind) != AuthKindJWT {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "session_required",
+			})
+		}
+		return c.Next()
+	}
+}
+
+func personalToken(c *fiber.Ctx, tokens Introspector, raw string) error {
+	info, err := tokens.Introspect(c.Context(), raw)
+	if err != nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "token_introspection_failed",
+		})
+	}
+	if !info.Active || info.UserId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "invalid_token",
+		})
+	}
+
+	if !isReadOnlyMethod(c.Method()) && !info.HasScope(scopeWrite) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "insufficient_scope",
+		})
+	}
+
+	c.Locals(LocalUserID, info.UserId)
+	c.Locals(LocalAuthKind, AuthKindToken)
+	return c.Next()
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == fiber.MethodGet || method == fiber.MethodHead
+}
@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	authv1 "github.com/smarrog/task-board/shared/proto/auth/v1"
+)
+
+// maxCachedTokens — при переполнении кэш просто чистим от протухших записей, а если не помогло — целиком.
+const maxCachedTokens = 10_000
+
+type TokenInfo struct {
+	Active bool
+	UserId string
+	Scopes []string
+}
+
+func (t *TokenInfo) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type tokenCacheEntry struct {
+	info      *TokenInfo
+	expiresAt time.Time
+}
+
+// TokenIntrospector проверяет персональные токены через auth-service и кэширует ответ на ttl,
+// поэтому отзыв токена доходит до gateway с задержкой не больше ttl.
+type TokenIntrospector struct {
+	auth    authv1.AuthServiceClient
+	ttl     time.Duration
+	timeout time.Duration
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]tokenCacheEntry
+}
+
+func NewTokenIntrospector(auth authv1.AuthServiceClient, ttl time.Duration, timeout time.Duration) *TokenIntrospector {
+	return &TokenIntrospector{
+		auth:    auth,
+		ttl:     ttl,
+		timeout: timeout,
+		cache:   make(map[[sha256.Size]byte]tokenCacheEntry),
+	}
+}
+
+func (i *TokenIntrospector) Introspect(ctx context.Context, token string) (*TokenInfo, error) {
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+
+	if info, ok := i.get(key, now); ok {
+		return info, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, i.timeout)
+	defer cancel()
+
+	resp, err := i.auth.IntrospectToken(ctx, &authv1.IntrospectTokenRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	info := &TokenInfo{
+		Active: resp.GetActive(),
+		UserId: resp.GetUserId(),
+		Scopes: resp.GetScopes(),
+	}
+
+	expiresAt := now.Add(i.ttl)
+	if resp.GetExpiresAt() != nil && resp.GetExpiresAt().AsTime().Before(expiresAt) {
+		expiresAt = resp.GetExpiresAt().AsTime()
+	}
+	i.put(key, tokenCacheEntry{info: info, expiresAt: expiresAt}, now)
+
+	return info, nil
+}
+
+func (i *TokenIntrospector) get(key [sha256.Size]byte, now time.Time) (*TokenInfo, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	e, ok := i.cache[key]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(e.expiresAt) {
+		delete(i.cache, key)
+		return nil, false
+	}
+	return e.info, true
+}
+
+func (i *TokenIntrospector) put(key [sha256.Size]byte, e tokenCacheEntry, now time.Time) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if len(i.cache) >= maxCachedTokens {
+		for k, v := range i.cache {
+			if !now.Before(v.expiresAt) {
+				delete(i.cache, k)
+			}
+		}
+		if len(i.cache) >= maxCachedTokens {
+			clear(i.cache)
+		}
+	}
+	i.cache[key] = e
+}
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"io"
+	"mime"
+	nethttp "net/http"
+
+	"github.com/gofiber/fiber/v2"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+)
+
+func (h *Handler) UploadAttachment(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "file_required")
+	}
+	if fh.Size > int64(h.cfg.AttachmentMaxSize) {
+		return fiber.NewError(fiber.StatusRequestEntityTooLarge, "file_too_large")
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_file")
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_file")
+	}
+
+	// клиенты часто не указывают тип — определяем по содержимому
+	contentType := fh.Header.Get("Content-Type")
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType = nethttp.DetectContentType(content)
+	}
+
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.attachments.UploadAttachment(ctx, &v1.UploadAttachmentRequest{
+		Base:        &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId:      taskId,
+		FileName:    fh.Filename,
+		ContentType: contentType,
+		Content:     content,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(buildAttachmentDTO(resp.GetAttachment()))
+}
+
+func (h *Handler) ListAttachments(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.attachments.ListAttachments(ctx, &v1.ListAttachmentsRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId: taskId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	items := make([]AttachmentDTO, 0, len(resp.GetAttachments()))
+	for _, a := range resp.GetAttachments() {
+		items = append(items, buildAttachmentDTO(a))
+	}
+	return c.JSON(fiber.Map{"items": items})
+}
+
+func (h *Handler) DownloadAttachment(c *fiber.Ctx) error {
+	attachmentId := c.Params("attachmentId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.attachments.DownloadAttachment(ctx, &v1.DownloadAttachmentRequest{
+		Base:         &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		AttachmentId: attachmentId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	a := resp.GetAttachment()
+	c.Set(fiber.HeaderContentType, a.GetContentType())
+	c.Set(fiber.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": a.GetFileName()}))
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	return c.Send(resp.GetContent())
+}
+
+func (h *Handler) DeleteAttachment(c *fiber.Ctx) error {
+	attachmentId := c.Params("attachmentId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.attachments.DeleteAttachment(ctx, &v1.DeleteAttachmentRequest{
+		Base:         &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		AttachmentId: attachmentId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func buildAttachmentDTO(a *v1.Attachment) AttachmentDTO {
+	return AttachmentDTO{
+		Id:          a.GetId(),
+		TaskId:      a.GetTaskId(),
+		UploaderId:  a.GetUploaderId(),
+		FileName:    a.GetFileName(),
+		ContentType: a.GetContentType(),
+		Size:        a.GetSize(),
+		CreatedAt:   timeFromPb(a.GetCreatedAt()),
+	}
+}
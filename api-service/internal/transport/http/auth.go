@@ -2,14 +2,22 @@ package http
 
 import (
 	"context"
+	"math"
+	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog"
 	"github.com/smarrog/task-board/api-service/internal/config"
+	"github.com/smarrog/task-board/api-service/internal/middleware"
 	authv1 "github.com/smarrog/task-board/shared/proto/auth/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const clientIpMetadataKey = "x-client-ip"
+
 type AuthHandler struct {
 	log  *zerolog.Logger
 	cfg  *config.Config
@@ -65,12 +73,136 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	}
 	ctx, cancel := h.reqCtxFromCfg()
 	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, clientIpMetadataKey, c.IP())
 
 	resp, err := h.auth.Login(ctx, &authv1.LoginRequest{Email: body.Email, Password: body.Password})
+	if err != nil {
+		setRetryAfter(c, err)
+		return grpcToHTTP(err)
+	}
+
+	if resp.GetSecondFactorRequired() {
+		return c.JSON(fiber.Map{
+			"second_factor_required": true,
+			"challenge_id":           resp.GetChallengeId(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"user": fiber.Map{
+			"id":       resp.GetUser().GetId(),
+			"email":    resp.GetUser().GetEmail(),
+			"username": resp.GetUser().GetUsername(),
+		},
+		"access_token": resp.GetAccessToken(),
+	})
+}
+
+type verifySecondFactorBody struct {
+	ChallengeId string `json:"challenge_id"`
+	Code        string `json:"code"`
+}
+
+func (h *AuthHandler) VerifySecondFactor(c *fiber.Ctx) error {
+	var body verifySecondFactorBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtxFromCfg()
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, clientIpMetadataKey, c.IP())
+
+	resp, err := h.auth.VerifySecondFactor(ctx, &authv1.VerifySecondFactorRequest{
+		ChallengeId: body.ChallengeId,
+		Code:        body.Code,
+	})
+	if err != nil {
+		setRetryAfter(c, err)
+		return grpcToHTTP(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"user": fiber.Map{
+			"id":       resp.GetUser().GetId(),
+			"email":    resp.GetUser().GetEmail(),
+			"username": resp.GetUser().GetUsername(),
+		},
+		"access_token": resp.GetAccessToken(),
+	})
+}
+
+func (h *AuthHandler) EnrollTotp(c *fiber.Ctx) error {
+	ctx, cancel := h.reqCtxFromCfg()
+	defer cancel()
+
+	resp, err := h.auth.EnrollTotp(ctx, &authv1.EnrollTotpRequest{UserId: h.requesterID(c)})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"secret":      resp.GetSecret(),
+		"otpauth_uri": resp.GetOtpauthUri(),
+	})
+}
+
+type confirmTotpBody struct {
+	Code string `json:"code"`
+}
+
+func (h *AuthHandler) ConfirmTotp(c *fiber.Ctx) error {
+	var body confirmTotpBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtxFromCfg()
+	defer cancel()
+
+	resp, err := h.auth.ConfirmTotp(ctx, &authv1.ConfirmTotpRequest{UserId: h.requesterID(c), Code: body.Code})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"recovery_codes": resp.GetRecoveryCodes(),
+	})
+}
+
+// OidcLogin отправляет браузер на страницу входа провайдера.
+func (h *AuthHandler) OidcLogin(c *fiber.Ctx) error {
+	ctx, cancel := h.reqCtxFromCfg()
+	defer cancel()
+
+	resp, err := h.auth.StartOidcLogin(ctx, &authv1.StartOidcLoginRequest{})
 	if err != nil {
 		return grpcToHTTP(err)
 	}
 
+	return c.Redirect(resp.GetAuthorizationUrl(), fiber.StatusFound)
+}
+
+func (h *AuthHandler) OidcCallback(c *fiber.Ctx) error {
+	if e := c.Query("error"); e != "" {
+		return fiber.NewError(fiber.StatusBadRequest, e)
+	}
+	ctx, cancel := h.reqCtxFromCfg()
+	defer cancel()
+
+	resp, err := h.auth.FinishOidcLogin(ctx, &authv1.FinishOidcLoginRequest{
+		State: c.Query("state"),
+		Code:  c.Query("code"),
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	if resp.GetSecondFactorRequired() {
+		return c.JSON(fiber.Map{
+			"second_factor_required": true,
+			"challenge_id":           resp.GetChallengeId(),
+		})
+	}
+
 	return c.JSON(fiber.Map{
 		"user": fiber.Map{
 			"id":       resp.GetUser().GetId(),
@@ -81,6 +213,99 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	})
 }
 
+type createTokenBody struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int32    `json:"expires_in_days"`
+}
+
+func (h *AuthHandler) CreateToken(c *fiber.Ctx) error {
+	var body createTokenBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtxFromCfg()
+	defer cancel()
+
+	resp, err := h.auth.CreateToken(ctx, &authv1.CreateTokenRequest{
+		UserId:        h.requesterID(c),
+		Name:          body.Name,
+		Scopes:        body.Scopes,
+		ExpiresInDays: body.ExpiresInDays,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	out := toTokenDTO(resp.GetToken())
+	out["token"] = resp.GetSecret()
+	return c.Status(fiber.StatusCreated).JSON(out)
+}
+
+func (h *AuthHandler) ListTokens(c *fiber.Ctx) error {
+	ctx, cancel := h.reqCtxFromCfg()
+	defer cancel()
+
+	resp, err := h.auth.ListTokens(ctx, &authv1.ListTokensRequest{UserId: h.requesterID(c)})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	items := make([]fiber.Map, 0, len(resp.GetTokens()))
+	for _, t := range resp.GetTokens() {
+		items = append(items, toTokenDTO(t))
+	}
+	return c.JSON(items)
+}
+
+func (h *AuthHandler) RevokeToken(c *fiber.Ctx) error {
+	ctx, cancel := h.reqCtxFromCfg()
+	defer cancel()
+
+	_, err := h.auth.RevokeToken(ctx, &authv1.RevokeTokenRequest{
+		UserId:  h.requesterID(c),
+		TokenId: c.Params("tokenId"),
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func toTokenDTO(t *authv1.PersonalAccessToken) fiber.Map {
+	return fiber.Map{
+		"id":           t.GetId(),
+		"name":         t.GetName(),
+		"scopes":       t.GetScopes(),
+		"created_at":   formatTimestamp(t.GetCreatedAt()),
+		"expires_at":   formatTimestamp(t.GetExpiresAt()),
+		"last_used_at": formatTimestamp(t.GetLastUsedAt()),
+	}
+}
+
+// formatTimestamp возвращает nil для незаданного времени, чтобы в JSON был null.
+func formatTimestamp(ts *timestamppb.Timestamp) any {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+func setRetryAfter(c *fiber.Ctx, err error) {
+	if delay, ok := grpcRetryDelay(err); ok {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+	}
+}
+
+func (h *AuthHandler) requesterID(c *fiber.Ctx) string {
+	v := c.Locals(middleware.LocalUserID)
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return ""
+}
+
 func (h *AuthHandler) reqCtxFromCfg() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), h.cfg.RequestTimeout)
 }
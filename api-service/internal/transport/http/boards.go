@@ -1,14 +1,18 @@
 package http
 
 import (
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type createBoardBody struct {
 	OwnerId     string `json:"owner_id"`
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	TemplateId  string `json:"template_id"`
 }
 
 func (h *Handler) CreateBoard(c *fiber.Ctx) error {
@@ -24,6 +28,7 @@ func (h *Handler) CreateBoard(c *fiber.Ctx) error {
 		OwnerId:     body.OwnerId,
 		Title:       body.Title,
 		Description: body.Description,
+		TemplateId:  body.TemplateId,
 	})
 	if err != nil {
 		return grpcToHTTP(err)
@@ -38,9 +43,17 @@ func (h *Handler) GetBoard(c *fiber.Ctx) error {
 	ctx, cancel := h.reqCtx(c)
 	defer cancel()
 
+	var labelIds []string
+	for _, raw := range strings.Split(c.Query("labels"), ",") {
+		if raw = strings.TrimSpace(raw); raw != "" {
+			labelIds = append(labelIds, raw)
+		}
+	}
+
 	resp, err := h.boards.GetBoard(ctx, &v1.GetBoardRequest{
-		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
-		BoardId: boardID,
+		Base:     &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId:  boardID,
+		LabelIds: labelIds,
 	})
 	if err != nil {
 		return grpcToHTTP(err)
@@ -50,25 +63,54 @@ func (h *Handler) GetBoard(c *fiber.Ctx) error {
 	return c.JSON(boardDTO)
 }
 
+// listAllBoardsPageSize — размер страницы, которой собираем весь список для старых клиентов.
+const listAllBoardsPageSize = 200
+
+// ListBoards без page_size и cursor отвечает, как раньше, массивом всех досок;
+// постраничный ответ {items, next_cursor} включается любым из этих параметров.
 func (h *Handler) ListBoards(c *fiber.Ctx) error {
+	pageSize, err := queryPageSize(c)
+	if err != nil {
+		return err
+	}
+	cursor := c.Query("cursor")
+	paged := c.Query("page_size") != "" || cursor != ""
+	if !paged {
+		pageSize = listAllBoardsPageSize
+	}
+
 	ctx, cancel := h.reqCtx(c)
 	defer cancel()
 
 	ownerID := h.requesterID(c)
-	resp, err := h.boards.ListBoards(ctx, &v1.ListBoardsRequest{
-		Base:    &v1.BaseRequest{RequesterId: ownerID},
-		OwnerId: ownerID,
-	})
-	if err != nil {
-		return grpcToHTTP(err)
-	}
+	out := make([]BoardDTO, 0)
+	for {
+		resp, err := h.boards.ListBoards(ctx, &v1.ListBoardsRequest{
+			Base:     &v1.BaseRequest{RequesterId: ownerID},
+			OwnerId:  ownerID,
+			PageSize: pageSize,
+			Cursor:   cursor,
+			Sort:     c.Query("sort"),
+			Search:   c.Query("q"),
+			Summary:  c.QueryBool("summary"),
+
+			IncludeArchived: c.QueryBool("include_archived"),
+		})
+		if err != nil {
+			return grpcToHTTP(err)
+		}
 
-	boards := resp.GetBoards()
-	out := make([]BoardDTO, 0, len(boards))
-	for _, b := range boards {
-		out = append(out, buildBoardDTO(b))
+		for _, b := range resp.GetBoards() {
+			out = append(out, buildBoardDTO(b))
+		}
+		if paged {
+			return c.JSON(BoardsPageDTO{Items: out, NextCursor: resp.GetNextCursor()})
+		}
+		cursor = resp.GetNextCursor()
+		if cursor == "" {
+			return c.JSON(out)
+		}
 	}
-	return c.JSON(out)
 }
 
 type updateBoardBody struct {
@@ -83,6 +125,10 @@ func (h *Handler) UpdateBoard(c *fiber.Ctx) error {
 	if err := c.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
 	}
+	ifMatch, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := h.reqCtx(c)
 	defer cancel()
@@ -93,6 +139,8 @@ func (h *Handler) UpdateBoard(c *fiber.Ctx) error {
 		OwnerId:     h.requesterID(c),
 		Title:       body.Title,
 		Description: body.Description,
+
+		ExpectedVersion: ifMatch,
 	})
 	if err != nil {
 		return grpcToHTTP(err)
@@ -102,6 +150,58 @@ func (h *Handler) UpdateBoard(c *fiber.Ctx) error {
 	return c.JSON(boardDTO)
 }
 
+type patchBoardBody struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+}
+
+// PatchBoard меняет только присланные поля; PUT по-прежнему заменяет доску целиком.
+func (h *Handler) PatchBoard(c *fiber.Ctx) error {
+	boardID := c.Params("boardId")
+
+	var body patchBoardBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ifMatch, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	req := &v1.UpdateBoardRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardID,
+		OwnerId: h.requesterID(c),
+
+		ExpectedVersion: ifMatch,
+	}
+	var paths []string
+	if body.Title != nil {
+		req.Title = *body.Title
+		paths = append(paths, "title")
+	}
+	if body.Description != nil {
+		req.Description = *body.Description
+		paths = append(paths, "description")
+	}
+	// пустая маска означала бы замену всех полей
+	if len(paths) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "empty_update")
+	}
+	req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.boards.UpdateBoard(ctx, req)
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	boardDTO := buildBoardDTO(resp.GetData())
+	return c.JSON(boardDTO)
+}
+
 func (h *Handler) DeleteBoard(c *fiber.Ctx) error {
 	boardID := c.Params("boardId")
 	ctx, cancel := h.reqCtx(c)
@@ -117,6 +217,128 @@ func (h *Handler) DeleteBoard(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+func (h *Handler) RestoreBoard(c *fiber.Ctx) error {
+	boardID := c.Params("boardId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.boards.RestoreBoard(ctx, &v1.RestoreBoardRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardID,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	boardDTO := buildBoardDTO(resp.GetData())
+	return c.JSON(boardDTO)
+}
+
+type duplicateBoardBody struct {
+	Title        string `json:"title"`
+	IncludeTasks bool   `json:"include_tasks"`
+}
+
+func (h *Handler) DuplicateBoard(c *fiber.Ctx) error {
+	boardID := c.Params("boardId")
+
+	// тело необязательно: без него копируются только колонки и метки
+	var body duplicateBoardBody
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&body); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+		}
+	}
+
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.boards.DuplicateBoard(ctx, &v1.DuplicateBoardRequest{
+		Base:         &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId:      boardID,
+		OwnerId:      h.requesterID(c),
+		Title:        body.Title,
+		IncludeTasks: body.IncludeTasks,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	boardDTO := buildBoardDTO(resp.GetData())
+	return c.Status(fiber.StatusCreated).JSON(boardDTO)
+}
+
+func (h *Handler) ArchiveBoard(c *fiber.Ctx) error {
+	boardID := c.Params("boardId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.boards.ArchiveBoard(ctx, &v1.ArchiveBoardRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardID,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	boardDTO := buildBoardDTO(resp.GetData())
+	return c.JSON(boardDTO)
+}
+
+func (h *Handler) UnarchiveBoard(c *fiber.Ctx) error {
+	boardID := c.Params("boardId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.boards.UnarchiveBoard(ctx, &v1.UnarchiveBoardRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardID,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	boardDTO := buildBoardDTO(resp.GetData())
+	return c.JSON(boardDTO)
+}
+
+func (h *Handler) ListTrash(c *fiber.Ctx) error {
+	boardID := c.Params("boardId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.boards.ListTrash(ctx, &v1.ListTrashRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardID,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	out := TrashDTO{
+		BoardDeletedAt: timeFromPb(resp.GetBoardDeletedAt()),
+		Columns:        make([]TrashedColumnDTO, 0, len(resp.GetColumns())),
+		Tasks:          make([]TrashedTaskDTO, 0, len(resp.GetTasks())),
+	}
+	for _, tc := range resp.GetColumns() {
+		col := tc.GetColumn()
+		out.Columns = append(out.Columns, TrashedColumnDTO{
+			Id:        col.GetId(),
+			BoardId:   col.GetBoardId(),
+			Position:  col.GetPosition(),
+			Title:     col.GetTitle(),
+			DeletedAt: timeFromPb(tc.GetDeletedAt()),
+		})
+	}
+	for _, tt := range resp.GetTasks() {
+		out.Tasks = append(out.Tasks, TrashedTaskDTO{
+			Task:      buildTaskDTO(tt.GetTask()),
+			DeletedAt: timeFromPb(tt.GetDeletedAt()),
+		})
+	}
+	return c.JSON(out)
+}
+
 func buildBoardDTO(full *v1.BoardFull) BoardDTO {
 	b := full.GetBoard()
 	cols := make([]ColumnDTO, 0, len(full.GetColumns()))
@@ -124,20 +346,16 @@ func buildBoardDTO(full *v1.BoardFull) BoardDTO {
 		c := cwt.GetColumn()
 		tasks := make([]TaskDTO, 0, len(cwt.GetTasks()))
 		for _, t := range cwt.GetTasks() {
-			tasks = append(tasks, TaskDTO{
-				Id:          t.GetId(),
-				ColumnId:    t.GetColumnId(),
-				Position:    t.GetPosition(),
-				Title:       t.GetTitle(),
-				Description: t.GetDescription(),
-				AssigneeId:  t.GetAssigneeId(),
-			})
+			tasks = append(tasks, buildTaskDTO(t))
 		}
 		cols = append(cols, ColumnDTO{
 			Id:       c.GetId(),
 			BoardId:  c.GetBoardId(),
 			Position: c.GetPosition(),
+			Title:    c.GetTitle(),
+			Version:  c.GetVersion(),
 			Tasks:    tasks,
+			Stats:    buildColumnStatsDTO(cwt.GetStats()),
 		})
 	}
 
@@ -146,6 +364,9 @@ func buildBoardDTO(full *v1.BoardFull) BoardDTO {
 		OwnerId:     b.GetOwnerId(),
 		Title:       b.GetTitle(),
 		Description: b.GetDescription(),
+		ArchivedAt:  timeFromPb(b.GetArchivedAt()),
+		Version:     b.GetVersion(),
 		Columns:     cols,
+		Labels:      buildLabelDTOs(full.GetLabels()),
 	}
 }
@@ -0,0 +1,89 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// bulkTaskOperationBody — операция пачки; поля берутся те же, что у одиночных запросов.
+type bulkTaskOperationBody struct {
+	Op     string `json:"op"` // move, update, delete или assign
+	TaskId string `json:"task_id"`
+	moveTaskBody
+	updateTaskBody
+}
+
+type bulkTasksBody struct {
+	Operations []bulkTaskOperationBody `json:"operations"`
+	BestEffort bool                    `json:"best_effort"`
+}
+
+func (h *Handler) BulkTasks(c *fiber.Ctx) error {
+	var body bulkTasksBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	ops := make([]*v1.BulkTaskOperation, 0, len(body.Operations))
+	for _, op := range body.Operations {
+		switch op.Op {
+		case "move":
+			ops = append(ops, &v1.BulkTaskOperation{Op: &v1.BulkTaskOperation_Move{Move: &v1.MoveTaskRequest{
+				TaskId:     op.TaskId,
+				ToColumnId: op.ToColumnID,
+				ToPosition: op.ToPosition,
+			}}})
+		case "update":
+			req, err := updateTaskRequest(op.TaskId, op.updateTaskBody)
+			if err != nil {
+				return err
+			}
+			ops = append(ops, &v1.BulkTaskOperation{Op: &v1.BulkTaskOperation_Update{Update: req}})
+		case "delete":
+			ops = append(ops, &v1.BulkTaskOperation{Op: &v1.BulkTaskOperation_Delete{Delete: &v1.DeleteTaskRequest{
+				TaskId: op.TaskId,
+			}}})
+		case "assign":
+			var assigneeId string
+			if op.AssigneeId != nil {
+				assigneeId = *op.AssigneeId
+			}
+			ops = append(ops, &v1.BulkTaskOperation{Op: &v1.BulkTaskOperation_Assign{Assign: &v1.AssignTaskOperation{
+				TaskId:     op.TaskId,
+				AssigneeId: assigneeId,
+			}}})
+		default:
+			return fiber.NewError(fiber.StatusBadRequest, "invalid_operation")
+		}
+	}
+
+	resp, err := h.tasks.BulkTasks(ctx, &v1.BulkTasksRequest{
+		Base:       &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		Operations: ops,
+		BestEffort: body.BestEffort,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	out := BulkTasksDTO{Ok: true, Results: make([]BulkTaskResultDTO, 0, len(resp.GetResults()))}
+	for _, r := range resp.GetResults() {
+		res := BulkTaskResultDTO{
+			Index:  r.GetIndex(),
+			Status: httpStatus(codes.Code(r.GetCode())),
+			Error:  r.GetError(),
+		}
+		if r.GetCode() != int32(codes.OK) {
+			out.Ok = false
+		}
+		if r.GetTask() != nil {
+			t := buildTaskDTO(r.GetTask())
+			res.Task = &t
+		}
+		out.Results = append(out.Results, res)
+	}
+	return c.JSON(out)
+}
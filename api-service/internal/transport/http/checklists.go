@@ -0,0 +1,167 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+)
+
+type createChecklistItemBody struct {
+	Text     string `json:"text"`
+	Position *int32 `json:"position"`
+}
+
+func (h *Handler) CreateChecklistItem(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	var body createChecklistItemBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	// без позиции пункт добавляется в конец
+	position := int32(-1)
+	if body.Position != nil {
+		if *body.Position < 0 {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid_position")
+		}
+		position = *body.Position
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.checklists.CreateChecklistItem(ctx, &v1.CreateChecklistItemRequest{
+		Base:     &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId:   taskId,
+		Text:     body.Text,
+		Position: position,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(buildChecklistItemDTO(resp.GetItem()))
+}
+
+func (h *Handler) ListChecklistItems(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.checklists.ListChecklistItems(ctx, &v1.ListChecklistItemsRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId: taskId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	items := make([]ChecklistItemDTO, 0, len(resp.GetItems()))
+	for _, it := range resp.GetItems() {
+		items = append(items, buildChecklistItemDTO(it))
+	}
+	return c.JSON(ChecklistDTO{
+		Items: items,
+		Progress: ChecklistProgressDTO{
+			Done:  resp.GetProgress().GetDone(),
+			Total: resp.GetProgress().GetTotal(),
+		},
+	})
+}
+
+type updateChecklistItemBody struct {
+	Text string `json:"text"`
+}
+
+func (h *Handler) UpdateChecklistItem(c *fiber.Ctx) error {
+	itemId := c.Params("itemId")
+	var body updateChecklistItemBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.checklists.UpdateChecklistItem(ctx, &v1.UpdateChecklistItemRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		ItemId: itemId,
+		Text:   body.Text,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.JSON(buildChecklistItemDTO(resp.GetItem()))
+}
+
+type setChecklistItemDoneBody struct {
+	Done bool `json:"done"`
+}
+
+func (h *Handler) SetChecklistItemDone(c *fiber.Ctx) error {
+	itemId := c.Params("itemId")
+	var body setChecklistItemDoneBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.checklists.SetChecklistItemDone(ctx, &v1.SetChecklistItemDoneRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		ItemId: itemId,
+		Done:   body.Done,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.JSON(buildChecklistItemDTO(resp.GetItem()))
+}
+
+type moveChecklistItemBody struct {
+	ToPosition int32 `json:"to_position"`
+}
+
+func (h *Handler) MoveChecklistItem(c *fiber.Ctx) error {
+	itemId := c.Params("itemId")
+	var body moveChecklistItemBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.checklists.MoveChecklistItem(ctx, &v1.MoveChecklistItemRequest{
+		Base:       &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		ItemId:     itemId,
+		ToPosition: body.ToPosition,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.JSON(buildChecklistItemDTO(resp.GetItem()))
+}
+
+func (h *Handler) DeleteChecklistItem(c *fiber.Ctx) error {
+	itemId := c.Params("itemId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.checklists.DeleteChecklistItem(ctx, &v1.DeleteChecklistItemRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		ItemId: itemId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func buildChecklistItemDTO(i *v1.ChecklistItem) ChecklistItemDTO {
+	return ChecklistItemDTO{
+		Id:       i.GetId(),
+		TaskId:   i.GetTaskId(),
+		Position: i.GetPosition(),
+		Text:     i.GetText(),
+		Done:     i.GetDone(),
+	}
+}
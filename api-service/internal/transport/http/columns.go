@@ -6,7 +6,8 @@ import (
 )
 
 type createColumnBody struct {
-	Position int32 `json:"position"`
+	Position int32  `json:"position"`
+	Title    string `json:"title"`
 }
 
 func (h *Handler) CreateColumn(c *fiber.Ctx) error {
@@ -22,16 +23,20 @@ func (h *Handler) CreateColumn(c *fiber.Ctx) error {
 		Base:     &v1.BaseRequest{RequesterId: h.requesterID(c)},
 		BoardId:  boardId,
 		Position: body.Position,
+		Title:    body.Title,
 	})
 	if err != nil {
 		return grpcToHTTP(err)
 	}
 
 	col := resp.GetColumn()
+	setETag(c, col.GetVersion())
 	return c.Status(fiber.StatusCreated).JSON(ColumnDTO{
 		Id:       col.GetId(),
 		BoardId:  col.GetBoardId(),
 		Position: col.GetPosition(),
+		Title:    col.GetTitle(),
+		Version:  col.GetVersion(),
 		Tasks:    []TaskDTO{},
 	})
 }
@@ -55,6 +60,8 @@ func (h *Handler) GetColumn(c *fiber.Ctx) error {
 
 type moveColumnBody struct {
 	ToPosition int32 `json:"to_position"`
+	// ToBoardId — необязательная доска, куда колонка переезжает вместе с задачами.
+	ToBoardId string `json:"to_board_id"`
 }
 
 func (h *Handler) MoveColumn(c *fiber.Ctx) error {
@@ -63,13 +70,21 @@ func (h *Handler) MoveColumn(c *fiber.Ctx) error {
 	if err := c.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
 	}
+	ifMatch, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := h.reqCtx(c)
 	defer cancel()
 
-	_, err := h.columns.MoveColumn(ctx, &v1.MoveColumnRequest{
+	_, err = h.columns.MoveColumn(ctx, &v1.MoveColumnRequest{
 		Base:       &v1.BaseRequest{RequesterId: h.requesterID(c)},
 		ColumnId:   columnId,
 		ToPosition: body.ToPosition,
+		ToBoardId:  body.ToBoardId,
+
+		ExpectedVersion: ifMatch,
 	})
 	if err != nil {
 		return grpcToHTTP(err)
@@ -87,6 +102,41 @@ func (h *Handler) MoveColumn(c *fiber.Ctx) error {
 	return c.JSON(columnDTO)
 }
 
+type reorderColumnsBody struct {
+	// ColumnIds — все колонки доски в новом порядке, каждая ровно один раз.
+	ColumnIds []string `json:"column_ids"`
+}
+
+func (h *Handler) ReorderColumns(c *fiber.Ctx) error {
+	boardId := c.Params("boardId")
+	var body reorderColumnsBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.columns.ReorderColumns(ctx, &v1.ReorderColumnsRequest{
+		Base:      &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId:   boardId,
+		ColumnIds: body.ColumnIds,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	resp, err := h.boards.GetBoard(ctx, &v1.GetBoardRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	boardDTO := buildBoardDTO(resp.GetData())
+	return c.JSON(boardDTO)
+}
+
 func (h *Handler) DeleteColumn(c *fiber.Ctx) error {
 	columnId := c.Params("columnId")
 	ctx, cancel := h.reqCtx(c)
@@ -102,24 +152,56 @@ func (h *Handler) DeleteColumn(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
+func (h *Handler) RestoreColumn(c *fiber.Ctx) error {
+	columnId := c.Params("columnId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.columns.RestoreColumn(ctx, &v1.RestoreColumnRequest{
+		Base:     &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		ColumnId: columnId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	columnDTO := toColumnDTO(resp.GetData())
+	return c.JSON(columnDTO)
+}
+
 func toColumnDTO(full *v1.ColumnFull) ColumnDTO {
 	col := full.GetColumn()
 	tasks := make([]TaskDTO, 0, len(full.GetTasks()))
 	for _, t := range full.GetTasks() {
-		tasks = append(tasks, TaskDTO{
-			Id:          t.GetId(),
-			ColumnId:    t.GetColumnId(),
-			Position:    t.GetPosition(),
-			Title:       t.GetTitle(),
-			Description: t.GetDescription(),
-			AssigneeId:  t.GetAssigneeId(),
-		})
+		tasks = append(tasks, buildTaskDTO(t))
 	}
 
 	return ColumnDTO{
 		Id:       col.GetId(),
 		BoardId:  col.GetBoardId(),
 		Position: col.GetPosition(),
+		Title:    col.GetTitle(),
+		Version:  col.GetVersion(),
 		Tasks:    tasks,
+		Stats:    buildColumnStatsDTO(full.GetStats()),
+	}
+}
+
+func buildColumnStatsDTO(s *v1.ColumnStats) *ColumnStatsDTO {
+	if s == nil {
+		return nil
+	}
+	byPriority := make(map[string]int32, len(s.GetByPriority()))
+	for _, pc := range s.GetByPriority() {
+		name := "none"
+		if p := priorityFromPb(pc.GetPriority()); p != nil {
+			name = *p
+		}
+		byPriority[name] += pc.GetCount()
+	}
+	return &ColumnStatsDTO{
+		TaskCount:     s.GetTaskCount(),
+		TotalEstimate: s.GetTotalEstimate(),
+		ByPriority:    byPriority,
 	}
 }
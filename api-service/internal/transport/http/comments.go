@@ -0,0 +1,106 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+)
+
+type commentBody struct {
+	Body string `json:"body"`
+}
+
+func (h *Handler) CreateComment(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	var body commentBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.comments.CreateComment(ctx, &v1.CreateCommentRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId: taskId,
+		Body:   body.Body,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(buildCommentDTO(resp.GetComment()))
+}
+
+func (h *Handler) ListComments(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	pageSize, err := queryPageSize(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.comments.ListComments(ctx, &v1.ListCommentsRequest{
+		Base:     &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId:   taskId,
+		PageSize: pageSize,
+		Cursor:   c.Query("cursor"),
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	items := make([]CommentDTO, 0, len(resp.GetComments()))
+	for _, cm := range resp.GetComments() {
+		items = append(items, buildCommentDTO(cm))
+	}
+	return c.JSON(CommentsPageDTO{Items: items, NextCursor: resp.GetNextCursor()})
+}
+
+func (h *Handler) UpdateComment(c *fiber.Ctx) error {
+	commentId := c.Params("commentId")
+	var body commentBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.comments.UpdateComment(ctx, &v1.UpdateCommentRequest{
+		Base:      &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		CommentId: commentId,
+		Body:      body.Body,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.JSON(buildCommentDTO(resp.GetComment()))
+}
+
+func (h *Handler) DeleteComment(c *fiber.Ctx) error {
+	commentId := c.Params("commentId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.comments.DeleteComment(ctx, &v1.DeleteCommentRequest{
+		Base:      &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		CommentId: commentId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func buildCommentDTO(cm *v1.Comment) CommentDTO {
+	return CommentDTO{
+		Id:         cm.GetId(),
+		TaskId:     cm.GetTaskId(),
+		AuthorId:   cm.GetAuthorId(),
+		Body:       cm.GetBody(),
+		MentionIds: nonNilStrings(cm.GetMentionIds()),
+		CreatedAt:  timeFromPb(cm.GetCreatedAt()),
+		UpdatedAt:  timeFromPb(cm.GetUpdatedAt()),
+	}
+}
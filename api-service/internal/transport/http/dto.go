@@ -1,25 +1,199 @@
 package http
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type BoardDTO struct {
-	Id          string      `json:"id"`
-	OwnerId     string      `json:"owner_id"`
-	Title       string      `json:"title"`
-	Description string      `json:"description"`
-	Columns     []ColumnDTO `json:"columns"`
+	Id          string     `json:"id"`
+	OwnerId     string     `json:"owner_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	ArchivedAt  *time.Time `json:"archived_at"`
+	// Version — версия самой доски для If-Match; правки колонок и задач её не меняют.
+	Version int64       `json:"version"`
+	Columns []ColumnDTO `json:"columns"`
+	Labels  []LabelDTO  `json:"labels"`
+}
+
+type BoardsPageDTO struct {
+	Items      []BoardDTO `json:"items"`
+	NextCursor string     `json:"next_cursor,omitempty"`
 }
 
 type ColumnDTO struct {
-	Id       string    `json:"id"`
-	BoardId  string    `json:"board_id"`
-	Position int32     `json:"position"`
-	Tasks    []TaskDTO `json:"tasks"`
+	Id       string `json:"id"`
+	BoardId  string `json:"board_id"`
+	Position int32  `json:"position"`
+	Title    string `json:"title"`
+	// Version — версия самой колонки для If-Match; правки задач её не меняют.
+	Version int64     `json:"version"`
+	Tasks   []TaskDTO `json:"tasks"`
+	// Stats приходит вместе с задачами колонки.
+	Stats *ColumnStatsDTO `json:"stats,omitempty"`
+}
+
+type ColumnStatsDTO struct {
+	TaskCount     int32   `json:"task_count"`
+	TotalEstimate float64 `json:"total_estimate"`
+	// ByPriority — число задач по приоритетам; задачи без приоритета под ключом "none".
+	ByPriority map[string]int32 `json:"by_priority"`
 }
 
 type TaskDTO struct {
-	Id          string `json:"id"`
-	ColumnId    string `json:"column_id"`
-	Position    int32  `json:"position"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	AssigneeId  string `json:"assignee_id"`
+	Id          string     `json:"id"`
+	ColumnId    string     `json:"column_id"`
+	Position    int32      `json:"position"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	AssigneeId  string     `json:"assignee_id"`
+	StartAt     *time.Time `json:"start_at"`
+	DueAt       *time.Time `json:"due_at"`
+	Priority    *string    `json:"priority"`
+	Estimate    *float64   `json:"estimate"`
+	LabelIds    []string   `json:"label_ids"`
+	// Checklist — прогресс чек-листа; приходит только в составе доски.
+	Checklist *ChecklistProgressDTO `json:"checklist,omitempty"`
+	// AttachmentsCount заполняется в составе доски и в GET /tasks/:taskId.
+	AttachmentsCount int32 `json:"attachments_count"`
+	// ArchivedAt задан у задачи из архива; в составе доски таких задач нет.
+	ArchivedAt *time.Time `json:"archived_at"`
+	// Version — то же, что ETag в ответах на запросы к самой задаче.
+	Version int64 `json:"version"`
+}
+
+type ChecklistProgressDTO struct {
+	Done  int32 `json:"done"`
+	Total int32 `json:"total"`
+}
+
+type ChecklistItemDTO struct {
+	Id       string `json:"id"`
+	TaskId   string `json:"task_id"`
+	Position int32  `json:"position"`
+	Text     string `json:"text"`
+	Done     bool   `json:"done"`
+}
+
+type ChecklistDTO struct {
+	Items    []ChecklistItemDTO   `json:"items"`
+	Progress ChecklistProgressDTO `json:"progress"`
+}
+
+type LabelDTO struct {
+	Id      string `json:"id"`
+	BoardId string `json:"board_id"`
+	Name    string `json:"name"`
+	Color   string `json:"color"`
+}
+
+type BulkTaskResultDTO struct {
+	Index  int32    `json:"index"`
+	Status int      `json:"status"`
+	Error  string   `json:"error,omitempty"`
+	Task   *TaskDTO `json:"task,omitempty"`
+}
+
+// BulkTasksDTO — итог пачки; Ok — все операции выполнены.
+type BulkTasksDTO struct {
+	Ok      bool                `json:"ok"`
+	Results []BulkTaskResultDTO `json:"results"`
+}
+
+type TemplateLabelDTO struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type TemplateDTO struct {
+	Id string `json:"id"`
+	// OwnerId пустой у встроенных шаблонов.
+	OwnerId     string             `json:"owner_id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Columns     []string           `json:"columns"`
+	Labels      []TemplateLabelDTO `json:"labels"`
+}
+
+type CommentDTO struct {
+	Id         string     `json:"id"`
+	TaskId     string     `json:"task_id"`
+	AuthorId   string     `json:"author_id"`
+	Body       string     `json:"body"`
+	MentionIds []string   `json:"mention_ids"`
+	CreatedAt  *time.Time `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+}
+
+type CommentsPageDTO struct {
+	Items      []CommentDTO `json:"items"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
+
+type AttachmentDTO struct {
+	Id          string     `json:"id"`
+	TaskId      string     `json:"task_id"`
+	UploaderId  string     `json:"uploader_id"`
+	FileName    string     `json:"file_name"`
+	ContentType string     `json:"content_type"`
+	Size        int64      `json:"size"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+type WatchersDTO struct {
+	UserIds []string `json:"user_ids"`
+}
+
+type AssignedTaskDTO struct {
+	Task           TaskDTO `json:"task"`
+	BoardId        string  `json:"board_id"`
+	BoardTitle     string  `json:"board_title"`
+	ColumnPosition int32   `json:"column_position"`
+}
+
+type AssignedTaskGroupDTO struct {
+	BoardId    string            `json:"board_id"`
+	BoardTitle string            `json:"board_title"`
+	Tasks      []AssignedTaskDTO `json:"tasks"`
+}
+
+type TrashedColumnDTO struct {
+	Id        string     `json:"id"`
+	BoardId   string     `json:"board_id"`
+	Position  int32      `json:"position"`
+	Title     string     `json:"title"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+type TrashedTaskDTO struct {
+	Task      TaskDTO    `json:"task"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+type TrashDTO struct {
+	// BoardDeletedAt задан, если в корзине вся доска; тогда восстанавливать нужно её.
+	BoardDeletedAt *time.Time         `json:"board_deleted_at,omitempty"`
+	Columns        []TrashedColumnDTO `json:"columns"`
+	Tasks          []TrashedTaskDTO   `json:"tasks"`
+}
+
+// nullable отличает отсутствующее в теле поле (Set=false) от явного null.
+type nullable[T any] struct {
+	Set   bool
+	Value *T
+}
+
+func (n *nullable[T]) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	if string(b) == "null" {
+		n.Value = nil
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	n.Value = &v
+	return nil
 }
@@ -1,7 +1,10 @@
 package http
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -14,21 +17,60 @@ func grpcToHTTP(err error) error {
 	if !ok {
 		return fiber.NewError(fiber.StatusInternalServerError, "internal_error")
 	}
+	if isStaleVersion(st) {
+		return fiber.NewError(fiber.StatusPreconditionFailed, st.Message())
+	}
+	return fiber.NewError(httpStatus(st.Code()), st.Message())
+}
+
+// isStaleVersion — ядро отклонило запись по версии из If-Match: PreconditionFailure в деталях
+// отличает это от гонки двух записей, которая остаётся 409.
+func isStaleVersion(st *status.Status) bool {
+	if st.Code() != codes.Aborted {
+		return false
+	}
+	for _, d := range st.Details() {
+		if _, ok := d.(*errdetails.PreconditionFailure); ok {
+			return true
+		}
+	}
+	return false
+}
 
-	switch st.Code() {
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return fiber.StatusOK
 	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
-		return fiber.NewError(fiber.StatusBadRequest, st.Message())
+		return fiber.StatusBadRequest
 	case codes.NotFound:
-		return fiber.NewError(fiber.StatusNotFound, st.Message())
-	case codes.AlreadyExists:
-		return fiber.NewError(fiber.StatusConflict, st.Message())
+		return fiber.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return fiber.StatusConflict
 	case codes.Unauthenticated:
-		return fiber.NewError(fiber.StatusUnauthorized, st.Message())
+		return fiber.StatusUnauthorized
 	case codes.PermissionDenied:
-		return fiber.NewError(fiber.StatusForbidden, st.Message())
+		return fiber.StatusForbidden
+	case codes.ResourceExhausted:
+		return fiber.StatusTooManyRequests
+	case codes.Unimplemented:
+		return fiber.StatusNotImplemented
 	default:
-		return fiber.NewError(fiber.StatusInternalServerError, st.Message())
+		return fiber.StatusInternalServerError
+	}
+}
+
+func grpcRetryDelay(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0, false
+	}
+	for _, d := range st.Details() {
+		if ri, ok := d.(*errdetails.RetryInfo); ok {
+			return ri.GetRetryDelay().AsDuration(), true
+		}
 	}
+	return 0, false
 }
 
 func NotImplemented(msg string) fiber.Handler {
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// setETag отдаёт версию ресурса сильным ETag: "<version>". Доске с колонками и колонке
+// с задачами ETag не ставится: их содержимое меняется без смены версии, и клиент получил
+// бы ложный 304 или совпадение If-Match. Для If-Match у них берётся version из тела.
+func setETag(c *fiber.Ctx, version int64) {
+	if version > 0 {
+		c.Set(fiber.HeaderETag, `"`+strconv.FormatInt(version, 10)+`"`)
+	}
+}
+
+// ifMatchVersion разбирает If-Match в ожидаемую версию; 0 — заголовка нет или он "*".
+// Поддерживается один ETag; слабый ETag по правилам If-Match не совпадает никогда.
+func ifMatchVersion(c *fiber.Ctx) (int64, error) {
+	raw := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if raw == "" || raw == "*" {
+		return 0, nil
+	}
+	if strings.HasPrefix(raw, "W/") {
+		return 0, fiber.NewError(fiber.StatusPreconditionFailed, "version_mismatch")
+	}
+	if len(raw) < 2 || raw[0] != '"' || raw[len(raw)-1] != '"' {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "invalid_if_match")
+	}
+	v, err := strconv.ParseInt(raw[1:len(raw)-1], 10, 64)
+	if err != nil || v <= 0 {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "invalid_if_match")
+	}
+	return v, nil
+}
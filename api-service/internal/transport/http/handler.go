@@ -2,6 +2,8 @@ package http
 
 import (
 	"context"
+	"math"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/rs/zerolog"
@@ -15,18 +17,30 @@ type Handler struct {
 	log *zerolog.Logger
 	cfg *config.Config
 
-	boards  v1.BoardsServiceClient
-	columns v1.ColumnsServiceClient
-	tasks   v1.TasksServiceClient
+	boards      v1.BoardsServiceClient
+	columns     v1.ColumnsServiceClient
+	tasks       v1.TasksServiceClient
+	labels      v1.LabelsServiceClient
+	checklists  v1.ChecklistsServiceClient
+	comments    v1.CommentsServiceClient
+	watchers    v1.WatchersServiceClient
+	attachments v1.AttachmentsServiceClient
+	templates   v1.TemplatesServiceClient
 }
 
 func NewHandler(log *zerolog.Logger, cfg *config.Config, coreConn *grpc.ClientConn) *Handler {
 	return &Handler{
-		log:     log,
-		cfg:     cfg,
-		boards:  v1.NewBoardsServiceClient(coreConn),
-		columns: v1.NewColumnsServiceClient(coreConn),
-		tasks:   v1.NewTasksServiceClient(coreConn),
+		log:         log,
+		cfg:         cfg,
+		boards:      v1.NewBoardsServiceClient(coreConn),
+		columns:     v1.NewColumnsServiceClient(coreConn),
+		tasks:       v1.NewTasksServiceClient(coreConn),
+		labels:      v1.NewLabelsServiceClient(coreConn),
+		checklists:  v1.NewChecklistsServiceClient(coreConn),
+		comments:    v1.NewCommentsServiceClient(coreConn),
+		watchers:    v1.NewWatchersServiceClient(coreConn),
+		attachments: v1.NewAttachmentsServiceClient(coreConn),
+		templates:   v1.NewTemplatesServiceClient(coreConn),
 	}
 }
 
@@ -41,3 +55,15 @@ func (h *Handler) requesterID(c *fiber.Ctx) string {
 func (h *Handler) reqCtx(c *fiber.Ctx) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(c.Context(), h.cfg.RequestTimeout)
 }
+
+func queryPageSize(c *fiber.Ctx) (int32, error) {
+	raw := c.Query("page_size")
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil || v < 0 {
+		return 0, fiber.NewError(fiber.StatusBadRequest, "invalid_page_size")
+	}
+	return int32(min(v, math.MaxInt32)), nil
+}
@@ -0,0 +1,137 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+)
+
+type labelBody struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+func (h *Handler) CreateLabel(c *fiber.Ctx) error {
+	boardId := c.Params("boardId")
+	var body labelBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.labels.CreateLabel(ctx, &v1.CreateLabelRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardId,
+		Name:    body.Name,
+		Color:   body.Color,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(buildLabelDTO(resp.GetLabel()))
+}
+
+func (h *Handler) ListLabels(c *fiber.Ctx) error {
+	boardId := c.Params("boardId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.labels.ListLabels(ctx, &v1.ListLabelsRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.JSON(fiber.Map{"items": buildLabelDTOs(resp.GetLabels())})
+}
+
+func (h *Handler) UpdateLabel(c *fiber.Ctx) error {
+	labelId := c.Params("labelId")
+	var body labelBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.labels.UpdateLabel(ctx, &v1.UpdateLabelRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		LabelId: labelId,
+		Name:    body.Name,
+		Color:   body.Color,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.JSON(buildLabelDTO(resp.GetLabel()))
+}
+
+func (h *Handler) DeleteLabel(c *fiber.Ctx) error {
+	labelId := c.Params("labelId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.labels.DeleteLabel(ctx, &v1.DeleteLabelRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		LabelId: labelId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+type setTaskLabelsBody struct {
+	LabelIds []string `json:"label_ids"`
+}
+
+func (h *Handler) SetTaskLabels(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	var body setTaskLabelsBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ifMatch, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.tasks.SetTaskLabels(ctx, &v1.SetTaskLabelsRequest{
+		Base:     &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId:   taskId,
+		LabelIds: body.LabelIds,
+
+		ExpectedVersion: ifMatch,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	t := resp.GetTask()
+	setETag(c, t.GetVersion())
+	return c.JSON(buildTaskDTO(t))
+}
+
+func buildLabelDTO(l *v1.Label) LabelDTO {
+	return LabelDTO{
+		Id:      l.GetId(),
+		BoardId: l.GetBoardId(),
+		Name:    l.GetName(),
+		Color:   l.GetColor(),
+	}
+}
+
+func buildLabelDTOs(ls []*v1.Label) []LabelDTO {
+	out := make([]LabelDTO, 0, len(ls))
+	for _, l := range ls {
+		out = append(out, buildLabelDTO(l))
+	}
+	return out
+}
@@ -8,18 +8,76 @@ func (h *Handler) Register(r fiber.Router) {
 	r.Get("/boards", h.ListBoards)
 	r.Get("/boards/:boardId", h.GetBoard)
 	r.Put("/boards/:boardId", h.UpdateBoard)
+	r.Patch("/boards/:boardId", h.PatchBoard)
 	r.Delete("/boards/:boardId", h.DeleteBoard)
+	r.Post("/boards/:boardId/restore", h.RestoreBoard)
+	r.Get("/boards/:boardId/trash", h.ListTrash)
+	r.Post("/boards/:boardId/archive", h.ArchiveBoard)
+	r.Post("/boards/:boardId/unarchive", h.UnarchiveBoard)
+	r.Post("/boards/:boardId/duplicate", h.DuplicateBoard)
 
 	// Columns
 	r.Post("/boards/:boardId/columns", h.CreateColumn)
+	r.Put("/boards/:boardId/columns/order", h.ReorderColumns)
 	r.Get("/columns/:columnId", h.GetColumn)
 	r.Post("/columns/:columnId/move", h.MoveColumn)
 	r.Delete("/columns/:columnId", h.DeleteColumn)
+	r.Post("/columns/:columnId/restore", h.RestoreColumn)
 
 	// Tasks
 	r.Post("/columns/:columnId/tasks", h.CreateTask)
 	r.Get("/tasks/:taskId", h.GetTask)
+	r.Patch("/tasks/:taskId", h.UpdateTask)
+	// PUT исторически работал как частичное обновление, оставлен для старых клиентов
 	r.Put("/tasks/:taskId", h.UpdateTask)
 	r.Post("/tasks/:taskId/move", h.MoveTask)
 	r.Delete("/tasks/:taskId", h.DeleteTask)
+	r.Post("/tasks/:taskId/restore", h.RestoreTask)
+	r.Post("/tasks/:taskId/archive", h.ArchiveTask)
+	r.Post("/tasks/:taskId/unarchive", h.UnarchiveTask)
+	r.Put("/tasks/:taskId/labels", h.SetTaskLabels)
+	r.Get("/me/tasks", h.ListMyTasks)
+	r.Post("/tasks\\:batch", h.BulkTasks)
+
+	// Checklists
+	r.Get("/tasks/:taskId/checklist", h.ListChecklistItems)
+	r.Post("/tasks/:taskId/checklist", h.CreateChecklistItem)
+	r.Put("/checklist-items/:itemId", h.UpdateChecklistItem)
+	r.Put("/checklist-items/:itemId/done", h.SetChecklistItemDone)
+	r.Post("/checklist-items/:itemId/move", h.MoveChecklistItem)
+	r.Delete("/checklist-items/:itemId", h.DeleteChecklistItem)
+
+	// Comments
+	r.Get("/tasks/:taskId/comments", h.ListComments)
+	r.Post("/tasks/:taskId/comments", h.CreateComment)
+	r.Put("/comments/:commentId", h.UpdateComment)
+	r.Delete("/comments/:commentId", h.DeleteComment)
+
+	// Attachments
+	r.Get("/tasks/:taskId/attachments", h.ListAttachments)
+	r.Post("/tasks/:taskId/attachments", h.UploadAttachment)
+	r.Get("/attachments/:attachmentId", h.DownloadAttachment)
+	r.Delete("/attachments/:attachmentId", h.DeleteAttachment)
+
+	// Watchers
+	r.Get("/tasks/:taskId/watchers", h.ListTaskWatchers)
+	r.Put("/tasks/:taskId/watch", h.WatchTask)
+	r.Delete("/tasks/:taskId/watch", h.UnwatchTask)
+	r.Get("/boards/:boardId/watchers", h.ListBoardWatchers)
+	r.Put("/boards/:boardId/watch", h.WatchBoard)
+	r.Delete("/boards/:boardId/watch", h.UnwatchBoard)
+
+	// Labels
+	r.Post("/boards/:boardId/labels", h.CreateLabel)
+	r.Get("/boards/:boardId/labels", h.ListLabels)
+	r.Put("/labels/:labelId", h.UpdateLabel)
+	r.Delete("/labels/:labelId", h.DeleteLabel)
+
+	// Templates
+	r.Get("/templates", h.ListTemplates)
+	r.Post("/templates", h.CreateTemplate)
+	r.Delete("/templates/:templateId", h.DeleteTemplate)
+
+	// Search
+	r.Get("/search", h.SearchTasks)
 }
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SearchHitDTO struct {
+	Task    TaskDTO `json:"task"`
+	BoardId string  `json:"board_id"`
+	Rank    float32 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+type SearchPageDTO struct {
+	Items      []SearchHitDTO `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+func (h *Handler) SearchTasks(c *fiber.Ctx) error {
+	pageSize, err := queryPageSize(c)
+	if err != nil {
+		return err
+	}
+	from, err := queryTime(c, "from")
+	if err != nil {
+		return err
+	}
+	to, err := queryTime(c, "to")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.tasks.SearchTasks(ctx, &v1.SearchTasksRequest{
+		Base:        &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		Query:       c.Query("q"),
+		BoardId:     c.Query("board_id"),
+		ColumnId:    c.Query("column_id"),
+		AssigneeId:  c.Query("assignee_id"),
+		CreatedFrom: from,
+		CreatedTo:   to,
+		PageSize:    pageSize,
+		Cursor:      c.Query("cursor"),
+
+		IncludeArchived: c.QueryBool("include_archived"),
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	out := make([]SearchHitDTO, 0, len(resp.GetHits()))
+	for _, hit := range resp.GetHits() {
+		out = append(out, SearchHitDTO{
+			Task:    buildTaskDTO(hit.GetTask()),
+			BoardId: hit.GetBoardId(),
+			Rank:    hit.GetRank(),
+			Snippet: hit.GetSnippet(),
+		})
+	}
+	return c.JSON(SearchPageDTO{Items: out, NextCursor: resp.GetNextCursor()})
+}
+
+// queryTime принимает RFC 3339; пустой параметр — фильтра нет.
+func queryTime(c *fiber.Ctx, key string) (*timestamppb.Timestamp, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "invalid_"+key)
+	}
+	return timestamppb.New(t), nil
+}
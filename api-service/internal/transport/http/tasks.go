@@ -1,15 +1,23 @@
 package http
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type createTaskBody struct {
-	Position    int32  `json:"position"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	AssigneeId  string `json:"assignee_id"`
+	Position    int32      `json:"position"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	AssigneeId  string     `json:"assignee_id"`
+	StartAt     *time.Time `json:"start_at"`
+	DueAt       *time.Time `json:"due_at"`
+	Priority    string     `json:"priority"`
+	Estimate    *float64   `json:"estimate"`
 }
 
 func (h *Handler) CreateTask(c *fiber.Ctx) error {
@@ -19,6 +27,10 @@ func (h *Handler) CreateTask(c *fiber.Ctx) error {
 	if err := c.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
 	}
+	priority, ok := priorityToPb(body.Priority)
+	if !ok {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_priority")
+	}
 
 	ctx, cancel := h.reqCtx(c)
 	defer cancel()
@@ -30,20 +42,18 @@ func (h *Handler) CreateTask(c *fiber.Ctx) error {
 		Title:       body.Title,
 		Description: body.Description,
 		AssigneeId:  body.AssigneeId,
+		StartAt:     timeToPb(body.StartAt),
+		DueAt:       timeToPb(body.DueAt),
+		Priority:    priority,
+		Estimate:    body.Estimate,
 	})
 	if err != nil {
 		return grpcToHTTP(err)
 	}
 
 	t := resp.GetTask()
-	return c.Status(fiber.StatusCreated).JSON(TaskDTO{
-		Id:          t.GetId(),
-		ColumnId:    t.GetColumnId(),
-		Position:    t.GetPosition(),
-		Title:       t.GetTitle(),
-		Description: t.GetDescription(),
-		AssigneeId:  t.GetAssigneeId(),
-	})
+	setETag(c, t.GetVersion())
+	return c.Status(fiber.StatusCreated).JSON(buildTaskDTO(t))
 }
 
 func (h *Handler) GetTask(c *fiber.Ctx) error {
@@ -61,75 +71,97 @@ func (h *Handler) GetTask(c *fiber.Ctx) error {
 	}
 
 	t := resp.GetTask()
-	return c.JSON(TaskDTO{
-		Id:          t.GetId(),
-		ColumnId:    t.GetColumnId(),
-		Position:    t.GetPosition(),
-		Title:       t.GetTitle(),
-		Description: t.GetDescription(),
-		AssigneeId:  t.GetAssigneeId(),
-	})
+	setETag(c, t.GetVersion())
+	return c.JSON(buildTaskDTO(t))
 }
 
 type updateTaskBody struct {
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	AssigneeId  *string `json:"assignee_id"`
+	Title       *string             `json:"title"`
+	Description *string             `json:"description"`
+	AssigneeId  *string             `json:"assignee_id"`
+	StartAt     nullable[time.Time] `json:"start_at"`
+	DueAt       nullable[time.Time] `json:"due_at"`
+	Priority    nullable[string]    `json:"priority"`
+	Estimate    nullable[float64]   `json:"estimate"`
 }
 
+// UpdateTask меняет только присланные в теле поля: из них собирается маска обновления,
+// так что правка уходит в core одним запросом без предварительного чтения задачи.
 func (h *Handler) UpdateTask(c *fiber.Ctx) error {
-	taskId := c.Params("taskId")
-
 	var body updateTaskBody
 	if err := c.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
 	}
+	req, err := updateTaskRequest(c.Params("taskId"), body)
+	if err != nil {
+		return err
+	}
+	if req.ExpectedVersion, err = ifMatchVersion(c); err != nil {
+		return err
+	}
+	req.Base = &v1.BaseRequest{RequesterId: h.requesterID(c)}
 
 	ctx, cancel := h.reqCtx(c)
 	defer cancel()
 
-	curT, err := h.tasks.GetTask(ctx, &v1.GetTaskRequest{
-		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
-		TaskId: taskId,
-	})
+	resp, err := h.tasks.UpdateTask(ctx, req)
 	if err != nil {
 		return grpcToHTTP(err)
 	}
 
-	title := curT.GetTask().GetTitle()
-	description := curT.GetTask().GetDescription()
-	assigneeId := curT.GetTask().GetAssigneeId()
+	t := resp.GetTask()
+	setETag(c, t.GetVersion())
+	return c.JSON(buildTaskDTO(t))
+}
+
+// updateTaskRequest переводит тело в запрос с маской; пустое тело — ошибка,
+// иначе пустая маска заменила бы задачу целиком.
+func updateTaskRequest(taskId string, body updateTaskBody) (*v1.UpdateTaskRequest, error) {
+	req := &v1.UpdateTaskRequest{TaskId: taskId}
+	var paths []string
 
 	if body.Title != nil {
-		title = *body.Title
+		req.Title = *body.Title
+		paths = append(paths, "title")
 	}
 	if body.Description != nil {
-		description = *body.Description
+		req.Description = *body.Description
+		paths = append(paths, "description")
 	}
 	if body.AssigneeId != nil {
-		assigneeId = *body.AssigneeId
+		req.AssigneeId = *body.AssigneeId
+		paths = append(paths, "assignee_id")
 	}
-
-	resp, err := h.tasks.UpdateTask(ctx, &v1.UpdateTaskRequest{
-		Base:        &v1.BaseRequest{RequesterId: h.requesterID(c)},
-		TaskId:      taskId,
-		Title:       title,
-		Description: description,
-		AssigneeId:  assigneeId,
-	})
-	if err != nil {
-		return grpcToHTTP(err)
+	if body.StartAt.Set {
+		req.StartAt = timeToPb(body.StartAt.Value)
+		paths = append(paths, "start_at")
+	}
+	if body.DueAt.Set {
+		req.DueAt = timeToPb(body.DueAt.Value)
+		paths = append(paths, "due_at")
+	}
+	if body.Priority.Set {
+		raw := ""
+		if body.Priority.Value != nil {
+			raw = *body.Priority.Value
+		}
+		p, ok := priorityToPb(raw)
+		if !ok {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "invalid_priority")
+		}
+		req.Priority = p
+		paths = append(paths, "priority")
+	}
+	if body.Estimate.Set {
+		req.Estimate = body.Estimate.Value
+		paths = append(paths, "estimate")
 	}
 
-	t := resp.GetTask()
-	return c.JSON(TaskDTO{
-		Id:          t.GetId(),
-		ColumnId:    t.GetColumnId(),
-		Position:    t.GetPosition(),
-		Title:       t.GetTitle(),
-		Description: t.GetDescription(),
-		AssigneeId:  t.GetAssigneeId(),
-	})
+	if len(paths) == 0 {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "empty_update")
+	}
+	req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+	return req, nil
 }
 
 type moveTaskBody struct {
@@ -144,6 +176,10 @@ func (h *Handler) MoveTask(c *fiber.Ctx) error {
 	if err := c.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
 	}
+	ifMatch, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := h.reqCtx(c)
 	defer cancel()
@@ -153,20 +189,16 @@ func (h *Handler) MoveTask(c *fiber.Ctx) error {
 		TaskId:     taskId,
 		ToColumnId: body.ToColumnID,
 		ToPosition: body.ToPosition,
+
+		ExpectedVersion: ifMatch,
 	})
 	if err != nil {
 		return grpcToHTTP(err)
 	}
 
 	t := resp.GetTask()
-	return c.JSON(TaskDTO{
-		Id:          t.GetId(),
-		ColumnId:    t.GetColumnId(),
-		Position:    t.GetPosition(),
-		Title:       t.GetTitle(),
-		Description: t.GetDescription(),
-		AssigneeId:  t.GetAssigneeId(),
-	})
+	setETag(c, t.GetVersion())
+	return c.JSON(buildTaskDTO(t))
 }
 
 func (h *Handler) DeleteTask(c *fiber.Ctx) error {
@@ -183,3 +215,193 @@ func (h *Handler) DeleteTask(c *fiber.Ctx) error {
 	}
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+func (h *Handler) RestoreTask(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.tasks.RestoreTask(ctx, &v1.RestoreTaskRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId: taskId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	t := resp.GetTask()
+	setETag(c, t.GetVersion())
+	return c.JSON(buildTaskDTO(t))
+}
+
+func (h *Handler) ArchiveTask(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.tasks.ArchiveTask(ctx, &v1.ArchiveTaskRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId: taskId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	t := resp.GetTask()
+	setETag(c, t.GetVersion())
+	return c.JSON(buildTaskDTO(t))
+}
+
+type unarchiveTaskBody struct {
+	// ColumnID — куда вернуть задачу; пустой — в прежнюю колонку.
+	ColumnID string `json:"column_id"`
+}
+
+func (h *Handler) UnarchiveTask(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+
+	// тело необязательно: без него задача возвращается в прежнюю колонку
+	var body unarchiveTaskBody
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&body); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+		}
+	}
+
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.tasks.UnarchiveTask(ctx, &v1.UnarchiveTaskRequest{
+		Base:     &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId:   taskId,
+		ColumnId: body.ColumnID,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	t := resp.GetTask()
+	setETag(c, t.GetVersion())
+	return c.JSON(buildTaskDTO(t))
+}
+
+func (h *Handler) ListMyTasks(c *fiber.Ctx) error {
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	requesterID := h.requesterID(c)
+	groupByBoard := c.Query("group") == "board"
+	resp, err := h.tasks.ListTasksByAssignee(ctx, &v1.ListTasksByAssigneeRequest{
+		Base:         &v1.BaseRequest{RequesterId: requesterID},
+		AssigneeId:   requesterID,
+		Sort:         c.Query("sort"),
+		GroupByBoard: groupByBoard,
+
+		IncludeArchived: c.QueryBool("include_archived"),
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	if groupByBoard {
+		groups := make([]AssignedTaskGroupDTO, 0, len(resp.GetGroups()))
+		for _, g := range resp.GetGroups() {
+			tasks := make([]AssignedTaskDTO, 0, len(g.GetTasks()))
+			for _, t := range g.GetTasks() {
+				tasks = append(tasks, buildAssignedTaskDTO(t))
+			}
+			groups = append(groups, AssignedTaskGroupDTO{
+				BoardId:    g.GetBoardId(),
+				BoardTitle: g.GetBoardTitle(),
+				Tasks:      tasks,
+			})
+		}
+		return c.JSON(fiber.Map{"groups": groups})
+	}
+
+	items := make([]AssignedTaskDTO, 0, len(resp.GetTasks()))
+	for _, t := range resp.GetTasks() {
+		items = append(items, buildAssignedTaskDTO(t))
+	}
+	return c.JSON(fiber.Map{"items": items})
+}
+
+func buildAssignedTaskDTO(t *v1.AssignedTask) AssignedTaskDTO {
+	return AssignedTaskDTO{
+		Task:           buildTaskDTO(t.GetTask()),
+		BoardId:        t.GetBoardId(),
+		BoardTitle:     t.GetBoardTitle(),
+		ColumnPosition: t.GetColumnPosition(),
+	}
+}
+
+func buildTaskDTO(t *v1.Task) TaskDTO {
+	return TaskDTO{
+		Id:          t.GetId(),
+		ColumnId:    t.GetColumnId(),
+		Position:    t.GetPosition(),
+		Title:       t.GetTitle(),
+		Description: t.GetDescription(),
+		AssigneeId:  t.GetAssigneeId(),
+		StartAt:     timeFromPb(t.GetStartAt()),
+		DueAt:       timeFromPb(t.GetDueAt()),
+		Priority:    priorityFromPb(t.GetPriority()),
+		Estimate:    t.Estimate,
+		LabelIds:    append([]string{}, t.GetLabelIds()...),
+		Checklist:   buildChecklistProgressDTO(t.GetChecklist()),
+
+		AttachmentsCount: t.GetAttachmentsCount(),
+		ArchivedAt:       timeFromPb(t.GetArchivedAt()),
+		Version:          t.GetVersion(),
+	}
+}
+
+func buildChecklistProgressDTO(p *v1.ChecklistProgress) *ChecklistProgressDTO {
+	if p == nil {
+		return nil
+	}
+	return &ChecklistProgressDTO{Done: p.GetDone(), Total: p.GetTotal()}
+}
+
+func timeFromPb(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func timeToPb(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+var priorityNames = map[v1.TaskPriority]string{
+	v1.TaskPriority_TASK_PRIORITY_LOW:    "low",
+	v1.TaskPriority_TASK_PRIORITY_MEDIUM: "medium",
+	v1.TaskPriority_TASK_PRIORITY_HIGH:   "high",
+	v1.TaskPriority_TASK_PRIORITY_URGENT: "urgent",
+}
+
+// priorityToPb: пустая строка снимает приоритет.
+func priorityToPb(raw string) (v1.TaskPriority, bool) {
+	if raw == "" {
+		return v1.TaskPriority_TASK_PRIORITY_UNSPECIFIED, true
+	}
+	for p, name := range priorityNames {
+		if name == raw {
+			return p, true
+		}
+	}
+	return v1.TaskPriority_TASK_PRIORITY_UNSPECIFIED, false
+}
+
+func priorityFromPb(p v1.TaskPriority) *string {
+	name, ok := priorityNames[p]
+	if !ok {
+		return nil
+	}
+	return &name
+}
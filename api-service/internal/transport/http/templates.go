@@ -0,0 +1,89 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+)
+
+type createTemplateBody struct {
+	BoardId     string `json:"board_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (h *Handler) CreateTemplate(c *fiber.Ctx) error {
+	var body createTemplateBody
+	if err := c.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid_json")
+	}
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.templates.CreateTemplate(ctx, &v1.CreateTemplateRequest{
+		Base:        &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		OwnerId:     h.requesterID(c),
+		BoardId:     body.BoardId,
+		Name:        body.Name,
+		Description: body.Description,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(buildTemplateDTO(resp.GetTemplate()))
+}
+
+func (h *Handler) ListTemplates(c *fiber.Ctx) error {
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.templates.ListTemplates(ctx, &v1.ListTemplatesRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		OwnerId: h.requesterID(c),
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+
+	items := make([]TemplateDTO, 0, len(resp.GetTemplates()))
+	for _, t := range resp.GetTemplates() {
+		items = append(items, buildTemplateDTO(t))
+	}
+	return c.JSON(fiber.Map{"items": items})
+}
+
+func (h *Handler) DeleteTemplate(c *fiber.Ctx) error {
+	templateId := c.Params("templateId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.templates.DeleteTemplate(ctx, &v1.DeleteTemplateRequest{
+		Base:       &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		OwnerId:    h.requesterID(c),
+		TemplateId: templateId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func buildTemplateDTO(t *v1.BoardTemplate) TemplateDTO {
+	columns := t.GetColumns()
+	if columns == nil {
+		columns = []string{}
+	}
+	labels := make([]TemplateLabelDTO, 0, len(t.GetLabels()))
+	for _, l := range t.GetLabels() {
+		labels = append(labels, TemplateLabelDTO{Name: l.GetName(), Color: l.GetColor()})
+	}
+
+	return TemplateDTO{
+		Id:          t.GetId(),
+		OwnerId:     t.GetOwnerId(),
+		Name:        t.GetName(),
+		Description: t.GetDescription(),
+		Columns:     columns,
+		Labels:      labels,
+	}
+}
@@ -0,0 +1,103 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+)
+
+func (h *Handler) WatchTask(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.watchers.WatchTask(ctx, &v1.WatchTaskRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId: taskId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *Handler) UnwatchTask(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.watchers.UnwatchTask(ctx, &v1.UnwatchTaskRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId: taskId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *Handler) ListTaskWatchers(c *fiber.Ctx) error {
+	taskId := c.Params("taskId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.watchers.ListTaskWatchers(ctx, &v1.ListTaskWatchersRequest{
+		Base:   &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		TaskId: taskId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.JSON(WatchersDTO{UserIds: nonNilStrings(resp.GetUserIds())})
+}
+
+func (h *Handler) WatchBoard(c *fiber.Ctx) error {
+	boardId := c.Params("boardId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.watchers.WatchBoard(ctx, &v1.WatchBoardRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *Handler) UnwatchBoard(c *fiber.Ctx) error {
+	boardId := c.Params("boardId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	_, err := h.watchers.UnwatchBoard(ctx, &v1.UnwatchBoardRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *Handler) ListBoardWatchers(c *fiber.Ctx) error {
+	boardId := c.Params("boardId")
+	ctx, cancel := h.reqCtx(c)
+	defer cancel()
+
+	resp, err := h.watchers.ListBoardWatchers(ctx, &v1.ListBoardWatchersRequest{
+		Base:    &v1.BaseRequest{RequesterId: h.requesterID(c)},
+		BoardId: boardId,
+	})
+	if err != nil {
+		return grpcToHTTP(err)
+	}
+	return c.JSON(WatchersDTO{UserIds: nonNilStrings(resp.GetUserIds())})
+}
+
+func nonNilStrings(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
@@ -2,10 +2,16 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"net/http"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 	"github.com/smarrog/task-board/auth-service/internal/config"
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+	"github.com/smarrog/task-board/auth-service/internal/infrastructure/oidc"
+	"github.com/smarrog/task-board/auth-service/internal/infrastructure/password"
 	ps "github.com/smarrog/task-board/auth-service/internal/infrastructure/persistence"
 	"github.com/smarrog/task-board/auth-service/internal/transport/grpc"
 	uc "github.com/smarrog/task-board/auth-service/internal/usecase"
@@ -32,8 +38,36 @@ func (a *App) Init() error {
 	a.pg = pool
 
 	repo := ps.NewUsersRepo(pool, log)
+	throttlesRepo := ps.NewLoginThrottlesRepo(pool, log)
+	twoFactorRepo := ps.NewTwoFactorRepo(pool, log)
+	challengesRepo := ps.NewLoginChallengesRepo(pool, log)
+	oidcRequestsRepo := ps.NewOidcAuthRequestsRepo(pool, log)
+	identitiesRepo := ps.NewUserIdentitiesRepo(pool, log)
+	tokensRepo := ps.NewPersonalAccessTokensRepo(pool, log)
+
+	var provider do.IdentityProvider
+	if cfg.OidcIssuerUrl != "" {
+		provider = oidc.NewProvider(oidc.Config{
+			IssuerUrl:    cfg.OidcIssuerUrl,
+			ClientId:     cfg.OidcClientId,
+			ClientSecret: cfg.OidcClientSecret,
+			RedirectUrl:  cfg.OidcRedirectUrl,
+			Scopes:       cfg.OidcScopes,
+		}, &http.Client{Timeout: cfg.OidcHttpTimeout}, log)
+	}
 
-	h := createAuthHandler(log, cfg, repo)
+	argon2Params, err := argon2ParamsFromConfig(cfg)
+	if err != nil {
+		pool.Close()
+		return err
+	}
+	hasher, err := password.NewHasher(cfg.PwdHashAlgorithm, argon2Params, cfg.BcryptCost)
+	if err != nil {
+		pool.Close()
+		return err
+	}
+
+	h := createAuthHandler(log, cfg, hasher, repo, throttlesRepo, twoFactorRepo, challengesRepo, oidcRequestsRepo, identitiesRepo, tokensRepo, provider)
 
 	a.grpc = grpc.NewServer(log, h)
 
@@ -85,10 +119,71 @@ func newPG(cfg *config.Config) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
-func createAuthHandler(log *zerolog.Logger, cfg *config.Config, repo *ps.UsersRepo) *grpc.AuthHandler {
-	register := uc.NewRegisterUseCase(repo, cfg)
-	login := uc.NewLoginUseCase(repo, cfg)
+// argon2ParamsFromConfig не даёт отрицательным и слишком большим значениям
+// молча перейти через границу беззнакового типа.
+func argon2ParamsFromConfig(cfg *config.Config) (password.Argon2Params, error) {
+	for name, v := range map[string]int{
+		"ARGON2_MEMORY_KIB": cfg.Argon2Memory,
+		"ARGON2_TIME":       cfg.Argon2Time,
+		"ARGON2_KEY_LEN":    cfg.Argon2KeyLen,
+	} {
+		if v < 0 || v > math.MaxUint32 {
+			return password.Argon2Params{}, fmt.Errorf("%s out of range: %d", name, v)
+		}
+	}
+	if cfg.Argon2Threads < 0 || cfg.Argon2Threads > math.MaxUint8 {
+		return password.Argon2Params{}, fmt.Errorf("ARGON2_THREADS out of range: %d", cfg.Argon2Threads)
+	}
+	return password.Argon2Params{
+		Memory:  uint32(cfg.Argon2Memory),
+		Time:    uint32(cfg.Argon2Time),
+		Threads: uint8(cfg.Argon2Threads),
+		KeyLen:  uint32(cfg.Argon2KeyLen),
+	}, nil
+}
 
-	handler := grpc.NewAuthHandler(log, register, login)
+func createAuthHandler(
+	log *zerolog.Logger,
+	cfg *config.Config,
+	hasher *password.Hasher,
+	repo *ps.UsersRepo,
+	throttlesRepo *ps.LoginThrottlesRepo,
+	twoFactorRepo *ps.TwoFactorRepo,
+	challengesRepo *ps.LoginChallengesRepo,
+	oidcRequestsRepo *ps.OidcAuthRequestsRepo,
+	identitiesRepo *ps.UserIdentitiesRepo,
+	tokensRepo *ps.PersonalAccessTokensRepo,
+	provider do.IdentityProvider,
+) *grpc.AuthHandler {
+	clock := uc.SystemClock{}
+
+	register := uc.NewRegisterUseCase(repo, hasher, cfg)
+	login := uc.NewLoginUseCase(repo, twoFactorRepo, challengesRepo, throttlesRepo, hasher, clock, cfg, log)
+	verifySecondFactor := uc.NewVerifySecondFactorUseCase(repo, twoFactorRepo, challengesRepo, throttlesRepo, clock, cfg)
+	enrollTotp := uc.NewEnrollTotpUseCase(repo, twoFactorRepo, clock, cfg)
+	confirmTotp := uc.NewConfirmTotpUseCase(twoFactorRepo, clock, cfg)
+	startOidcLogin := uc.NewStartOidcLoginUseCase(provider, oidcRequestsRepo, clock, cfg)
+	finishOidcLogin := uc.NewFinishOidcLoginUseCase(repo, identitiesRepo, oidcRequestsRepo, twoFactorRepo, challengesRepo, provider, clock, cfg)
+	createToken := uc.NewCreateTokenUseCase(tokensRepo, clock)
+	listTokens := uc.NewListTokensUseCase(tokensRepo)
+	revokeToken := uc.NewRevokeTokenUseCase(tokensRepo, clock)
+	introspectToken := uc.NewIntrospectTokenUseCase(tokensRepo, clock)
+	resolveUsernames := uc.NewResolveUsernamesUseCase(repo)
+
+	handler := grpc.NewAuthHandler(
+		log,
+		register,
+		login,
+		verifySecondFactor,
+		enrollTotp,
+		confirmTotp,
+		startOidcLogin,
+		finishOidcLogin,
+		createToken,
+		listTokens,
+		revokeToken,
+		introspectToken,
+		resolveUsernames,
+	)
 	return handler
 }
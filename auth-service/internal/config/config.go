@@ -23,6 +23,32 @@ type Config struct {
 
 	JWTSecret      string
 	AccessTokenTTL time.Duration
+
+	PwdHashAlgorithm string
+	Argon2Memory     int
+	Argon2Time       int
+	Argon2Threads    int
+	Argon2KeyLen     int
+	BcryptCost       int
+
+	LoginMaxFailures   int
+	LoginIpMaxFailures int
+	LoginFailureWindow time.Duration
+	LoginLockoutBase   time.Duration
+	LoginLockoutMax    time.Duration
+
+	TotpIssuer                string
+	LoginChallengeTTL         time.Duration
+	LoginChallengeMaxAttempts int
+	RecoveryCodesCount        int
+
+	OidcIssuerUrl      string
+	OidcClientId       string
+	OidcClientSecret   string
+	OidcRedirectUrl    string
+	OidcScopes         []string
+	OidcAuthRequestTTL time.Duration
+	OidcHttpTimeout    time.Duration
 }
 
 func Load() *Config {
@@ -40,6 +66,32 @@ func Load() *Config {
 
 		JWTSecret:      env.GetString("JWT_SECRET", "dev-secret"),
 		AccessTokenTTL: env.GetDuration("ACCESS_TOKEN_TTL", 24*time.Hour),
+
+		PwdHashAlgorithm: env.GetString("PWD_HASH_ALGORITHM", "argon2id"),
+		Argon2Memory:     env.GetInt("ARGON2_MEMORY_KIB", 64*1024),
+		Argon2Time:       env.GetInt("ARGON2_TIME", 3),
+		Argon2Threads:    env.GetInt("ARGON2_THREADS", 2),
+		Argon2KeyLen:     env.GetInt("ARGON2_KEY_LEN", 32),
+		BcryptCost:       env.GetInt("BCRYPT_COST", 10),
+
+		LoginMaxFailures:   env.GetInt("LOGIN_MAX_FAILURES", 5),
+		LoginIpMaxFailures: env.GetInt("LOGIN_IP_MAX_FAILURES", 20),
+		LoginFailureWindow: env.GetDuration("LOGIN_FAILURE_WINDOW", 15*time.Minute),
+		LoginLockoutBase:   env.GetDuration("LOGIN_LOCKOUT_BASE", time.Minute),
+		LoginLockoutMax:    env.GetDuration("LOGIN_LOCKOUT_MAX", time.Hour),
+
+		TotpIssuer:                env.GetString("TOTP_ISSUER", "Task Board"),
+		LoginChallengeTTL:         env.GetDuration("LOGIN_CHALLENGE_TTL", 5*time.Minute),
+		LoginChallengeMaxAttempts: env.GetInt("LOGIN_CHALLENGE_MAX_ATTEMPTS", 5),
+		RecoveryCodesCount:        env.GetInt("RECOVERY_CODES_COUNT", 10),
+
+		OidcIssuerUrl:      env.GetString("OIDC_ISSUER_URL", ""),
+		OidcClientId:       env.GetString("OIDC_CLIENT_ID", ""),
+		OidcClientSecret:   env.GetString("OIDC_CLIENT_SECRET", ""),
+		OidcRedirectUrl:    env.GetString("OIDC_REDIRECT_URL", "http://localhost:8080/v1/auth/oidc/callback"),
+		OidcScopes:         env.GetSplitString("OIDC_SCOPES", []string{"openid", "email", "profile"}),
+		OidcAuthRequestTTL: env.GetDuration("OIDC_AUTH_REQUEST_TTL", 10*time.Minute),
+		OidcHttpTimeout:    env.GetDuration("OIDC_HTTP_TIMEOUT", 5*time.Second),
 	}
 
 	return &cfg
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 )
 
@@ -46,3 +47,320 @@ func (u *User) Email() Email         { return u.email }
 func (u *User) Username() UserName   { return u.username }
 func (u *User) PwdHash() PwdHash     { return u.pwdHash }
 func (u *User) CreatedAt() time.Time { return u.createdAt }
+
+func (u *User) ChangePwdHash(pwdHash PwdHash) { u.pwdHash = pwdHash }
+
+type LoginThrottle struct {
+	key           ThrottleKey
+	failures      int
+	lastFailureAt time.Time
+	lockedUntil   time.Time
+}
+
+func NewLoginThrottle(key ThrottleKey) *LoginThrottle {
+	return &LoginThrottle{key: key}
+}
+
+func RehydrateLoginThrottle(
+	key ThrottleKey,
+	failures int,
+	lastFailureAt time.Time,
+	lockedUntil time.Time,
+) *LoginThrottle {
+	return &LoginThrottle{
+		key:           key,
+		failures:      failures,
+		lastFailureAt: lastFailureAt,
+		lockedUntil:   lockedUntil,
+	}
+}
+
+func (t *LoginThrottle) Key() ThrottleKey         { return t.key }
+func (t *LoginThrottle) Failures() int            { return t.failures }
+func (t *LoginThrottle) LastFailureAt() time.Time { return t.lastFailureAt }
+func (t *LoginThrottle) LockedUntil() time.Time   { return t.lockedUntil }
+
+func (t *LoginThrottle) IsLocked(now time.Time) bool {
+	return now.Before(t.lockedUntil)
+}
+
+// LockUntil возвращает срок блокировки после неудачи в момент now, уже учтённой в счётчике.
+// После policy.MaxFailures неудач каждая следующая удваивает время блокировки
+// (но не больше policy.MaxLockout); false — порог ещё не достигнут.
+func (t *LoginThrottle) LockUntil(now time.Time, policy ThrottlePolicy) (time.Time, bool) {
+	if t.failures < policy.MaxFailures {
+		return time.Time{}, false
+	}
+	return now.Add(policy.Lockout(t.failures)), true
+}
+
+// TwoFactor — TOTP пользователя. Пока confirmedAt пуст, 2FA не включена:
+// секрет выдан, но пользователь ещё не подтвердил его кодом из приложения.
+type TwoFactor struct {
+	userId       UserId
+	secret       TotpSecret
+	confirmedAt  time.Time
+	lastUsedStep int64
+	createdAt    time.Time
+}
+
+func NewTwoFactor(userId UserId, secret TotpSecret, now time.Time) *TwoFactor {
+	return &TwoFactor{
+		userId:    userId,
+		secret:    secret,
+		createdAt: now,
+	}
+}
+
+func RehydrateTwoFactor(
+	userId UserId,
+	secret TotpSecret,
+	confirmedAt time.Time,
+	lastUsedStep int64,
+	createdAt time.Time,
+) *TwoFactor {
+	return &TwoFactor{
+		userId:       userId,
+		secret:       secret,
+		confirmedAt:  confirmedAt,
+		lastUsedStep: lastUsedStep,
+		createdAt:    createdAt,
+	}
+}
+
+func (f *TwoFactor) UserId() UserId         { return f.userId }
+func (f *TwoFactor) Secret() TotpSecret     { return f.secret }
+func (f *TwoFactor) ConfirmedAt() time.Time { return f.confirmedAt }
+func (f *TwoFactor) LastUsedStep() int64    { return f.lastUsedStep }
+func (f *TwoFactor) CreatedAt() time.Time   { return f.createdAt }
+
+func (f *TwoFactor) IsEnabled() bool { return !f.confirmedAt.IsZero() }
+
+func (f *TwoFactor) Confirm(code string, now time.Time) error {
+	if f.IsEnabled() {
+		return ErrTwoFactorAlreadyEnabled
+	}
+	if !f.VerifyCode(code, now) {
+		return ErrInvalidSecondFactor
+	}
+	f.confirmedAt = now
+	return nil
+}
+
+// VerifyCode проверяет код с допуском в TotpSkew шагов. Шаг, которым уже воспользовались,
+// повторно не принимается — перехваченный код нельзя переиграть.
+func (f *TwoFactor) VerifyCode(code string, now time.Time) bool {
+	code = strings.TrimSpace(code)
+	if !IsTotpCode(code) {
+		return false
+	}
+
+	current := TotpStep(now)
+	for step := current - TotpSkew; step <= current+TotpSkew; step++ {
+		if step <= f.lastUsedStep {
+			continue
+		}
+		if codesEqual(code, f.secret.Code(step)) {
+			f.lastUsedStep = step
+			return true
+		}
+	}
+	return false
+}
+
+// LoginChallenge — вход, прошедший проверку пароля и ожидающий второго фактора.
+type LoginChallenge struct {
+	id        ChallengeId
+	userId    UserId
+	attempts  int
+	expiresAt time.Time
+}
+
+func NewLoginChallenge(userId UserId, now time.Time, ttl time.Duration) *LoginChallenge {
+	return &LoginChallenge{
+		id:        NewChallengeId(),
+		userId:    userId,
+		expiresAt: now.Add(ttl),
+	}
+}
+
+func RehydrateLoginChallenge(id ChallengeId, userId UserId, attempts int, expiresAt time.Time) *LoginChallenge {
+	return &LoginChallenge{
+		id:        id,
+		userId:    userId,
+		attempts:  attempts,
+		expiresAt: expiresAt,
+	}
+}
+
+func (c *LoginChallenge) Id() ChallengeId      { return c.id }
+func (c *LoginChallenge) UserId() UserId       { return c.userId }
+func (c *LoginChallenge) Attempts() int        { return c.attempts }
+func (c *LoginChallenge) ExpiresAt() time.Time { return c.expiresAt }
+
+func (c *LoginChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(c.expiresAt)
+}
+
+// HasAttemptsLeft сообщает, можно ли ещё вводить код после уже учтённых неверных попыток.
+func (c *LoginChallenge) HasAttemptsLeft(maxAttempts int) bool {
+	return c.attempts < maxAttempts
+}
+
+// OidcAuthRequest — начатый вход через внешний провайдер. Живёт до колбэка и используется
+// один раз: state защищает от CSRF, nonce — от подмены ID-токена, verifier — для PKCE.
+type OidcAuthRequest struct {
+	state        string
+	nonce        string
+	codeVerifier string
+	expiresAt    time.Time
+}
+
+func NewOidcAuthRequest(now time.Time, ttl time.Duration) (*OidcAuthRequest, error) {
+	state, err := randomUrlToken(32)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := randomUrlToken(32)
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := randomUrlToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OidcAuthRequest{
+		state:        state,
+		nonce:        nonce,
+		codeVerifier: verifier,
+		expiresAt:    now.Add(ttl),
+	}, nil
+}
+
+func RehydrateOidcAuthRequest(state, nonce, codeVerifier string, expiresAt time.Time) *OidcAuthRequest {
+	return &OidcAuthRequest{
+		state:        state,
+		nonce:        nonce,
+		codeVerifier: codeVerifier,
+		expiresAt:    expiresAt,
+	}
+}
+
+func (r *OidcAuthRequest) State() string        { return r.state }
+func (r *OidcAuthRequest) Nonce() string        { return r.nonce }
+func (r *OidcAuthRequest) CodeVerifier() string { return r.codeVerifier }
+func (r *OidcAuthRequest) ExpiresAt() time.Time { return r.expiresAt }
+
+func (r *OidcAuthRequest) CodeChallenge() string { return PkceChallenge(r.codeVerifier) }
+
+func (r *OidcAuthRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
+
+// UserIdentity связывает пользователя с учёткой у внешнего провайдера (issuer + sub).
+type UserIdentity struct {
+	issuer    string
+	subject   string
+	userId    UserId
+	createdAt time.Time
+}
+
+func NewUserIdentity(issuer string, subject string, userId UserId, now time.Time) *UserIdentity {
+	return &UserIdentity{
+		issuer:    issuer,
+		subject:   subject,
+		userId:    userId,
+		createdAt: now,
+	}
+}
+
+func (i *UserIdentity) Issuer() string       { return i.issuer }
+func (i *UserIdentity) Subject() string      { return i.subject }
+func (i *UserIdentity) UserId() UserId       { return i.userId }
+func (i *UserIdentity) CreatedAt() time.Time { return i.createdAt }
+
+type PersonalAccessToken struct {
+	id         TokenId
+	userId     UserId
+	name       TokenName
+	hash       TokenHash
+	scopes     []TokenScope
+	createdAt  time.Time
+	expiresAt  time.Time
+	lastUsedAt time.Time
+	revokedAt  time.Time
+}
+
+// NewPersonalAccessToken возвращает токен и его открытое значение. Нулевой ttl — бессрочный токен.
+func NewPersonalAccessToken(
+	userId UserId,
+	name TokenName,
+	scopes []TokenScope,
+	now time.Time,
+	ttl time.Duration,
+) (*PersonalAccessToken, string, error) {
+	if ttl < 0 {
+		return nil, "", ErrInvalidTokenTTL
+	}
+
+	secret, err := NewPersonalTokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	t := &PersonalAccessToken{
+		id:        NewTokenId(),
+		userId:    userId,
+		name:      name,
+		hash:      HashPersonalToken(secret),
+		scopes:    scopes,
+		createdAt: now,
+	}
+	if ttl > 0 {
+		t.expiresAt = now.Add(ttl)
+	}
+
+	return t, secret, nil
+}
+
+func RehydratePersonalAccessToken(
+	id TokenId,
+	userId UserId,
+	name TokenName,
+	hash TokenHash,
+	scopes []TokenScope,
+	createdAt time.Time,
+	expiresAt time.Time,
+	lastUsedAt time.Time,
+	revokedAt time.Time,
+) *PersonalAccessToken {
+	return &PersonalAccessToken{
+		id:         id,
+		userId:     userId,
+		name:       name,
+		hash:       hash,
+		scopes:     scopes,
+		createdAt:  createdAt,
+		expiresAt:  expiresAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+	}
+}
+
+func (t *PersonalAccessToken) Id() TokenId           { return t.id }
+func (t *PersonalAccessToken) UserId() UserId        { return t.userId }
+func (t *PersonalAccessToken) Name() TokenName       { return t.name }
+func (t *PersonalAccessToken) Hash() TokenHash       { return t.hash }
+func (t *PersonalAccessToken) Scopes() []TokenScope  { return t.scopes }
+func (t *PersonalAccessToken) CreatedAt() time.Time  { return t.createdAt }
+func (t *PersonalAccessToken) ExpiresAt() time.Time  { return t.expiresAt }
+func (t *PersonalAccessToken) LastUsedAt() time.Time { return t.lastUsedAt }
+func (t *PersonalAccessToken) RevokedAt() time.Time  { return t.revokedAt }
+
+func (t *PersonalAccessToken) IsActive(now time.Time) bool {
+	if !t.revokedAt.IsZero() {
+		return false
+	}
+	return t.expiresAt.IsZero() || now.Before(t.expiresAt)
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"time"
 )
 
 var ErrUserIdRequired = errors.New("user id is required")
@@ -9,6 +11,7 @@ var ErrUserNotFound = errors.New("user not found")
 
 var ErrUserNameIsToShort = errors.New("user name is too short")
 var ErrUserNameIsToLong = errors.New("user name is too long")
+var ErrTooManyUserNames = errors.New("too many user names")
 
 var ErrEmailAlreadyExists = errors.New("email already exists")
 var ErrInvalidEmail = errors.New("invalid email")
@@ -18,3 +21,37 @@ var ErrPwdIsToLong = errors.New("password is too long")
 var ErrPwdHashGeneration = errors.New("password hash generation")
 
 var ErrInvalidCredentials = errors.New("invalid credentials")
+
+var ErrLoginLocked = errors.New("login temporarily locked")
+
+type LoginLockedError struct {
+	RetryAt time.Time
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%s until %s", ErrLoginLocked, e.RetryAt.Format(time.RFC3339))
+}
+
+func (e *LoginLockedError) Unwrap() error { return ErrLoginLocked }
+
+var ErrInvalidTotpSecret = errors.New("invalid totp secret")
+var ErrTwoFactorNotFound = errors.New("two factor not found")
+var ErrTwoFactorAlreadyEnabled = errors.New("two factor already enabled")
+var ErrInvalidSecondFactor = errors.New("invalid second factor code")
+
+var ErrChallengeNotFound = errors.New("login challenge not found")
+
+var ErrOidcDisabled = errors.New("oidc login is not configured")
+var ErrOidcInvalidState = errors.New("oidc state is invalid or expired")
+var ErrOidcInvalidIdToken = errors.New("oidc id token is invalid")
+var ErrOidcEmailNotVerified = errors.New("oidc email is not verified")
+var ErrOidcExchange = errors.New("oidc code exchange failed")
+
+var ErrIdentityNotFound = errors.New("user identity not found")
+
+var ErrTokenNameIsEmpty = errors.New("token name is empty")
+var ErrTokenNameIsToLong = errors.New("token name is too long")
+var ErrInvalidTokenScope = errors.New("invalid token scope")
+var ErrTokenScopesRequired = errors.New("token scopes are required")
+var ErrInvalidTokenTTL = errors.New("invalid token ttl")
+var ErrTokenNotFound = errors.New("token not found")
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 type Repository interface {
@@ -9,4 +10,77 @@ type Repository interface {
 
 	GetById(ctx context.Context, id UserId) (*User, error)
 	GetByEmail(ctx context.Context, email Email) (*User, error)
+
+	UpdatePwdHash(ctx context.Context, id UserId, pwdHash PwdHash) error
+
+	// ListIdsByUsernames ищет без учёта регистра; ключ — имя в нижнем регистре.
+	ListIdsByUsernames(ctx context.Context, names []UserName) (map[string][]UserId, error)
+}
+
+type PasswordHasher interface {
+	Hash(pwd Pwd) (PwdHash, error)
+	// Verify возвращает false без ошибки, если пароль просто не подошёл.
+	Verify(pwd string, hash PwdHash) (bool, error)
+	NeedsRehash(hash PwdHash) bool
+}
+
+type LoginThrottleRepository interface {
+	// Get возвращает пустой LoginThrottle, если по ключу ещё не было неудачных попыток.
+	Get(ctx context.Context, key ThrottleKey) (*LoginThrottle, error)
+	// RegisterFailure атомарно учитывает неудачу в момент now и возвращает состояние после неё.
+	// Счётчик начинается заново, если прошлая неудача была раньше windowStart и блокировка снята.
+	RegisterFailure(ctx context.Context, key ThrottleKey, now, windowStart time.Time) (*LoginThrottle, error)
+	// Lock блокирует ключ до until; уже выставленная более поздняя блокировка не сокращается.
+	Lock(ctx context.Context, key ThrottleKey, until time.Time) error
+	Delete(ctx context.Context, key ThrottleKey) error
+}
+
+type TwoFactorRepository interface {
+	Get(ctx context.Context, userId UserId) (*TwoFactor, error)
+	Save(ctx context.Context, f *TwoFactor) error
+
+	// ReplaceRecoveryCodes удаляет все прежние коды пользователя.
+	ReplaceRecoveryCodes(ctx context.Context, userId UserId, hashes []RecoveryCodeHash) error
+	// UseRecoveryCode гасит код и возвращает false, если его нет или он уже использован.
+	UseRecoveryCode(ctx context.Context, userId UserId, hash RecoveryCodeHash) (bool, error)
+	// ConsumeStep атомарно запоминает использованный шаг TOTP и возвращает false,
+	// если этот или более поздний шаг уже использован.
+	ConsumeStep(ctx context.Context, userId UserId, step int64) (bool, error)
+}
+
+type LoginChallengeRepository interface {
+	Create(ctx context.Context, c *LoginChallenge) error
+	Get(ctx context.Context, id ChallengeId) (*LoginChallenge, error)
+	// RegisterAttempt атомарно учитывает неверный код и возвращает состояние после него.
+	RegisterAttempt(ctx context.Context, id ChallengeId) (*LoginChallenge, error)
+	// Delete возвращает ErrChallengeNotFound, если челлендж уже удалён: погасить его может только один вызов.
+	Delete(ctx context.Context, id ChallengeId) error
+}
+
+type OidcAuthRequestRepository interface {
+	Create(ctx context.Context, r *OidcAuthRequest) error
+	// Take достаёт запрос и сразу удаляет его, чтобы state нельзя было использовать повторно.
+	Take(ctx context.Context, state string) (*OidcAuthRequest, error)
+}
+
+type UserIdentityRepository interface {
+	GetUserId(ctx context.Context, issuer string, subject string) (UserId, error)
+	Create(ctx context.Context, i *UserIdentity) error
+}
+
+type IdentityProvider interface {
+	Issuer() string
+	AuthCodeUrl(ctx context.Context, state string, nonce string, codeChallenge string) (string, error)
+	// Exchange меняет код на токены и возвращает claims уже проверенного ID-токена.
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*ExternalIdentity, error)
+}
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, t *PersonalAccessToken) error
+	GetByHash(ctx context.Context, hash TokenHash) (*PersonalAccessToken, error)
+	// ListByUser возвращает неотозванные токены, новые первыми.
+	ListByUser(ctx context.Context, userId UserId) ([]*PersonalAccessToken, error)
+	// Revoke отзывает токен, только если он принадлежит userId.
+	Revoke(ctx context.Context, userId UserId, id TokenId, at time.Time) error
+	TouchLastUsed(ctx context.Context, id TokenId, at time.Time) error
 }
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// секрет из RFC 6238, приложение B: ASCII "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func mustSecret(t *testing.T) TotpSecret {
+	t.Helper()
+	s, err := TotpSecretFromString(rfcSecret)
+	if err != nil {
+		t.Fatalf("secret: %v", err)
+	}
+	return s
+}
+
+func TestTotpSecretCodeMatchesRfcVectors(t *testing.T) {
+	s := mustSecret(t)
+
+	// в RFC коды восьмизначные, у нас шесть цифр — это их младшие разряды
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, c := range cases {
+		if got := s.Code(TotpStep(time.Unix(c.unix, 0))); got != c.code {
+			t.Errorf("Code(%d) = %s, want %s", c.unix, got, c.code)
+		}
+	}
+}
+
+func TestTotpSecretFromStringNormalizes(t *testing.T) {
+	s, err := TotpSecretFromString(" gezdgnbvgy3tqojqgezdgnbvgy3tqojq== ")
+	if err != nil {
+		t.Fatalf("secret: %v", err)
+	}
+	if s.String() != rfcSecret {
+		t.Fatalf("String() = %s, want %s", s.String(), rfcSecret)
+	}
+
+	if _, err := TotpSecretFromString("not base32!"); err == nil {
+		t.Fatal("want error for invalid secret")
+	}
+}
+
+func TestVerifyCodeWindow(t *testing.T) {
+	s := mustSecret(t)
+	now := time.Unix(1_700_000_000, 0)
+	step := TotpStep(now)
+
+	cases := []struct {
+		name  string
+		step  int64
+		valid bool
+	}{
+		{"previous step", step - 1, true},
+		{"current step", step, true},
+		{"next step", step + 1, true},
+		{"two steps back", step - 2, false},
+		{"two steps ahead", step + 2, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tf := NewTwoFactor(NewUserId(), s, now)
+			if got := tf.VerifyCode(s.Code(c.step), now); got != c.valid {
+				t.Fatalf("VerifyCode = %v, want %v", got, c.valid)
+			}
+		})
+	}
+}
+
+func TestVerifyCodeRejectsReplayAndOlderSteps(t *testing.T) {
+	s := mustSecret(t)
+	now := time.Unix(1_700_000_000, 0)
+	step := TotpStep(now)
+	tf := NewTwoFactor(NewUserId(), s, now)
+
+	if !tf.VerifyCode(s.Code(step), now) {
+		t.Fatal("first use must pass")
+	}
+	if tf.VerifyCode(s.Code(step), now) {
+		t.Fatal("replayed code must be rejected")
+	}
+	if tf.VerifyCode(s.Code(step-1), now) {
+		t.Fatal("code of an older step must be rejected after a newer one was used")
+	}
+	if !tf.VerifyCode(s.Code(step+1), now) {
+		t.Fatal("code of a newer step must pass")
+	}
+}
+
+func TestVerifyCodeRejectsMalformed(t *testing.T) {
+	tf := NewTwoFactor(NewUserId(), mustSecret(t), time.Now())
+	for _, code := range []string{"", "12345", "1234567", "12a456"} {
+		if tf.VerifyCode(code, time.Now()) {
+			t.Errorf("VerifyCode(%q) = true", code)
+		}
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	s := mustSecret(t)
+	now := time.Unix(1_700_000_000, 0)
+	tf := NewTwoFactor(NewUserId(), s, now)
+
+	// код вне окна допуска
+	if err := tf.Confirm(s.Code(TotpStep(now)+5), now); err != ErrInvalidSecondFactor {
+		t.Fatalf("Confirm with wrong code: %v", err)
+	}
+	if tf.IsEnabled() {
+		t.Fatal("2FA must stay disabled after a wrong code")
+	}
+
+	if err := tf.Confirm(s.Code(TotpStep(now)), now); err != nil {
+		t.Fatalf("Confirm: %v", err)
+	}
+	if !tf.IsEnabled() {
+		t.Fatal("2FA must be enabled")
+	}
+	if err := tf.Confirm(s.Code(TotpStep(now)+1), now); err != ErrTwoFactorAlreadyEnabled {
+		t.Fatalf("second Confirm: %v", err)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	code, err := NewRecoveryCode()
+	if err != nil {
+		t.Fatalf("NewRecoveryCode: %v", err)
+	}
+	if len(code) != recoveryCodeSize+1 || code[5] != '-' {
+		t.Fatalf("unexpected code format %q", code)
+	}
+
+	// пользователь может ввести код в другом регистре, без дефиса или с пробелами
+	want := HashRecoveryCode(code)
+	for _, variant := range []string{
+		code[:5] + code[6:],
+		" " + code + " ",
+		code[:5] + " " + code[6:],
+	} {
+		if got := HashRecoveryCode(variant); got != want {
+			t.Errorf("HashRecoveryCode(%q) differs", variant)
+		}
+	}
+	if HashRecoveryCode("aaaaa-bbbbb") == HashRecoveryCode("aaaaa-bbbbc") {
+		t.Fatal("different codes must hash differently")
+	}
+}
+
+func TestLoginChallengeAttemptsAndExpiry(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	c := NewLoginChallenge(NewUserId(), now, 5*time.Minute)
+
+	if c.IsExpired(now.Add(5*time.Minute - time.Second)) {
+		t.Fatal("challenge expired too early")
+	}
+	if !c.IsExpired(now.Add(5 * time.Minute)) {
+		t.Fatal("challenge must expire at ttl")
+	}
+
+	if !c.HasAttemptsLeft(3) {
+		t.Fatal("new challenge must have attempts")
+	}
+	if !RehydrateLoginChallenge(c.Id(), c.UserId(), 2, c.ExpiresAt()).HasAttemptsLeft(3) {
+		t.Fatal("two attempts must leave attempts")
+	}
+	if RehydrateLoginChallenge(c.Id(), c.UserId(), 3, c.ExpiresAt()).HasAttemptsLeft(3) {
+		t.Fatal("third attempt must exhaust the challenge")
+	}
+}
@@ -1,18 +1,44 @@
 package domain
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 const (
 	MinPasswordLength = 8
-	MaxPasswordLength = 32
+	MaxPasswordLength = 128
 	MinUserNameLength = 3
 	MaxUserNameLength = 32
+
+	TotpDigits = 6
+	TotpPeriod = 30 * time.Second
+	// TotpSkew — сколько соседних шагов принимаем, чтобы пережить рассинхрон часов.
+	TotpSkew       = 1
+	totpSecretSize = 20
+
+	recoveryCodeSize = 10
+
+	MaxTokenNameLength = 64
+	// MaxTokenTTLDays — не больше пяти лет: дальше срок годности уже не ограничивает утечку.
+	MaxTokenTTLDays = 5 * 365
+	// PersonalTokenPrefix позволяет gateway отличить персональный токен от JWT без разбора.
+	PersonalTokenPrefix = "tbp_"
+	personalTokenSize   = 32
 )
 
 type UserId struct {
@@ -100,3 +126,284 @@ func NewAccessToken(value string) (AccessToken, error) {
 }
 
 func (p AccessToken) String() string { return string(p) }
+
+type ThrottleKey string
+
+func NewAccountThrottleKey(email Email) ThrottleKey {
+	return ThrottleKey("email:" + strings.ToLower(email.String()))
+}
+
+func NewIpThrottleKey(ip string) (ThrottleKey, bool) {
+	v := strings.TrimSpace(ip)
+	if v == "" {
+		return "", false
+	}
+	return ThrottleKey("ip:" + v), true
+}
+
+func (k ThrottleKey) String() string { return string(k) }
+
+type ThrottlePolicy struct {
+	MaxFailures int
+	Window      time.Duration
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+}
+
+func (p ThrottlePolicy) Lockout(failures int) time.Duration {
+	exp := failures - p.MaxFailures
+	if exp < 0 {
+		return 0
+	}
+	if exp > 30 {
+		exp = 30
+	}
+
+	d := p.BaseLockout << exp
+	if d <= 0 || d > p.MaxLockout {
+		return p.MaxLockout
+	}
+	return d
+}
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TotpSecret — общий секрет для TOTP (RFC 6238: HMAC-SHA1, 6 цифр, шаг 30 секунд).
+type TotpSecret struct {
+	value []byte
+}
+
+func NewTotpSecret() (TotpSecret, error) {
+	b := make([]byte, totpSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return TotpSecret{}, err
+	}
+	return TotpSecret{value: b}, nil
+}
+
+func TotpSecretFromString(s string) (TotpSecret, error) {
+	v := strings.ToUpper(strings.TrimRight(strings.TrimSpace(s), "="))
+	b, err := totpEncoding.DecodeString(v)
+	if err != nil {
+		return TotpSecret{}, fmt.Errorf("%w: %v", ErrInvalidTotpSecret, err)
+	}
+	if len(b) == 0 {
+		return TotpSecret{}, ErrInvalidTotpSecret
+	}
+	return TotpSecret{value: b}, nil
+}
+
+func (s TotpSecret) String() string { return totpEncoding.EncodeToString(s.value) }
+
+// Code возвращает код для шага step (см. TotpStep).
+func (s TotpSecret) Code(step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, s.value)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", TotpDigits, bin%1_000_000)
+}
+
+// OtpauthUri — ссылка для QR-кода в приложениях-аутентификаторах.
+func (s TotpSecret) OtpauthUri(issuer string, account string) string {
+	q := url.Values{}
+	q.Set("secret", s.String())
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(TotpDigits))
+	q.Set("period", fmt.Sprint(int(TotpPeriod/time.Second)))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+func TotpStep(at time.Time) int64 {
+	return at.Unix() / int64(TotpPeriod/time.Second)
+}
+
+func IsTotpCode(code string) bool {
+	if len(code) != TotpDigits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func codesEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// RecoveryCodeHash — в базе храним только sha256 от нормализованного кода.
+type RecoveryCodeHash string
+
+func NewRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	v := strings.ToLower(totpEncoding.EncodeToString(b))[:recoveryCodeSize]
+	return v[:5] + "-" + v[5:], nil
+}
+
+func HashRecoveryCode(code string) RecoveryCodeHash {
+	v := strings.ToLower(strings.TrimSpace(code))
+	v = strings.ReplaceAll(v, "-", "")
+	v = strings.ReplaceAll(v, " ", "")
+	sum := sha256.Sum256([]byte(v))
+	return RecoveryCodeHash(hex.EncodeToString(sum[:]))
+}
+
+func (h RecoveryCodeHash) String() string { return string(h) }
+
+type ChallengeId struct {
+	value uuid.UUID
+}
+
+func NewChallengeId() ChallengeId {
+	return ChallengeId{uuid.New()}
+}
+
+func ChallengeIdFromString(s string) (ChallengeId, error) {
+	id, err := uuid.Parse(strings.TrimSpace(s))
+	if err != nil || id == uuid.Nil {
+		return ChallengeId{}, ErrChallengeNotFound
+	}
+	return ChallengeId{value: id}, nil
+}
+
+func (id ChallengeId) UUID() uuid.UUID { return id.value }
+func (id ChallengeId) String() string  { return id.value.String() }
+
+// ExternalIdentity — проверенные claims из ID-токена внешнего провайдера.
+type ExternalIdentity struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	PreferredUsername string
+	Name              string
+}
+
+func randomUrlToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// PkceChallenge — code_challenge для метода S256 (RFC 7636).
+func PkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+type TokenId struct {
+	value uuid.UUID
+}
+
+func NewTokenId() TokenId {
+	return TokenId{uuid.New()}
+}
+
+func TokenIdFromUUID(id uuid.UUID) (TokenId, error) {
+	if id == uuid.Nil {
+		return TokenId{}, ErrTokenNotFound
+	}
+	return TokenId{value: id}, nil
+}
+
+func TokenIdFromString(s string) (TokenId, error) {
+	id, err := uuid.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return TokenId{}, fmt.Errorf("%w: %v", ErrTokenNotFound, err)
+	}
+	return TokenIdFromUUID(id)
+}
+
+func (id TokenId) UUID() uuid.UUID { return id.value }
+func (id TokenId) String() string  { return id.value.String() }
+
+type TokenName string
+
+func NewTokenName(raw string) (TokenName, error) {
+	v := strings.TrimSpace(raw)
+	if v == "" {
+		return "", ErrTokenNameIsEmpty
+	}
+	if len(v) > MaxTokenNameLength {
+		return "", ErrTokenNameIsToLong
+	}
+	return TokenName(v), nil
+}
+
+func (n TokenName) String() string { return string(n) }
+
+type TokenScope string
+
+const (
+	TokenScopeRead  TokenScope = "read"
+	TokenScopeWrite TokenScope = "write"
+)
+
+func NewTokenScope(raw string) (TokenScope, error) {
+	switch v := TokenScope(strings.ToLower(strings.TrimSpace(raw))); v {
+	case TokenScopeRead, TokenScopeWrite:
+		return v, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidTokenScope, raw)
+	}
+}
+
+// NewTokenScopes убирает дубли; write без read не бывает, поэтому read добавляется сам.
+func NewTokenScopes(raw []string) ([]TokenScope, error) {
+	if len(raw) == 0 {
+		return nil, ErrTokenScopesRequired
+	}
+
+	hasWrite := false
+	for _, r := range raw {
+		s, err := NewTokenScope(r)
+		if err != nil {
+			return nil, err
+		}
+		if s == TokenScopeWrite {
+			hasWrite = true
+		}
+	}
+
+	if hasWrite {
+		return []TokenScope{TokenScopeRead, TokenScopeWrite}, nil
+	}
+	return []TokenScope{TokenScopeRead}, nil
+}
+
+func (s TokenScope) String() string { return string(s) }
+
+// TokenHash — в базе храним только sha256 от токена, сам токен показывается один раз.
+type TokenHash string
+
+func NewPersonalTokenSecret() (string, error) {
+	v, err := randomUrlToken(personalTokenSize)
+	if err != nil {
+		return "", err
+	}
+	return PersonalTokenPrefix + v, nil
+}
+
+func HashPersonalToken(raw string) TokenHash {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(raw)))
+	return TokenHash(hex.EncodeToString(sum[:]))
+}
+
+func (h TokenHash) String() string { return string(h) }
@@ -0,0 +1,351 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// discoveryTTL — провайдер может сменить эндпоинты и jwks_uri, метаданные перечитываем.
+	discoveryTTL = time.Hour
+	// jwksMinRefresh не даёт токенам с неизвестным kid заставлять нас дёргать JWKS на каждый запрос.
+	jwksMinRefresh = time.Minute
+	idTokenLeeway  = 30 * time.Second
+	maxBodySize    = 1 << 20
+)
+
+type Config struct {
+	IssuerUrl    string
+	ClientId     string
+	ClientSecret string
+	RedirectUrl  string
+	Scopes       []string
+}
+
+type discoveryDoc struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     any    `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+	Name              string `json:"name"`
+}
+
+type Provider struct {
+	cfg    Config
+	client *http.Client
+	log    *zerolog.Logger
+
+	// mu защищает только кэш: сетевые запросы идут без блокировки,
+	// чтобы медленный провайдер не останавливал все входы разом.
+	mu            sync.Mutex
+	doc           *discoveryDoc
+	docFetchedAt  time.Time
+	keys          map[string]*rsa.PublicKey
+	keysFetchedAt time.Time
+	keysFetch     chan struct{} // закрывается по окончании текущей загрузки JWKS
+	now           func() time.Time
+}
+
+func NewProvider(cfg Config, client *http.Client, log *zerolog.Logger) *Provider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Provider{cfg: cfg, client: client, log: log, now: time.Now}
+}
+
+func (p *Provider) Issuer() string { return strings.TrimRight(p.cfg.IssuerUrl, "/") }
+
+func (p *Provider) AuthCodeUrl(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	doc, err := p.discovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(doc.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("oidc authorization endpoint: %w", err)
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientId)
+	q.Set("redirect_uri", p.cfg.RedirectUrl)
+	q.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+func (p *Provider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*do.ExternalIdentity, error) {
+	doc, err := p.discovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectUrl)
+	form.Set("client_id", p.cfg.ClientId)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientId), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	var tokens struct {
+		IdToken string `json:"id_token"`
+	}
+	if err := p.do(req, &tokens); err != nil {
+		return nil, fmt.Errorf("%w: %v", do.ErrOidcExchange, err)
+	}
+	if tokens.IdToken == "" {
+		return nil, fmt.Errorf("%w: no id_token in response", do.ErrOidcExchange)
+	}
+
+	return p.verifyIdToken(ctx, doc, tokens.IdToken, nonce)
+}
+
+func (p *Provider) verifyIdToken(ctx context.Context, doc *discoveryDoc, raw string, nonce string) (*do.ExternalIdentity, error) {
+	var claims idTokenClaims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, doc, kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.cfg.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(idTokenLeeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", do.ErrOidcInvalidIdToken, err)
+	}
+	if claims.Nonce == "" || claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", do.ErrOidcInvalidIdToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: empty subject", do.ErrOidcInvalidIdToken)
+	}
+
+	return &do.ExternalIdentity{
+		Issuer:            doc.Issuer,
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     isTrue(claims.EmailVerified),
+		PreferredUsername: claims.PreferredUsername,
+		Name:              claims.Name,
+	}, nil
+}
+
+// isTrue — часть провайдеров отдаёт email_verified строкой.
+func isTrue(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return strings.EqualFold(b, "true")
+	default:
+		return false
+	}
+}
+
+func (p *Provider) discovery(ctx context.Context) (*discoveryDoc, error) {
+	if p.cfg.IssuerUrl == "" {
+		return nil, do.ErrOidcDisabled
+	}
+
+	p.mu.Lock()
+	if p.doc != nil && p.now().Sub(p.docFetchedAt) < discoveryTTL {
+		doc := p.doc
+		p.mu.Unlock()
+		return doc, nil
+	}
+	p.mu.Unlock()
+
+	doc, err := p.fetchDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.doc = doc
+	p.docFetchedAt = p.now()
+	return doc, nil
+}
+
+func (p *Provider) fetchDiscovery(ctx context.Context) (*discoveryDoc, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.Issuer()+discoveryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc discoveryDoc
+	if err := p.do(req, &doc); err != nil {
+		return nil, fmt.Errorf("oidc discovery: %w", err)
+	}
+	if doc.Issuer != p.Issuer() {
+		return nil, fmt.Errorf("oidc discovery: issuer mismatch %q != %q", doc.Issuer, p.Issuer())
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JwksUri == "" {
+		return nil, errors.New("oidc discovery: incomplete provider metadata")
+	}
+	return &doc, nil
+}
+
+func (p *Provider) key(ctx context.Context, doc *discoveryDoc, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	if k, ok := p.lookupKey(kid); ok {
+		p.mu.Unlock()
+		return k, nil
+	}
+	// JWKS уже загружается — ждём ту же загрузку, а не идём за набором вторым запросом
+	if wait := p.keysFetch; wait != nil {
+		p.mu.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if k, ok := p.lookupKey(kid); ok {
+			return k, nil
+		}
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	if p.now().Sub(p.keysFetchedAt) < jwksMinRefresh {
+		p.mu.Unlock()
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	done := make(chan struct{})
+	p.keysFetch = done
+	p.mu.Unlock()
+
+	keys, err := p.fetchKeys(ctx, doc.JwksUri)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keysFetch = nil
+	close(done)
+	if err != nil {
+		return nil, err
+	}
+	p.keys = keys
+	p.keysFetchedAt = p.now()
+
+	if k, ok := p.lookupKey(kid); ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey: если в заголовке нет kid, подходит только единственный ключ в наборе.
+func (p *Provider) lookupKey(kid string) (*rsa.PublicKey, bool) {
+	if kid == "" {
+		if len(p.keys) == 1 {
+			for _, k := range p.keys {
+				return k, true
+			}
+		}
+		return nil, false
+	}
+	k, ok := p.keys[kid]
+	return k, ok
+}
+
+func (p *Provider) fetchKeys(ctx context.Context, jwksUri string) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksUri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := p.do(req, &set); err != nil {
+		return nil, fmt.Errorf("oidc jwks: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			p.log.Warn().Err(err).Str("kid", k.Kid).Msg("skip jwk with invalid modulus")
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			p.log.Warn().Str("kid", k.Kid).Msg("skip jwk with invalid exponent")
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	return keys, nil
+}
+
+func (p *Provider) do(req *http.Request, out any) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: status %d: %s", req.Method, req.URL.Redacted(), resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,288 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+// fakeIdp — минимальный OIDC-провайдер: discovery, JWKS и token endpoint.
+type fakeIdp struct {
+	srv *httptest.Server
+
+	mu     sync.Mutex
+	keys   map[string]*rsa.PrivateKey
+	signer string // kid, которым подписывается ID-токен
+	claims jwt.MapClaims
+
+	discoveryHits atomic.Int32
+	jwksHits      atomic.Int32
+}
+
+func newFakeIdp(t *testing.T) *fakeIdp {
+	t.Helper()
+	idp := &fakeIdp{keys: map[string]*rsa.PrivateKey{}}
+	idp.addKey(t, "k1")
+	idp.signer = "k1"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		idp.discoveryHits.Add(1)
+		writeJson(w, discoveryDoc{
+			Issuer:                idp.srv.URL,
+			AuthorizationEndpoint: idp.srv.URL + "/authorize",
+			TokenEndpoint:         idp.srv.URL + "/token",
+			JwksUri:               idp.srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		idp.jwksHits.Add(1)
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		var set struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		for kid, k := range idp.keys {
+			set.Keys = append(set.Keys, map[string]string{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+			})
+		}
+		writeJson(w, set)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("code") != "good-code" || r.PostForm.Get("code_verifier") == "" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		idp.mu.Lock()
+		tok := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.claims)
+		tok.Header["kid"] = idp.signer
+		raw, err := tok.SignedString(idp.keys[idp.signer])
+		idp.mu.Unlock()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJson(w, map[string]string{"id_token": raw})
+	})
+	idp.srv = httptest.NewServer(mux)
+	t.Cleanup(idp.srv.Close)
+
+	now := time.Now()
+	idp.claims = jwt.MapClaims{
+		"iss":            idp.srv.URL,
+		"aud":            "client-1",
+		"sub":            "sub-1",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Minute).Unix(),
+		"nonce":          "nonce-1",
+		"email":          "alice@example.com",
+		"email_verified": "true",
+	}
+	return idp
+}
+
+func (idp *fakeIdp) addKey(t *testing.T, kid string) {
+	t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.keys[kid] = k
+}
+
+func (idp *fakeIdp) provider() *Provider {
+	log := zerolog.Nop()
+	return NewProvider(Config{
+		IssuerUrl: idp.srv.URL,
+		ClientId:  "client-1",
+		Scopes:    []string{"openid", "email"},
+	}, idp.srv.Client(), &log)
+}
+
+func writeJson(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestExchangeReturnsVerifiedIdentity(t *testing.T) {
+	idp := newFakeIdp(t)
+	p := idp.provider()
+
+	ident, err := p.Exchange(context.Background(), "good-code", "verifier", "nonce-1")
+	if err != nil {
+		t.Fatalf("exchange: %v", err)
+	}
+	if ident.Issuer != idp.srv.URL || ident.Subject != "sub-1" || ident.Email != "alice@example.com" {
+		t.Fatalf("unexpected identity %+v", ident)
+	}
+	if !ident.EmailVerified {
+		t.Fatal("email_verified given as string must be accepted")
+	}
+}
+
+func TestExchangeRejectsInvalidTokens(t *testing.T) {
+	cases := []struct {
+		name   string
+		mutate func(c jwt.MapClaims)
+		nonce  string
+	}{
+		{"nonce mismatch", func(jwt.MapClaims) {}, "other-nonce"},
+		{"foreign audience", func(c jwt.MapClaims) { c["aud"] = "client-2" }, "nonce-1"},
+		{"foreign issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }, "nonce-1"},
+		{"expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }, "nonce-1"},
+		{"no subject", func(c jwt.MapClaims) { delete(c, "sub") }, "nonce-1"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			idp := newFakeIdp(t)
+			c.mutate(idp.claims)
+
+			_, err := idp.provider().Exchange(context.Background(), "good-code", "verifier", c.nonce)
+			if !errors.Is(err, do.ErrOidcInvalidIdToken) {
+				t.Fatalf("want ErrOidcInvalidIdToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestExchangeRejectedCode(t *testing.T) {
+	idp := newFakeIdp(t)
+
+	_, err := idp.provider().Exchange(context.Background(), "bad-code", "verifier", "nonce-1")
+	if !errors.Is(err, do.ErrOidcExchange) {
+		t.Fatalf("want ErrOidcExchange, got %v", err)
+	}
+}
+
+func TestDiscoveryIsCachedForTTL(t *testing.T) {
+	idp := newFakeIdp(t)
+	p := idp.provider()
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := p.AuthCodeUrl(ctx, "state", "nonce", "challenge"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := idp.discoveryHits.Load(); got != 1 {
+		t.Fatalf("discovery fetched %d times within ttl", got)
+	}
+
+	now = now.Add(discoveryTTL)
+	if _, err := p.AuthCodeUrl(ctx, "state", "nonce", "challenge"); err != nil {
+		t.Fatal(err)
+	}
+	if got := idp.discoveryHits.Load(); got != 2 {
+		t.Fatalf("discovery must be refetched after ttl, got %d fetches", got)
+	}
+}
+
+func TestDiscoveryFailureIsNotCached(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	idp := newFakeIdp(t)
+	inner := idp.srv.Config.Handler
+	idp.srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		inner.ServeHTTP(w, r)
+	})
+	p := idp.provider()
+
+	if _, err := p.AuthCodeUrl(context.Background(), "state", "nonce", "challenge"); err == nil {
+		t.Fatal("want error while provider is down")
+	}
+	fail.Store(false)
+	if _, err := p.AuthCodeUrl(context.Background(), "state", "nonce", "challenge"); err != nil {
+		t.Fatalf("provider is back: %v", err)
+	}
+}
+
+func TestDiscoveryRejectsIssuerMismatch(t *testing.T) {
+	idp := newFakeIdp(t)
+	log := zerolog.Nop()
+	p := NewProvider(Config{IssuerUrl: idp.srv.URL + "/other", ClientId: "client-1"}, idp.srv.Client(), &log)
+
+	if _, err := p.AuthCodeUrl(context.Background(), "state", "nonce", "challenge"); err == nil {
+		t.Fatal("want issuer mismatch error")
+	}
+}
+
+func TestKeyRotationRefreshesJwks(t *testing.T) {
+	idp := newFakeIdp(t)
+	p := idp.provider()
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if _, err := p.Exchange(ctx, "good-code", "verifier", "nonce-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// провайдер перешёл на новый ключ
+	idp.addKey(t, "k2")
+	idp.mu.Lock()
+	idp.signer = "k2"
+	idp.mu.Unlock()
+
+	// раньше jwksMinRefresh набор ключей не перечитывается
+	if _, err := p.Exchange(ctx, "good-code", "verifier", "nonce-1"); !errors.Is(err, do.ErrOidcInvalidIdToken) {
+		t.Fatalf("want unknown key error, got %v", err)
+	}
+
+	now = now.Add(jwksMinRefresh)
+	if _, err := p.Exchange(ctx, "good-code", "verifier", "nonce-1"); err != nil {
+		t.Fatalf("exchange after rotation: %v", err)
+	}
+	if got := idp.jwksHits.Load(); got != 2 {
+		t.Fatalf("jwks fetched %d times, want 2", got)
+	}
+}
+
+func TestConcurrentExchange(t *testing.T) {
+	idp := newFakeIdp(t)
+	p := idp.provider()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := p.Exchange(context.Background(), "good-code", "verifier", "nonce-1"); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,183 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+
+	argon2idPrefix = "$argon2id$"
+	saltSize       = 16
+
+	// bcrypt учитывает только первые 72 байта пароля, длиннее GenerateFromPassword не примет.
+	bcryptMaxPasswordBytes = 72
+	minArgon2KeyLen        = 16
+)
+
+var errMalformedHash = errors.New("malformed password hash")
+
+type Argon2Params struct {
+	Memory  uint32 // KiB
+	Time    uint32
+	Threads uint8
+	KeyLen  uint32
+}
+
+// Hasher хэширует новым алгоритмом, но умеет проверять и старые bcrypt-хэши —
+// такие NeedsRehash помечает на замену.
+type Hasher struct {
+	algorithm  string
+	argon2     Argon2Params
+	bcryptCost int
+}
+
+// NewHasher проверяет параметры обоих алгоритмов: bcrypt нужен и при argon2id,
+// пока в базе остаются старые хэши.
+func NewHasher(algorithm string, argon2Params Argon2Params, bcryptCost int) (*Hasher, error) {
+	switch algorithm {
+	case AlgorithmArgon2id, AlgorithmBcrypt:
+	default:
+		return nil, fmt.Errorf("unknown password hash algorithm %q", algorithm)
+	}
+	if err := argon2Params.validate(); err != nil {
+		return nil, err
+	}
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost %d out of range [%d, %d]", bcryptCost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	return &Hasher{algorithm: algorithm, argon2: argon2Params, bcryptCost: bcryptCost}, nil
+}
+
+func (p Argon2Params) validate() error {
+	switch {
+	case p.Time < 1:
+		return fmt.Errorf("argon2 time must be positive, got %d", p.Time)
+	case p.Threads < 1:
+		return fmt.Errorf("argon2 threads must be positive, got %d", p.Threads)
+	case p.Memory < 8*uint32(p.Threads):
+		// меньше 8 KiB на поток argon2 молча поднимает до минимума, и хэш не совпадёт с параметрами
+		return fmt.Errorf("argon2 memory must be at least %d KiB for %d threads, got %d", 8*uint32(p.Threads), p.Threads, p.Memory)
+	case p.KeyLen < minArgon2KeyLen:
+		return fmt.Errorf("argon2 key length must be at least %d, got %d", minArgon2KeyLen, p.KeyLen)
+	}
+	return nil
+}
+
+func (h *Hasher) Hash(pwd do.Pwd) (do.PwdHash, error) {
+	if h.algorithm == AlgorithmBcrypt {
+		if len(pwd) > bcryptMaxPasswordBytes {
+			return "", do.ErrPwdIsToLong
+		}
+		raw, err := bcrypt.GenerateFromPassword([]byte(pwd), h.bcryptCost)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", do.ErrPwdHashGeneration, err)
+		}
+		return do.NewPwdHash(string(raw))
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("%w: %v", do.ErrPwdHashGeneration, err)
+	}
+	key := argon2.IDKey([]byte(pwd), salt, h.argon2.Time, h.argon2.Memory, h.argon2.Threads, h.argon2.KeyLen)
+
+	return do.NewPwdHash(encodeArgon2id(h.argon2, salt, key))
+}
+
+func (h *Hasher) Verify(pwd string, hash do.PwdHash) (bool, error) {
+	raw := hash.String()
+	if raw == "" {
+		// пользователь без пароля (заведён через SSO)
+		return false, nil
+	}
+
+	if strings.HasPrefix(raw, argon2idPrefix) {
+		params, salt, key, err := decodeArgon2id(raw)
+		if err != nil {
+			return false, err
+		}
+		other := argon2.IDKey([]byte(pwd), salt, params.Time, params.Memory, params.Threads, params.KeyLen)
+		return subtle.ConstantTimeCompare(key, other) == 1, nil
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(raw), []byte(pwd))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) || errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// NeedsRehash сообщает, что хэш сделан другим алгоритмом или с другими параметрами.
+func (h *Hasher) NeedsRehash(hash do.PwdHash) bool {
+	raw := hash.String()
+	if raw == "" {
+		return false
+	}
+
+	if h.algorithm == AlgorithmBcrypt {
+		cost, err := bcrypt.Cost([]byte(raw))
+		return err != nil || cost != h.bcryptCost
+	}
+
+	if !strings.HasPrefix(raw, argon2idPrefix) {
+		return true
+	}
+	params, _, _, err := decodeArgon2id(raw)
+	return err != nil || params != h.argon2
+}
+
+// encodeArgon2id — формат PHC: $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func encodeArgon2id(p Argon2Params, salt []byte, key []byte) string {
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		p.Memory, p.Time, p.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func decodeArgon2id(raw string) (Argon2Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, key
+	parts := strings.Split(raw, "$")
+	if len(parts) != 6 {
+		return Argon2Params{}, nil, nil, errMalformedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Argon2Params{}, nil, nil, errMalformedHash
+	}
+
+	var p Argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil {
+		return Argon2Params{}, nil, nil, errMalformedHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return Argon2Params{}, nil, nil, errMalformedHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return Argon2Params{}, nil, nil, errMalformedHash
+	}
+	p.KeyLen = uint32(len(key))
+
+	return p, salt, key, nil
+}
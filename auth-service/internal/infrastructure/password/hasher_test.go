@@ -0,0 +1,114 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+// параметры для тестов: минимально допустимые, чтобы не тратить память и время
+var testArgon2 = Argon2Params{Memory: 8, Time: 1, Threads: 1, KeyLen: 16}
+
+func mustHasher(t *testing.T, algorithm string) *Hasher {
+	t.Helper()
+	h, err := NewHasher(algorithm, testArgon2, bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("NewHasher: %v", err)
+	}
+	return h
+}
+
+func TestHashAndVerify(t *testing.T) {
+	for _, alg := range []string{AlgorithmArgon2id, AlgorithmBcrypt} {
+		t.Run(alg, func(t *testing.T) {
+			h := mustHasher(t, alg)
+			hash, err := h.Hash(do.Pwd("password-1"))
+			if err != nil {
+				t.Fatalf("Hash: %v", err)
+			}
+			if ok, err := h.Verify("password-1", hash); err != nil || !ok {
+				t.Fatalf("Verify(right) = %v, %v", ok, err)
+			}
+			if ok, err := h.Verify("password-2", hash); err != nil || ok {
+				t.Fatalf("Verify(wrong) = %v, %v", ok, err)
+			}
+			if h.NeedsRehash(hash) {
+				t.Fatal("fresh hash must not need rehash")
+			}
+		})
+	}
+}
+
+func TestBcryptRejectsLongPasswords(t *testing.T) {
+	long := do.Pwd(strings.Repeat("a", do.MaxPasswordLength))
+
+	if _, err := mustHasher(t, AlgorithmBcrypt).Hash(long); !errors.Is(err, do.ErrPwdIsToLong) {
+		t.Fatalf("bcrypt: want ErrPwdIsToLong, got %v", err)
+	}
+	if _, err := mustHasher(t, AlgorithmBcrypt).Hash(long[:bcryptMaxPasswordBytes]); err != nil {
+		t.Fatalf("bcrypt at limit: %v", err)
+	}
+	if _, err := mustHasher(t, AlgorithmArgon2id).Hash(long); err != nil {
+		t.Fatalf("argon2id: %v", err)
+	}
+}
+
+func TestNeedsRehashOnAlgorithmOrParamsChange(t *testing.T) {
+	argon := mustHasher(t, AlgorithmArgon2id)
+	bc := mustHasher(t, AlgorithmBcrypt)
+
+	bcryptHash, err := bc.Hash(do.Pwd("password-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	argonHash, err := argon.Hash(do.Pwd("password-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !argon.NeedsRehash(bcryptHash) {
+		t.Fatal("bcrypt hash must be migrated to argon2id")
+	}
+	if ok, err := argon.Verify("password-1", bcryptHash); err != nil || !ok {
+		t.Fatalf("legacy bcrypt hash must still verify: %v, %v", ok, err)
+	}
+	if !bc.NeedsRehash(argonHash) {
+		t.Fatal("argon2id hash must be migrated to bcrypt")
+	}
+
+	stronger, err := NewHasher(AlgorithmArgon2id, Argon2Params{Memory: 16, Time: 1, Threads: 1, KeyLen: 16}, bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stronger.NeedsRehash(argonHash) {
+		t.Fatal("changed argon2 params must trigger rehash")
+	}
+}
+
+func TestNewHasherValidatesParams(t *testing.T) {
+	cases := []struct {
+		name      string
+		algorithm string
+		argon2    Argon2Params
+		cost      int
+	}{
+		{"unknown algorithm", "md5", testArgon2, bcrypt.DefaultCost},
+		{"bcrypt cost too low", AlgorithmBcrypt, testArgon2, bcrypt.MinCost - 1},
+		{"bcrypt cost too high", AlgorithmBcrypt, testArgon2, bcrypt.MaxCost + 1},
+		{"zero time", AlgorithmArgon2id, Argon2Params{Memory: 8, Time: 0, Threads: 1, KeyLen: 16}, bcrypt.DefaultCost},
+		{"zero threads", AlgorithmArgon2id, Argon2Params{Memory: 8, Time: 1, Threads: 0, KeyLen: 16}, bcrypt.DefaultCost},
+		{"memory below 8 KiB per thread", AlgorithmArgon2id, Argon2Params{Memory: 15, Time: 1, Threads: 2, KeyLen: 16}, bcrypt.DefaultCost},
+		{"short key", AlgorithmArgon2id, Argon2Params{Memory: 8, Time: 1, Threads: 1, KeyLen: 8}, bcrypt.DefaultCost},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := NewHasher(c.algorithm, c.argon2, c.cost); err == nil {
+				t.Fatal("want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type LoginChallengesRepo struct {
+	pg  *pgxpool.Pool
+	log *zerolog.Logger
+}
+
+func NewLoginChallengesRepo(pg *pgxpool.Pool, log *zerolog.Logger) *LoginChallengesRepo {
+	return &LoginChallengesRepo{pg: pg, log: log}
+}
+
+func (r *LoginChallengesRepo) Create(ctx context.Context, c *do.LoginChallenge) error {
+	_, err := r.pg.Exec(ctx, `
+		INSERT INTO login_challenges (id, user_id, attempts, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`, c.Id().UUID(), c.UserId().UUID(), c.Attempts(), c.ExpiresAt())
+	return err
+}
+
+func (r *LoginChallengesRepo) Get(ctx context.Context, id do.ChallengeId) (*do.LoginChallenge, error) {
+	var userIdRaw uuid.UUID
+	var attempts int
+	var expiresAt time.Time
+
+	err := r.pg.QueryRow(ctx, `
+		SELECT user_id, attempts, expires_at
+		FROM login_challenges
+		WHERE id = $1
+	`, id.UUID()).Scan(&userIdRaw, &attempts, &expiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, do.ErrChallengeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := do.UserIdFromUUID(userIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return do.RehydrateLoginChallenge(id, userId, attempts, expiresAt.UTC()), nil
+}
+
+// RegisterAttempt увеличивает счётчик в самом UPDATE, поэтому параллельные неверные коды не теряются.
+func (r *LoginChallengesRepo) RegisterAttempt(ctx context.Context, id do.ChallengeId) (*do.LoginChallenge, error) {
+	var userIdRaw uuid.UUID
+	var attempts int
+	var expiresAt time.Time
+
+	err := r.pg.QueryRow(ctx, `
+		UPDATE login_challenges
+		SET attempts = attempts + 1
+		WHERE id = $1
+		RETURNING user_id, attempts, expires_at
+	`, id.UUID()).Scan(&userIdRaw, &attempts, &expiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, do.ErrChallengeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userId, err := do.UserIdFromUUID(userIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return do.RehydrateLoginChallenge(id, userId, attempts, expiresAt.UTC()), nil
+}
+
+func (r *LoginChallengesRepo) Delete(ctx context.Context, id do.ChallengeId) error {
+	tag, err := r.pg.Exec(ctx, `DELETE FROM login_challenges WHERE id = $1`, id.UUID())
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return do.ErrChallengeNotFound
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type LoginThrottlesRepo struct {
+	pg  *pgxpool.Pool
+	log *zerolog.Logger
+}
+
+func NewLoginThrottlesRepo(pg *pgxpool.Pool, log *zerolog.Logger) *LoginThrottlesRepo {
+	return &LoginThrottlesRepo{pg: pg, log: log}
+}
+
+func (r *LoginThrottlesRepo) Get(ctx context.Context, key do.ThrottleKey) (*do.LoginThrottle, error) {
+	var failures int
+	var lastFailureAt, lockedUntil *time.Time
+
+	err := r.pg.QueryRow(ctx, `
+		SELECT failures, last_failure_at, locked_until
+		FROM login_throttles
+		WHERE key = $1
+	`, key.String()).Scan(&failures, &lastFailureAt, &lockedUntil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return do.NewLoginThrottle(key), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return do.RehydrateLoginThrottle(key, failures, timeOrZero(lastFailureAt), timeOrZero(lockedUntil)), nil
+}
+
+// RegisterFailure увеличивает счётчик одним upsert, поэтому параллельные неудачи не теряются.
+func (r *LoginThrottlesRepo) RegisterFailure(ctx context.Context, key do.ThrottleKey, now, windowStart time.Time) (*do.LoginThrottle, error) {
+	var failures int
+	var lastFailureAt, lockedUntil *time.Time
+
+	err := r.pg.QueryRow(ctx, `
+		INSERT INTO login_throttles (key, failures, last_failure_at, updated_at)
+		VALUES ($1, 1, $2, now())
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE
+				WHEN login_throttles.last_failure_at < $3
+					AND (login_throttles.locked_until IS NULL OR login_throttles.locked_until <= $2)
+				THEN 1
+				ELSE login_throttles.failures + 1
+			END,
+			last_failure_at = $2,
+			updated_at      = now()
+		RETURNING failures, last_failure_at, locked_until
+	`, key.String(), now, windowStart).Scan(&failures, &lastFailureAt, &lockedUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	return do.RehydrateLoginThrottle(key, failures, timeOrZero(lastFailureAt), timeOrZero(lockedUntil)), nil
+}
+
+func (r *LoginThrottlesRepo) Lock(ctx context.Context, key do.ThrottleKey, until time.Time) error {
+	// GREATEST пропускает NULL, так что первая блокировка выставляется как есть
+	_, err := r.pg.Exec(ctx, `
+		UPDATE login_throttles
+		SET locked_until = GREATEST(locked_until, $2),
+			updated_at   = now()
+		WHERE key = $1
+	`, key.String(), until)
+	return err
+}
+
+func (r *LoginThrottlesRepo) Delete(ctx context.Context, key do.ThrottleKey) error {
+	_, err := r.pg.Exec(ctx, `DELETE FROM login_throttles WHERE key = $1`, key.String())
+	return err
+}
+
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.UTC()
+}
+
+func zeroToNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
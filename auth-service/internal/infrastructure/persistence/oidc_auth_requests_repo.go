@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type OidcAuthRequestsRepo struct {
+	pg  *pgxpool.Pool
+	log *zerolog.Logger
+}
+
+func NewOidcAuthRequestsRepo(pg *pgxpool.Pool, log *zerolog.Logger) *OidcAuthRequestsRepo {
+	return &OidcAuthRequestsRepo{pg: pg, log: log}
+}
+
+func (r *OidcAuthRequestsRepo) Create(ctx context.Context, req *do.OidcAuthRequest) error {
+	_, err := r.pg.Exec(ctx, `
+		INSERT INTO oidc_auth_requests (state, nonce, code_verifier, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`, req.State(), req.Nonce(), req.CodeVerifier(), req.ExpiresAt())
+	return err
+}
+
+func (r *OidcAuthRequestsRepo) Take(ctx context.Context, state string) (*do.OidcAuthRequest, error) {
+	var nonce, codeVerifier string
+	var expiresAt time.Time
+
+	err := r.pg.QueryRow(ctx, `
+		DELETE FROM oidc_auth_requests
+		WHERE state = $1
+		RETURNING nonce, code_verifier, expires_at
+	`, state).Scan(&nonce, &codeVerifier, &expiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, do.ErrOidcInvalidState
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return do.RehydrateOidcAuthRequest(state, nonce, codeVerifier, expiresAt.UTC()), nil
+}
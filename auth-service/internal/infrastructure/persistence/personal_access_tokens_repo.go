@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type PersonalAccessTokensRepo struct {
+	pg  *pgxpool.Pool
+	log *zerolog.Logger
+}
+
+func NewPersonalAccessTokensRepo(pg *pgxpool.Pool, log *zerolog.Logger) *PersonalAccessTokensRepo {
+	return &PersonalAccessTokensRepo{pg: pg, log: log}
+}
+
+const personalAccessTokenColumns = `id, user_id, name, token_hash, scopes, created_at, expires_at, last_used_at, revoked_at`
+
+func (r *PersonalAccessTokensRepo) Create(ctx context.Context, t *do.PersonalAccessToken) error {
+	scopes := make([]string, 0, len(t.Scopes()))
+	for _, s := range t.Scopes() {
+		scopes = append(scopes, s.String())
+	}
+
+	_, err := r.pg.Exec(ctx, `
+		INSERT INTO personal_access_tokens (id, user_id, name, token_hash, scopes, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, t.Id().UUID(), t.UserId().UUID(), t.Name().String(), t.Hash().String(), scopes, t.CreatedAt(), zeroToNil(t.ExpiresAt()))
+	return err
+}
+
+func (r *PersonalAccessTokensRepo) GetByHash(ctx context.Context, hash do.TokenHash) (*do.PersonalAccessToken, error) {
+	row := r.pg.QueryRow(ctx, `
+		SELECT `+personalAccessTokenColumns+`
+		FROM personal_access_tokens
+		WHERE token_hash = $1
+	`, hash.String())
+
+	t, err := scanPersonalAccessToken(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, do.ErrTokenNotFound
+	}
+	return t, err
+}
+
+func (r *PersonalAccessTokensRepo) ListByUser(ctx context.Context, userId do.UserId) ([]*do.PersonalAccessToken, error) {
+	rows, err := r.pg.Query(ctx, `
+		SELECT `+personalAccessTokenColumns+`
+		FROM personal_access_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`, userId.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*do.PersonalAccessToken
+	for rows.Next() {
+		t, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
+
+func (r *PersonalAccessTokensRepo) Revoke(ctx context.Context, userId do.UserId, id do.TokenId, at time.Time) error {
+	tag, err := r.pg.Exec(ctx, `
+		UPDATE personal_access_tokens
+		SET revoked_at = $3
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`, id.UUID(), userId.UUID(), at)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return do.ErrTokenNotFound
+	}
+	return nil
+}
+
+func (r *PersonalAccessTokensRepo) TouchLastUsed(ctx context.Context, id do.TokenId, at time.Time) error {
+	_, err := r.pg.Exec(ctx, `
+		UPDATE personal_access_tokens
+		SET last_used_at = $2
+		WHERE id = $1
+	`, id.UUID(), at)
+	return err
+}
+
+func scanPersonalAccessToken(row pgx.Row) (*do.PersonalAccessToken, error) {
+	var idRaw, userIdRaw uuid.UUID
+	var nameRaw, hashRaw string
+	var scopesRaw []string
+	var createdAt time.Time
+	var expiresAt, lastUsedAt, revokedAt *time.Time
+
+	if err := row.Scan(&idRaw, &userIdRaw, &nameRaw, &hashRaw, &scopesRaw, &createdAt, &expiresAt, &lastUsedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+
+	id, err := do.TokenIdFromUUID(idRaw)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := do.UserIdFromUUID(userIdRaw)
+	if err != nil {
+		return nil, err
+	}
+	name, err := do.NewTokenName(nameRaw)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := do.NewTokenScopes(scopesRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return do.RehydratePersonalAccessToken(
+		id,
+		userId,
+		name,
+		do.TokenHash(hashRaw),
+		scopes,
+		createdAt,
+		timeOrZero(expiresAt),
+		timeOrZero(lastUsedAt),
+		timeOrZero(revokedAt),
+	), nil
+}
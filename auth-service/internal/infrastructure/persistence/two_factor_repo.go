@@ -0,0 +1,109 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type TwoFactorRepo struct {
+	pg  *pgxpool.Pool
+	log *zerolog.Logger
+}
+
+func NewTwoFactorRepo(pg *pgxpool.Pool, log *zerolog.Logger) *TwoFactorRepo {
+	return &TwoFactorRepo{pg: pg, log: log}
+}
+
+func (r *TwoFactorRepo) Get(ctx context.Context, userId do.UserId) (*do.TwoFactor, error) {
+	var secretRaw string
+	var confirmedAt *time.Time
+	var lastUsedStep int64
+	var createdAt time.Time
+
+	err := r.pg.QueryRow(ctx, `
+		SELECT secret, confirmed_at, last_used_step, created_at
+		FROM user_totp
+		WHERE user_id = $1
+	`, userId.UUID()).Scan(&secretRaw, &confirmedAt, &lastUsedStep, &createdAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, do.ErrTwoFactorNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := do.TotpSecretFromString(secretRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	return do.RehydrateTwoFactor(userId, secret, timeOrZero(confirmedAt), lastUsedStep, createdAt), nil
+}
+
+func (r *TwoFactorRepo) Save(ctx context.Context, f *do.TwoFactor) error {
+	_, err := r.pg.Exec(ctx, `
+		INSERT INTO user_totp (user_id, secret, confirmed_at, last_used_step, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret         = EXCLUDED.secret,
+			confirmed_at   = EXCLUDED.confirmed_at,
+			last_used_step = EXCLUDED.last_used_step,
+			created_at     = EXCLUDED.created_at
+	`, f.UserId().UUID(), f.Secret().String(), zeroToNil(f.ConfirmedAt()), f.LastUsedStep(), f.CreatedAt())
+	return err
+}
+
+func (r *TwoFactorRepo) ReplaceRecoveryCodes(ctx context.Context, userId do.UserId, hashes []do.RecoveryCodeHash) error {
+	tx, err := r.pg.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM user_recovery_codes WHERE user_id = $1`, userId.UUID()); err != nil {
+		return err
+	}
+
+	for _, h := range hashes {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO user_recovery_codes (user_id, code_hash)
+			VALUES ($1, $2)
+		`, userId.UUID(), h.String()); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *TwoFactorRepo) UseRecoveryCode(ctx context.Context, userId do.UserId, hash do.RecoveryCodeHash) (bool, error) {
+	tag, err := r.pg.Exec(ctx, `
+		UPDATE user_recovery_codes
+		SET used_at = now()
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`, userId.UUID(), hash.String())
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// ConsumeStep — условный UPDATE: из параллельных входов с одним кодом шаг получит только один.
+func (r *TwoFactorRepo) ConsumeStep(ctx context.Context, userId do.UserId, step int64) (bool, error) {
+	tag, err := r.pg.Exec(ctx, `
+		UPDATE user_totp
+		SET last_used_step = $2
+		WHERE user_id = $1 AND (last_used_step IS NULL OR last_used_step < $2)
+	`, userId.UUID(), step)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type UserIdentitiesRepo struct {
+	pg  *pgxpool.Pool
+	log *zerolog.Logger
+}
+
+func NewUserIdentitiesRepo(pg *pgxpool.Pool, log *zerolog.Logger) *UserIdentitiesRepo {
+	return &UserIdentitiesRepo{pg: pg, log: log}
+}
+
+func (r *UserIdentitiesRepo) GetUserId(ctx context.Context, issuer string, subject string) (do.UserId, error) {
+	var userIdRaw uuid.UUID
+
+	err := r.pg.QueryRow(ctx, `
+		SELECT user_id
+		FROM user_identities
+		WHERE issuer = $1 AND subject = $2
+	`, issuer, subject).Scan(&userIdRaw)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return do.UserId{}, do.ErrIdentityNotFound
+	}
+	if err != nil {
+		return do.UserId{}, err
+	}
+
+	return do.UserIdFromUUID(userIdRaw)
+}
+
+func (r *UserIdentitiesRepo) Create(ctx context.Context, i *do.UserIdentity) error {
+	_, err := r.pg.Exec(ctx, `
+		INSERT INTO user_identities (issuer, subject, user_id, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (issuer, subject) DO NOTHING
+	`, i.Issuer(), i.Subject(), i.UserId().UUID(), i.CreatedAt())
+	return err
+}
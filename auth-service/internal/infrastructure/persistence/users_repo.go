@@ -3,6 +3,7 @@ package persistence
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -102,3 +103,58 @@ func (r *UsersRepo) GetById(ctx context.Context, id do.UserId) (*do.User, error)
 
 	return do.RehydrateUser(id, email, userName, pwdHash, createdAtRaw), nil
 }
+
+func (r *UsersRepo) UpdatePwdHash(ctx context.Context, id do.UserId, pwdHash do.PwdHash) error {
+	tag, err := r.pg.Exec(ctx, `
+		UPDATE users
+		SET password_hash = $2
+		WHERE id = $1
+	`, id.UUID(), pwdHash.String())
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return do.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UsersRepo) ListIdsByUsernames(ctx context.Context, names []do.UserName) (map[string][]do.UserId, error) {
+	out := make(map[string][]do.UserId, len(names))
+	if len(names) == 0 {
+		return out, nil
+	}
+
+	lowered := make([]string, 0, len(names))
+	for _, n := range names {
+		lowered = append(lowered, strings.ToLower(n.String()))
+	}
+
+	rows, err := r.pg.Query(ctx, `
+		SELECT id, lower(username)
+		FROM users
+		WHERE lower(username) = ANY($1)
+	`, lowered)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var idRaw uuid.UUID
+		var name string
+		if err := rows.Scan(&idRaw, &name); err != nil {
+			return nil, err
+		}
+		id, err := do.UserIdFromUUID(idRaw)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = append(out[name], id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
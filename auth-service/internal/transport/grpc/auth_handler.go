@@ -3,24 +3,72 @@ package grpc
 import (
 	"context"
 	"errors"
+	"net"
+	"strings"
+	"time"
 
 	"github.com/rs/zerolog"
 	do "github.com/smarrog/task-board/auth-service/internal/domain"
 	uc "github.com/smarrog/task-board/auth-service/internal/usecase"
 	v1 "github.com/smarrog/task-board/shared/proto/auth/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const clientIpMetadataKey = "x-client-ip"
+
 type AuthHandler struct {
 	v1.UnimplementedAuthServiceServer
-	log      *zerolog.Logger
-	register *uc.RegisterUseCase
-	login    *uc.LoginUseCase
+	log                *zerolog.Logger
+	register           *uc.RegisterUseCase
+	login              *uc.LoginUseCase
+	verifySecondFactor *uc.VerifySecondFactorUseCase
+	enrollTotp         *uc.EnrollTotpUseCase
+	confirmTotp        *uc.ConfirmTotpUseCase
+	startOidcLogin     *uc.StartOidcLoginUseCase
+	finishOidcLogin    *uc.FinishOidcLoginUseCase
+	createToken        *uc.CreateTokenUseCase
+	listTokens         *uc.ListTokensUseCase
+	revokeToken        *uc.RevokeTokenUseCase
+	introspectToken    *uc.IntrospectTokenUseCase
+	resolveUsernames   *uc.ResolveUsernamesUseCase
 }
 
-func NewAuthHandler(log *zerolog.Logger, register *uc.RegisterUseCase, login *uc.LoginUseCase) *AuthHandler {
-	return &AuthHandler{log: log, register: register, login: login}
+func NewAuthHandler(
+	log *zerolog.Logger,
+	register *uc.RegisterUseCase,
+	login *uc.LoginUseCase,
+	verifySecondFactor *uc.VerifySecondFactorUseCase,
+	enrollTotp *uc.EnrollTotpUseCase,
+	confirmTotp *uc.ConfirmTotpUseCase,
+	startOidcLogin *uc.StartOidcLoginUseCase,
+	finishOidcLogin *uc.FinishOidcLoginUseCase,
+	createToken *uc.CreateTokenUseCase,
+	listTokens *uc.ListTokensUseCase,
+	revokeToken *uc.RevokeTokenUseCase,
+	introspectToken *uc.IntrospectTokenUseCase,
+	resolveUsernames *uc.ResolveUsernamesUseCase,
+) *AuthHandler {
+	return &AuthHandler{
+		log:                log,
+		register:           register,
+		login:              login,
+		verifySecondFactor: verifySecondFactor,
+		enrollTotp:         enrollTotp,
+		confirmTotp:        confirmTotp,
+		startOidcLogin:     startOidcLogin,
+		finishOidcLogin:    finishOidcLogin,
+		createToken:        createToken,
+		listTokens:         listTokens,
+		revokeToken:        revokeToken,
+		introspectToken:    introspectToken,
+		resolveUsernames:   resolveUsernames,
+	}
 }
 
 func (h *AuthHandler) Register(ctx context.Context, req *v1.RegisterRequest) (*v1.RegisterResponse, error) {
@@ -34,31 +82,282 @@ func (h *AuthHandler) Register(ctx context.Context, req *v1.RegisterRequest) (*v
 		}
 	}
 	return &v1.RegisterResponse{
-		User: &v1.User{
-			Id:       out.User.Id().String(),
-			Email:    out.User.Email().String(),
-			Username: out.User.Username().String(),
-		},
+		User:        toUserPb(out.User),
 		AccessToken: out.AccessToken.String(),
 	}, nil
 }
 
 func (h *AuthHandler) Login(ctx context.Context, req *v1.LoginRequest) (*v1.LoginResponse, error) {
-	out, err := h.login.Execute(ctx, uc.LoginInput{Email: req.GetEmail(), Pwd: req.GetPassword()})
+	out, err := h.login.Execute(ctx, uc.LoginInput{Email: req.GetEmail(), Pwd: req.GetPassword(), ClientIp: clientIp(ctx)})
 	if err != nil {
+		var locked *do.LoginLockedError
 		switch {
+		case errors.As(err, &locked):
+			return nil, loginLockedStatus(locked)
 		case errors.Is(err, do.ErrInvalidCredentials):
 			return nil, status.Error(codes.Unauthenticated, "invalid_credentials")
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
+	if out.Challenge != nil {
+		return &v1.LoginResponse{
+			SecondFactorRequired: true,
+			ChallengeId:          out.Challenge.Id().String(),
+		}, nil
+	}
 	return &v1.LoginResponse{
-		User: &v1.User{
-			Id:       out.User.Id().String(),
-			Email:    out.User.Email().String(),
-			Username: out.User.Username().String(),
-		},
+		User:        toUserPb(out.User),
+		AccessToken: out.AccessToken.String(),
+	}, nil
+}
+
+func (h *AuthHandler) VerifySecondFactor(ctx context.Context, req *v1.VerifySecondFactorRequest) (*v1.VerifySecondFactorResponse, error) {
+	out, err := h.verifySecondFactor.Execute(ctx, uc.VerifySecondFactorInput{
+		ChallengeId: req.GetChallengeId(),
+		Code:        req.GetCode(),
+		ClientIp:    clientIp(ctx),
+	})
+	if err != nil {
+		var locked *do.LoginLockedError
+		switch {
+		case errors.As(err, &locked):
+			return nil, loginLockedStatus(locked)
+		case errors.Is(err, do.ErrChallengeNotFound):
+			return nil, status.Error(codes.Unauthenticated, "challenge_expired")
+		case errors.Is(err, do.ErrInvalidSecondFactor):
+			return nil, status.Error(codes.Unauthenticated, "invalid_code")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &v1.VerifySecondFactorResponse{
+		User:        toUserPb(out.User),
 		AccessToken: out.AccessToken.String(),
 	}, nil
 }
+
+func (h *AuthHandler) EnrollTotp(ctx context.Context, req *v1.EnrollTotpRequest) (*v1.EnrollTotpResponse, error) {
+	out, err := h.enrollTotp.Execute(ctx, uc.EnrollTotpInput{UserId: req.GetUserId()})
+	if err != nil {
+		return nil, twoFactorStatus(err)
+	}
+	return &v1.EnrollTotpResponse{
+		Secret:     out.Secret.String(),
+		OtpauthUri: out.OtpauthUri,
+	}, nil
+}
+
+func (h *AuthHandler) ConfirmTotp(ctx context.Context, req *v1.ConfirmTotpRequest) (*v1.ConfirmTotpResponse, error) {
+	out, err := h.confirmTotp.Execute(ctx, uc.ConfirmTotpInput{UserId: req.GetUserId(), Code: req.GetCode()})
+	if err != nil {
+		return nil, twoFactorStatus(err)
+	}
+	return &v1.ConfirmTotpResponse{RecoveryCodes: out.RecoveryCodes}, nil
+}
+
+func (h *AuthHandler) StartOidcLogin(ctx context.Context, _ *v1.StartOidcLoginRequest) (*v1.StartOidcLoginResponse, error) {
+	out, err := h.startOidcLogin.Execute(ctx)
+	if err != nil {
+		return nil, oidcStatus(h.log, err)
+	}
+	return &v1.StartOidcLoginResponse{AuthorizationUrl: out.AuthorizationUrl}, nil
+}
+
+func (h *AuthHandler) FinishOidcLogin(ctx context.Context, req *v1.FinishOidcLoginRequest) (*v1.FinishOidcLoginResponse, error) {
+	out, err := h.finishOidcLogin.Execute(ctx, uc.FinishOidcLoginInput{State: req.GetState(), Code: req.GetCode()})
+	if err != nil {
+		return nil, oidcStatus(h.log, err)
+	}
+	if out.Challenge != nil {
+		return &v1.FinishOidcLoginResponse{
+			SecondFactorRequired: true,
+			ChallengeId:          out.Challenge.Id().String(),
+		}, nil
+	}
+	return &v1.FinishOidcLoginResponse{
+		User:        toUserPb(out.User),
+		AccessToken: out.AccessToken.String(),
+	}, nil
+}
+
+func (h *AuthHandler) CreateToken(ctx context.Context, req *v1.CreateTokenRequest) (*v1.CreateTokenResponse, error) {
+	out, err := h.createToken.Execute(ctx, uc.CreateTokenInput{
+		UserId:        req.GetUserId(),
+		Name:          req.GetName(),
+		Scopes:        req.GetScopes(),
+		ExpiresInDays: int(req.GetExpiresInDays()),
+	})
+	if err != nil {
+		return nil, tokenStatus(err)
+	}
+	return &v1.CreateTokenResponse{Token: toTokenPb(out.Token), Secret: out.Secret}, nil
+}
+
+func (h *AuthHandler) ListTokens(ctx context.Context, req *v1.ListTokensRequest) (*v1.ListTokensResponse, error) {
+	out, err := h.listTokens.Execute(ctx, uc.ListTokensInput{UserId: req.GetUserId()})
+	if err != nil {
+		return nil, tokenStatus(err)
+	}
+
+	tokens := make([]*v1.PersonalAccessToken, 0, len(out.Tokens))
+	for _, t := range out.Tokens {
+		tokens = append(tokens, toTokenPb(t))
+	}
+	return &v1.ListTokensResponse{Tokens: tokens}, nil
+}
+
+func (h *AuthHandler) RevokeToken(ctx context.Context, req *v1.RevokeTokenRequest) (*v1.RevokeTokenResponse, error) {
+	if err := h.revokeToken.Execute(ctx, uc.RevokeTokenInput{UserId: req.GetUserId(), TokenId: req.GetTokenId()}); err != nil {
+		return nil, tokenStatus(err)
+	}
+	return &v1.RevokeTokenResponse{}, nil
+}
+
+func (h *AuthHandler) IntrospectToken(ctx context.Context, req *v1.IntrospectTokenRequest) (*v1.IntrospectTokenResponse, error) {
+	out, err := h.introspectToken.Execute(ctx, uc.IntrospectTokenInput{Token: req.GetToken()})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !out.Active {
+		return &v1.IntrospectTokenResponse{Active: false}, nil
+	}
+	return &v1.IntrospectTokenResponse{
+		Active:    true,
+		UserId:    out.Token.UserId().String(),
+		Scopes:    scopesToStrings(out.Token.Scopes()),
+		ExpiresAt: timeToPb(out.Token.ExpiresAt()),
+	}, nil
+}
+
+func (h *AuthHandler) ResolveUsernames(ctx context.Context, req *v1.ResolveUsernamesRequest) (*v1.ResolveUsernamesResponse, error) {
+	out, err := h.resolveUsernames.Execute(ctx, uc.ResolveUsernamesInput{Usernames: req.GetUsernames()})
+	if err != nil {
+		if errors.Is(err, do.ErrTooManyUserNames) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	users := make([]*v1.ResolvedUsername, 0, len(out.Users))
+	for _, u := range out.Users {
+		users = append(users, &v1.ResolvedUsername{Username: u.Username, UserId: u.UserId.String()})
+	}
+	return &v1.ResolveUsernamesResponse{Users: users}, nil
+}
+
+func toTokenPb(t *do.PersonalAccessToken) *v1.PersonalAccessToken {
+	return &v1.PersonalAccessToken{
+		Id:         t.Id().String(),
+		Name:       t.Name().String(),
+		Scopes:     scopesToStrings(t.Scopes()),
+		CreatedAt:  timeToPb(t.CreatedAt()),
+		ExpiresAt:  timeToPb(t.ExpiresAt()),
+		LastUsedAt: timeToPb(t.LastUsedAt()),
+	}
+}
+
+func scopesToStrings(scopes []do.TokenScope) []string {
+	out := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		out = append(out, s.String())
+	}
+	return out
+}
+
+func timeToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func tokenStatus(err error) error {
+	switch {
+	case errors.Is(err, do.ErrTokenNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, do.ErrUserIdRequired),
+		errors.Is(err, do.ErrTokenNameIsEmpty),
+		errors.Is(err, do.ErrTokenNameIsToLong),
+		errors.Is(err, do.ErrInvalidTokenScope),
+		errors.Is(err, do.ErrTokenScopesRequired),
+		errors.Is(err, do.ErrInvalidTokenTTL):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toUserPb(u *do.User) *v1.User {
+	return &v1.User{
+		Id:       u.Id().String(),
+		Email:    u.Email().String(),
+		Username: u.Username().String(),
+	}
+}
+
+func twoFactorStatus(err error) error {
+	switch {
+	case errors.Is(err, do.ErrUserIdRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, do.ErrUserNotFound), errors.Is(err, do.ErrTwoFactorNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, do.ErrTwoFactorAlreadyEnabled):
+		return status.Error(codes.FailedPrecondition, "two_factor_already_enabled")
+	case errors.Is(err, do.ErrInvalidSecondFactor):
+		return status.Error(codes.InvalidArgument, "invalid_code")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// oidcStatus не отдаёт клиенту подробности ответа провайдера — они только в логе.
+func oidcStatus(log *zerolog.Logger, err error) error {
+	switch {
+	case errors.Is(err, do.ErrOidcDisabled):
+		return status.Error(codes.Unimplemented, "oidc_disabled")
+	case errors.Is(err, do.ErrOidcInvalidState):
+		return status.Error(codes.InvalidArgument, "invalid_state")
+	case errors.Is(err, do.ErrOidcEmailNotVerified):
+		return status.Error(codes.PermissionDenied, "email_not_verified")
+	case errors.Is(err, do.ErrOidcInvalidIdToken), errors.Is(err, do.ErrOidcExchange):
+		log.Warn().Err(err).Msg("oidc login rejected")
+		return status.Error(codes.Unauthenticated, "oidc_login_failed")
+	case errors.Is(err, do.ErrInvalidEmail), errors.Is(err, do.ErrUserNameIsToShort), errors.Is(err, do.ErrUserNameIsToLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		log.Error().Err(err).Msg("oidc login failed")
+		return status.Error(codes.Internal, "internal")
+	}
+}
+
+func loginLockedStatus(locked *do.LoginLockedError) error {
+	st := status.New(codes.ResourceExhausted, "too_many_attempts")
+
+	delay := time.Until(locked.RetryAt)
+	if delay < 0 {
+		delay = 0
+	}
+	if withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// clientIp берёт адрес клиента из метаданных gateway, а при их отсутствии — адрес peer-а.
+func clientIp(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(clientIpMetadataKey); len(vals) > 0 && strings.TrimSpace(vals[0]) != "" {
+			return strings.TrimSpace(vals[0])
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	return ""
+}
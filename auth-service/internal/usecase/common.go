@@ -1,24 +1,23 @@
 package usecase
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	do "github.com/smarrog/task-board/auth-service/internal/domain"
-	"golang.org/x/crypto/bcrypt"
 )
 
-func getPwdHash(pwd do.Pwd) (do.PwdHash, error) {
-	pwdHashBytes, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
-	if err != nil {
-		return "", fmt.Errorf("%w: %v", do.ErrPwdHashGeneration, err)
-	}
-	return do.NewPwdHash(string(pwdHashBytes))
+type Clock interface {
+	Now() time.Time
 }
 
-func getAccessToken(subject string, secret string, ttl time.Duration) (do.AccessToken, error) {
-	now := time.Now().UTC()
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time { return time.Now().UTC() }
+
+func getAccessToken(subject string, secret string, ttl time.Duration, now time.Time) (do.AccessToken, error) {
 	claims := jwt.RegisteredClaims{
 		Subject:   subject,
 		IssuedAt:  jwt.NewNumericDate(now),
@@ -32,3 +31,31 @@ func getAccessToken(subject string, secret string, ttl time.Duration) (do.Access
 
 	return do.NewAccessToken(raw)
 }
+
+// startSecondFactor заводит LoginChallenge, если у пользователя включена 2FA; nil — второй фактор
+// не нужен. Через него проходит любой вход, после которого выдаётся токен: пароль, OIDC.
+func startSecondFactor(
+	ctx context.Context,
+	twoFactors do.TwoFactorRepository,
+	challenges do.LoginChallengeRepository,
+	userId do.UserId,
+	now time.Time,
+	ttl time.Duration,
+) (*do.LoginChallenge, error) {
+	tf, err := twoFactors.Get(ctx, userId)
+	if errors.Is(err, do.ErrTwoFactorNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !tf.IsEnabled() {
+		return nil, nil
+	}
+
+	c := do.NewLoginChallenge(userId, now, ttl)
+	if err := challenges.Create(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/auth-service/internal/config"
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type ConfirmTotpUseCase struct {
+	twoFactors do.TwoFactorRepository
+	clock      Clock
+	cfg        *config.Config
+}
+
+type ConfirmTotpInput struct {
+	UserId string
+	Code   string
+}
+
+type ConfirmTotpOutput struct {
+	// RecoveryCodes показываются пользователю один раз, в базе остаются только хэши.
+	RecoveryCodes []string
+}
+
+func NewConfirmTotpUseCase(twoFactors do.TwoFactorRepository, clock Clock, cfg *config.Config) *ConfirmTotpUseCase {
+	return &ConfirmTotpUseCase{twoFactors: twoFactors, clock: clock, cfg: cfg}
+}
+
+func (uc *ConfirmTotpUseCase) Execute(ctx context.Context, input ConfirmTotpInput) (*ConfirmTotpOutput, error) {
+	userId, err := do.UserIdFromString(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	tf, err := uc.twoFactors.Get(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if err := tf.Confirm(input.Code, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, uc.cfg.RecoveryCodesCount)
+	hashes := make([]do.RecoveryCodeHash, 0, uc.cfg.RecoveryCodesCount)
+	for range uc.cfg.RecoveryCodesCount {
+		code, err := do.NewRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, do.HashRecoveryCode(code))
+	}
+
+	if err := uc.twoFactors.ReplaceRecoveryCodes(ctx, userId, hashes); err != nil {
+		return nil, err
+	}
+	if err := uc.twoFactors.Save(ctx, tf); err != nil {
+		return nil, err
+	}
+
+	return &ConfirmTotpOutput{RecoveryCodes: codes}, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type CreateTokenUseCase struct {
+	tokens do.PersonalAccessTokenRepository
+	clock  Clock
+}
+
+type CreateTokenInput struct {
+	UserId string
+	Name   string
+	Scopes []string
+	// ExpiresInDays = 0 — бессрочный токен, иначе не больше do.MaxTokenTTLDays.
+	ExpiresInDays int
+}
+
+type CreateTokenOutput struct {
+	Token *do.PersonalAccessToken
+	// Secret отдаётся только при создании, потом восстановить его нельзя.
+	Secret string
+}
+
+func NewCreateTokenUseCase(tokens do.PersonalAccessTokenRepository, clock Clock) *CreateTokenUseCase {
+	return &CreateTokenUseCase{tokens: tokens, clock: clock}
+}
+
+func (uc *CreateTokenUseCase) Execute(ctx context.Context, input CreateTokenInput) (*CreateTokenOutput, error) {
+	userId, err := do.UserIdFromString(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+	name, err := do.NewTokenName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := do.NewTokenScopes(input.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	// проверяем до перевода в Duration: большое число дней переполнило бы int64 наносекунд
+	if input.ExpiresInDays < 0 || input.ExpiresInDays > do.MaxTokenTTLDays {
+		return nil, do.ErrInvalidTokenTTL
+	}
+
+	t, secret, err := do.NewPersonalAccessToken(userId, name, scopes, uc.clock.Now(), time.Duration(input.ExpiresInDays)*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tokens.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return &CreateTokenOutput{Token: t, Secret: secret}, nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type memTokens struct {
+	mu     sync.Mutex
+	tokens []*do.PersonalAccessToken
+}
+
+func (r *memTokens) Create(_ context.Context, t *do.PersonalAccessToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens = append(r.tokens, t)
+	return nil
+}
+
+func (r *memTokens) GetByHash(context.Context, do.TokenHash) (*do.PersonalAccessToken, error) {
+	return nil, do.ErrTokenNotFound
+}
+
+func (r *memTokens) ListByUser(context.Context, do.UserId) ([]*do.PersonalAccessToken, error) {
+	return nil, nil
+}
+
+func (r *memTokens) Revoke(context.Context, do.UserId, do.TokenId, time.Time) error { return nil }
+
+func (r *memTokens) TouchLastUsed(context.Context, do.TokenId, time.Time) error { return nil }
+
+func TestCreateTokenExpiry(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0).UTC()}
+	userId := do.NewUserId().String()
+
+	cases := []struct {
+		name    string
+		days    int
+		wantErr bool
+		want    time.Time
+	}{
+		{"no expiry", 0, false, time.Time{}},
+		{"one day", 1, false, clock.now.Add(24 * time.Hour)},
+		{"max", do.MaxTokenTTLDays, false, clock.now.AddDate(0, 0, do.MaxTokenTTLDays)},
+		{"negative", -1, true, time.Time{}},
+		{"over max", do.MaxTokenTTLDays + 1, true, time.Time{}},
+		// без проверки такое число дней переполняло Duration и давало срок в прошлом
+		{"int32 max", math.MaxInt32, true, time.Time{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			uc := NewCreateTokenUseCase(&memTokens{}, clock)
+			out, err := uc.Execute(context.Background(), CreateTokenInput{
+				UserId:        userId,
+				Name:          "ci",
+				Scopes:        []string{"read"},
+				ExpiresInDays: c.days,
+			})
+			if c.wantErr {
+				if !errors.Is(err, do.ErrInvalidTokenTTL) {
+					t.Fatalf("want ErrInvalidTokenTTL, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+			if !out.Token.ExpiresAt().Equal(c.want) {
+				t.Fatalf("expires at %v, want %v", out.Token.ExpiresAt(), c.want)
+			}
+		})
+	}
+}
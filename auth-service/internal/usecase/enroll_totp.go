@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smarrog/task-board/auth-service/internal/config"
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type EnrollTotpUseCase struct {
+	repo       do.Repository
+	twoFactors do.TwoFactorRepository
+	clock      Clock
+	cfg        *config.Config
+}
+
+type EnrollTotpInput struct {
+	UserId string
+}
+
+type EnrollTotpOutput struct {
+	Secret     do.TotpSecret
+	OtpauthUri string
+}
+
+func NewEnrollTotpUseCase(repo do.Repository, twoFactors do.TwoFactorRepository, clock Clock, cfg *config.Config) *EnrollTotpUseCase {
+	return &EnrollTotpUseCase{repo: repo, twoFactors: twoFactors, clock: clock, cfg: cfg}
+}
+
+// Execute выдаёт новый секрет. Повторный вызов до подтверждения заменяет прежний секрет,
+// включённую 2FA так перезаписать нельзя.
+func (uc *EnrollTotpUseCase) Execute(ctx context.Context, input EnrollTotpInput) (*EnrollTotpOutput, error) {
+	userId, err := do.UserIdFromString(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := uc.repo.GetById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.twoFactors.Get(ctx, u.Id())
+	if err != nil && !errors.Is(err, do.ErrTwoFactorNotFound) {
+		return nil, err
+	}
+	if existing != nil && existing.IsEnabled() {
+		return nil, do.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := do.NewTotpSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	tf := do.NewTwoFactor(u.Id(), secret, uc.clock.Now())
+	if err := uc.twoFactors.Save(ctx, tf); err != nil {
+		return nil, err
+	}
+
+	return &EnrollTotpOutput{
+		Secret:     secret,
+		OtpauthUri: secret.OtpauthUri(uc.cfg.TotpIssuer, u.Email().String()),
+	}, nil
+}
@@ -0,0 +1,360 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/smarrog/task-board/auth-service/internal/config"
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+// Хранилища в памяти повторяют контракты репозиториев из domain/repository.go.
+
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+type memUsers struct {
+	mu    sync.Mutex
+	users map[do.UserId]*do.User
+}
+
+func newMemUsers() *memUsers { return &memUsers{users: map[do.UserId]*do.User{}} }
+
+func (r *memUsers) Create(_ context.Context, u *do.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.users {
+		if strings.EqualFold(existing.Email().String(), u.Email().String()) {
+			return do.ErrEmailAlreadyExists
+		}
+	}
+	r.users[u.Id()] = u
+	return nil
+}
+
+func (r *memUsers) GetById(_ context.Context, id do.UserId) (*do.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return nil, do.ErrUserNotFound
+	}
+	return u, nil
+}
+
+func (r *memUsers) GetByEmail(_ context.Context, email do.Email) (*do.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if strings.EqualFold(u.Email().String(), email.String()) {
+			return u, nil
+		}
+	}
+	return nil, do.ErrUserNotFound
+}
+
+func (r *memUsers) UpdatePwdHash(_ context.Context, id do.UserId, pwdHash do.PwdHash) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[id]
+	if !ok {
+		return do.ErrUserNotFound
+	}
+	u.ChangePwdHash(pwdHash)
+	return nil
+}
+
+func (r *memUsers) ListIdsByUsernames(_ context.Context, names []do.UserName) (map[string][]do.UserId, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := map[string][]do.UserId{}
+	for _, n := range names {
+		key := strings.ToLower(n.String())
+		for _, u := range r.users {
+			if strings.ToLower(u.Username().String()) == key {
+				out[key] = append(out[key], u.Id())
+			}
+		}
+	}
+	return out, nil
+}
+
+type memTwoFactors struct {
+	mu       sync.Mutex
+	factors  map[do.UserId]*do.TwoFactor
+	recovery map[do.UserId]map[do.RecoveryCodeHash]bool
+	// onGet вызывается после чтения в Get; гонки через него выстраивают чтения до записей.
+	onGet func()
+}
+
+func newMemTwoFactors() *memTwoFactors {
+	return &memTwoFactors{
+		factors:  map[do.UserId]*do.TwoFactor{},
+		recovery: map[do.UserId]map[do.RecoveryCodeHash]bool{},
+	}
+}
+
+func (r *memTwoFactors) Get(_ context.Context, userId do.UserId) (*do.TwoFactor, error) {
+	f, err := r.get(userId)
+	if r.onGet != nil {
+		r.onGet()
+	}
+	return f, err
+}
+
+func (r *memTwoFactors) get(userId do.UserId) (*do.TwoFactor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.factors[userId]
+	if !ok {
+		return nil, do.ErrTwoFactorNotFound
+	}
+	// как и настоящий репозиторий, отдаём копию: изменения видны только после Save
+	return do.RehydrateTwoFactor(f.UserId(), f.Secret(), f.ConfirmedAt(), f.LastUsedStep(), f.CreatedAt()), nil
+}
+
+func (r *memTwoFactors) Save(_ context.Context, f *do.TwoFactor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factors[f.UserId()] = do.RehydrateTwoFactor(f.UserId(), f.Secret(), f.ConfirmedAt(), f.LastUsedStep(), f.CreatedAt())
+	return nil
+}
+
+func (r *memTwoFactors) ReplaceRecoveryCodes(_ context.Context, userId do.UserId, hashes []do.RecoveryCodeHash) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	codes := make(map[do.RecoveryCodeHash]bool, len(hashes))
+	for _, h := range hashes {
+		codes[h] = false
+	}
+	r.recovery[userId] = codes
+	return nil
+}
+
+func (r *memTwoFactors) UseRecoveryCode(_ context.Context, userId do.UserId, hash do.RecoveryCodeHash) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	used, ok := r.recovery[userId][hash]
+	if !ok || used {
+		return false, nil
+	}
+	r.recovery[userId][hash] = true
+	return true, nil
+}
+
+func (r *memTwoFactors) ConsumeStep(_ context.Context, userId do.UserId, step int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.factors[userId]
+	if !ok || f.LastUsedStep() >= step {
+		return false, nil
+	}
+	r.factors[userId] = do.RehydrateTwoFactor(f.UserId(), f.Secret(), f.ConfirmedAt(), step, f.CreatedAt())
+	return true, nil
+}
+
+type memChallenges struct {
+	mu         sync.Mutex
+	challenges map[do.ChallengeId]*do.LoginChallenge
+}
+
+func newMemChallenges() *memChallenges {
+	return &memChallenges{challenges: map[do.ChallengeId]*do.LoginChallenge{}}
+}
+
+func (r *memChallenges) Create(_ context.Context, c *do.LoginChallenge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.challenges[c.Id()] = c
+	return nil
+}
+
+func (r *memChallenges) Get(_ context.Context, id do.ChallengeId) (*do.LoginChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.challenges[id]
+	if !ok {
+		return nil, do.ErrChallengeNotFound
+	}
+	return do.RehydrateLoginChallenge(c.Id(), c.UserId(), c.Attempts(), c.ExpiresAt()), nil
+}
+
+func (r *memChallenges) RegisterAttempt(_ context.Context, id do.ChallengeId) (*do.LoginChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.challenges[id]
+	if !ok {
+		return nil, do.ErrChallengeNotFound
+	}
+	c = do.RehydrateLoginChallenge(c.Id(), c.UserId(), c.Attempts()+1, c.ExpiresAt())
+	r.challenges[id] = c
+	return c, nil
+}
+
+func (r *memChallenges) Delete(_ context.Context, id do.ChallengeId) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.challenges[id]; !ok {
+		return do.ErrChallengeNotFound
+	}
+	delete(r.challenges, id)
+	return nil
+}
+
+type memThrottles struct {
+	mu        sync.Mutex
+	throttles map[do.ThrottleKey]*do.LoginThrottle
+}
+
+func newMemThrottles() *memThrottles {
+	return &memThrottles{throttles: map[do.ThrottleKey]*do.LoginThrottle{}}
+}
+
+func (r *memThrottles) Get(_ context.Context, key do.ThrottleKey) (*do.LoginThrottle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.throttles[key]; ok {
+		return t, nil
+	}
+	return do.NewLoginThrottle(key), nil
+}
+
+func (r *memThrottles) RegisterFailure(_ context.Context, key do.ThrottleKey, now, windowStart time.Time) (*do.LoginThrottle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	failures := 1
+	var lockedUntil time.Time
+	if t, ok := r.throttles[key]; ok {
+		lockedUntil = t.LockedUntil()
+		failures = t.Failures() + 1
+		if t.LastFailureAt().Before(windowStart) && !t.IsLocked(now) {
+			failures = 1
+		}
+	}
+	t := do.RehydrateLoginThrottle(key, failures, now, lockedUntil)
+	r.throttles[key] = t
+	return t, nil
+}
+
+func (r *memThrottles) Lock(_ context.Context, key do.ThrottleKey, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.throttles[key]
+	if !ok {
+		return nil
+	}
+	if until.After(t.LockedUntil()) {
+		r.throttles[key] = do.RehydrateLoginThrottle(key, t.Failures(), t.LastFailureAt(), until)
+	}
+	return nil
+}
+
+func (r *memThrottles) Delete(_ context.Context, key do.ThrottleKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.throttles, key)
+	return nil
+}
+
+// plainHasher хранит пароль как есть: алгоритмы хэширования проверяются отдельно.
+type plainHasher struct{}
+
+func (plainHasher) Hash(pwd do.Pwd) (do.PwdHash, error) {
+	return do.PwdHash("plain:" + pwd.String()), nil
+}
+
+func (plainHasher) Verify(pwd string, hash do.PwdHash) (bool, error) {
+	return hash.String() == "plain:"+pwd, nil
+}
+
+func (plainHasher) NeedsRehash(do.PwdHash) bool { return false }
+
+func testConfig() *config.Config {
+	return &config.Config{
+		JWTSecret:                 "test-secret",
+		AccessTokenTTL:            time.Hour,
+		LoginMaxFailures:          5,
+		LoginIpMaxFailures:        20,
+		LoginFailureWindow:        15 * time.Minute,
+		LoginLockoutBase:          time.Minute,
+		LoginLockoutMax:           time.Hour,
+		TotpIssuer:                "task-board",
+		LoginChallengeTTL:         5 * time.Minute,
+		LoginChallengeMaxAttempts: 3,
+		RecoveryCodesCount:        4,
+		OidcAuthRequestTTL:        10 * time.Minute,
+	}
+}
+
+// authEnv — общее окружение сценариев входа.
+type authEnv struct {
+	clock      *fakeClock
+	cfg        *config.Config
+	users      *memUsers
+	twoFactors *memTwoFactors
+	challenges *memChallenges
+	throttles  *memThrottles
+	log        zerolog.Logger
+}
+
+func newAuthEnv() *authEnv {
+	return &authEnv{
+		clock:      &fakeClock{now: time.Unix(1_700_000_000, 0).UTC()},
+		cfg:        testConfig(),
+		users:      newMemUsers(),
+		twoFactors: newMemTwoFactors(),
+		challenges: newMemChallenges(),
+		throttles:  newMemThrottles(),
+		log:        zerolog.Nop(),
+	}
+}
+
+func (e *authEnv) addUser(t *testing.T, email, pwd string) *do.User {
+	t.Helper()
+	hash, err := plainHasher{}.Hash(do.Pwd(pwd))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := do.NewUser(do.Email(email), do.UserName(strings.SplitN(email, "@", 2)[0]), hash)
+	if err := e.users.Create(context.Background(), u); err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+// enableTotp включает пользователю 2FA и возвращает секрет и recovery-коды.
+func (e *authEnv) enableTotp(t *testing.T, u *do.User) (do.TotpSecret, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	enrolled, err := NewEnrollTotpUseCase(e.users, e.twoFactors, e.clock, e.cfg).Execute(ctx, EnrollTotpInput{UserId: u.Id().String()})
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+	confirmed, err := NewConfirmTotpUseCase(e.twoFactors, e.clock, e.cfg).Execute(ctx, ConfirmTotpInput{
+		UserId: u.Id().String(),
+		Code:   enrolled.Secret.Code(do.TotpStep(e.clock.Now())),
+	})
+	if err != nil {
+		t.Fatalf("confirm: %v", err)
+	}
+	// код подтверждения уже израсходован — дальше работаем со следующего шага
+	e.clock.Advance(do.TotpPeriod)
+	return enrolled.Secret, confirmed.RecoveryCodes
+}
+
+func (e *authEnv) login() *LoginUseCase {
+	return NewLoginUseCase(e.users, e.twoFactors, e.challenges, e.throttles, plainHasher{}, e.clock, e.cfg, &e.log)
+}
+
+func (e *authEnv) verifySecondFactor() *VerifySecondFactorUseCase {
+	return NewVerifySecondFactorUseCase(e.users, e.twoFactors, e.challenges, e.throttles, e.clock, e.cfg)
+}
@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/smarrog/task-board/auth-service/internal/config"
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type FinishOidcLoginUseCase struct {
+	repo       do.Repository
+	identities do.UserIdentityRepository
+	requests   do.OidcAuthRequestRepository
+	twoFactors do.TwoFactorRepository
+	challenges do.LoginChallengeRepository
+	provider   do.IdentityProvider
+	clock      Clock
+	cfg        *config.Config
+}
+
+type FinishOidcLoginInput struct {
+	State string
+	Code  string
+}
+
+type FinishOidcLoginOutput struct {
+	User        *do.User
+	AccessToken do.AccessToken
+	// Challenge заполнен вместо AccessToken, если у пользователя включена 2FA.
+	Challenge *do.LoginChallenge
+}
+
+func NewFinishOidcLoginUseCase(
+	repo do.Repository,
+	identities do.UserIdentityRepository,
+	requests do.OidcAuthRequestRepository,
+	twoFactors do.TwoFactorRepository,
+	challenges do.LoginChallengeRepository,
+	provider do.IdentityProvider,
+	clock Clock,
+	cfg *config.Config,
+) *FinishOidcLoginUseCase {
+	return &FinishOidcLoginUseCase{
+		repo:       repo,
+		identities: identities,
+		requests:   requests,
+		twoFactors: twoFactors,
+		challenges: challenges,
+		provider:   provider,
+		clock:      clock,
+		cfg:        cfg,
+	}
+}
+
+func (uc *FinishOidcLoginUseCase) Execute(ctx context.Context, input FinishOidcLoginInput) (*FinishOidcLoginOutput, error) {
+	if uc.provider == nil {
+		return nil, do.ErrOidcDisabled
+	}
+	if strings.TrimSpace(input.State) == "" || strings.TrimSpace(input.Code) == "" {
+		return nil, do.ErrOidcInvalidState
+	}
+
+	now := uc.clock.Now()
+
+	r, err := uc.requests.Take(ctx, input.State)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsExpired(now) {
+		return nil, do.ErrOidcInvalidState
+	}
+
+	ext, err := uc.provider.Exchange(ctx, input.Code, r.CodeVerifier(), r.Nonce())
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := uc.resolveUser(ctx, ext)
+	if err != nil {
+		return nil, err
+	}
+
+	// провайдер подтверждает только первый фактор, включённая 2FA обязательна и здесь
+	c, err := startSecondFactor(ctx, uc.twoFactors, uc.challenges, u.Id(), now, uc.cfg.LoginChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		return &FinishOidcLoginOutput{User: u, Challenge: c}, nil
+	}
+
+	token, err := getAccessToken(u.Id().String(), uc.cfg.JWTSecret, uc.cfg.AccessTokenTTL, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FinishOidcLoginOutput{User: u, AccessToken: token}, nil
+}
+
+// resolveUser находит пользователя по привязанной учётке провайдера, иначе по email,
+// а если такого нет — заводит нового. Привязка по email допустима только для подтверждённого
+// провайдером адреса, иначе можно было бы войти в чужой аккаунт.
+func (uc *FinishOidcLoginUseCase) resolveUser(ctx context.Context, ext *do.ExternalIdentity) (*do.User, error) {
+	userId, err := uc.identities.GetUserId(ctx, ext.Issuer, ext.Subject)
+	if err == nil {
+		return uc.repo.GetById(ctx, userId)
+	}
+	if !errors.Is(err, do.ErrIdentityNotFound) {
+		return nil, err
+	}
+
+	if !ext.EmailVerified {
+		return nil, do.ErrOidcEmailNotVerified
+	}
+	email, err := do.NewEmail(ext.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := uc.repo.GetByEmail(ctx, email)
+	if errors.Is(err, do.ErrUserNotFound) {
+		u, err = uc.provision(ctx, email, ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.identities.Create(ctx, do.NewUserIdentity(ext.Issuer, ext.Subject, u.Id(), uc.clock.Now())); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// provision заводит пользователя без пароля: войти он сможет только через провайдера.
+func (uc *FinishOidcLoginUseCase) provision(ctx context.Context, email do.Email, ext *do.ExternalIdentity) (*do.User, error) {
+	userName, err := do.NewUserName(ssoUserName(email, ext))
+	if err != nil {
+		return nil, err
+	}
+
+	u := do.NewUser(email, userName, "")
+	if err := uc.repo.Create(ctx, u); err != nil {
+		if errors.Is(err, do.ErrEmailAlreadyExists) {
+			// параллельный вход того же пользователя успел его создать
+			return uc.repo.GetByEmail(ctx, email)
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+func ssoUserName(email do.Email, ext *do.ExternalIdentity) string {
+	candidates := []string{ext.PreferredUsername, ext.Name, strings.SplitN(email.String(), "@", 2)[0]}
+	for _, c := range candidates {
+		c = strings.TrimSpace(c)
+		if len(c) > do.MaxUserNameLength {
+			c = strings.TrimSpace(strings.ToValidUTF8(c[:do.MaxUserNameLength], ""))
+		}
+		if len(c) >= do.MinUserNameLength {
+			return c
+		}
+	}
+	return "user-" + ext.Subject[:min(len(ext.Subject), 8)]
+}
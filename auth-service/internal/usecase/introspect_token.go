@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+// lastUsedPrecision — last_used_at нужен для списка токенов, писать его на каждый запрос незачем.
+const lastUsedPrecision = time.Minute
+
+type IntrospectTokenUseCase struct {
+	tokens do.PersonalAccessTokenRepository
+	clock  Clock
+}
+
+type IntrospectTokenInput struct {
+	Token string
+}
+
+type IntrospectTokenOutput struct {
+	Active bool
+	Token  *do.PersonalAccessToken
+}
+
+func NewIntrospectTokenUseCase(tokens do.PersonalAccessTokenRepository, clock Clock) *IntrospectTokenUseCase {
+	return &IntrospectTokenUseCase{tokens: tokens, clock: clock}
+}
+
+func (uc *IntrospectTokenUseCase) Execute(ctx context.Context, input IntrospectTokenInput) (*IntrospectTokenOutput, error) {
+	raw := strings.TrimSpace(input.Token)
+	if !strings.HasPrefix(raw, do.PersonalTokenPrefix) {
+		return &IntrospectTokenOutput{Active: false}, nil
+	}
+
+	t, err := uc.tokens.GetByHash(ctx, do.HashPersonalToken(raw))
+	if errors.Is(err, do.ErrTokenNotFound) {
+		return &IntrospectTokenOutput{Active: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	if !t.IsActive(now) {
+		return &IntrospectTokenOutput{Active: false}, nil
+	}
+
+	if now.Sub(t.LastUsedAt()) >= lastUsedPrecision {
+		if err := uc.tokens.TouchLastUsed(ctx, t.Id(), now); err != nil {
+			return nil, err
+		}
+	}
+
+	return &IntrospectTokenOutput{Active: true, Token: t}, nil
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type ListTokensUseCase struct {
+	tokens do.PersonalAccessTokenRepository
+}
+
+type ListTokensInput struct {
+	UserId string
+}
+
+type ListTokensOutput struct {
+	Tokens []*do.PersonalAccessToken
+}
+
+func NewListTokensUseCase(tokens do.PersonalAccessTokenRepository) *ListTokensUseCase {
+	return &ListTokensUseCase{tokens: tokens}
+}
+
+func (uc *ListTokensUseCase) Execute(ctx context.Context, input ListTokensInput) (*ListTokensOutput, error) {
+	userId, err := do.UserIdFromString(input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := uc.tokens.ListByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListTokensOutput{Tokens: tokens}, nil
+}
@@ -2,30 +2,59 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/rs/zerolog"
+
 	"github.com/smarrog/task-board/auth-service/internal/config"
 	do "github.com/smarrog/task-board/auth-service/internal/domain"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type LoginUseCase struct {
-	repo do.Repository
-	cfg  *config.Config
+	repo       do.Repository
+	twoFactors do.TwoFactorRepository
+	challenges do.LoginChallengeRepository
+	throttler  *loginThrottler
+	hasher     do.PasswordHasher
+	clock      Clock
+	cfg        *config.Config
+	log        *zerolog.Logger
 }
 
 type LoginInput struct {
-	Email string
-	Pwd   string
+	Email    string
+	Pwd      string
+	ClientIp string
 }
 
 type LoginOutput struct {
 	User        *do.User
 	AccessToken do.AccessToken
+	// Challenge заполнен вместо AccessToken, если у пользователя включена 2FA.
+	Challenge *do.LoginChallenge
 }
 
-func NewLoginUseCase(repo do.Repository, cfg *config.Config) *LoginUseCase {
-	return &LoginUseCase{repo: repo, cfg: cfg}
+func NewLoginUseCase(
+	repo do.Repository,
+	twoFactors do.TwoFactorRepository,
+	challenges do.LoginChallengeRepository,
+	throttles do.LoginThrottleRepository,
+	hasher do.PasswordHasher,
+	clock Clock,
+	cfg *config.Config,
+	log *zerolog.Logger,
+) *LoginUseCase {
+	return &LoginUseCase{
+		repo:       repo,
+		twoFactors: twoFactors,
+		challenges: challenges,
+		throttler:  newLoginThrottler(throttles, cfg),
+		hasher:     hasher,
+		clock:      clock,
+		cfg:        cfg,
+		log:        log,
+	}
 }
 
 func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*LoginOutput, error) {
@@ -34,19 +63,76 @@ func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*LoginOu
 		return nil, err
 	}
 
-	u, err := uc.repo.GetByEmail(ctx, email)
+	now := uc.clock.Now()
+	targets := uc.throttler.targets(email, input.ClientIp)
 
+	if err := uc.throttler.check(ctx, targets, now); err != nil {
+		return nil, err
+	}
+
+	u, err := uc.repo.GetByEmail(ctx, email)
 	if err != nil {
+		if errors.Is(err, do.ErrUserNotFound) {
+			if tErr := uc.throttler.registerFailure(ctx, targets, now); tErr != nil {
+				return nil, tErr
+			}
+		}
 		return nil, fmt.Errorf("%w: %v", do.ErrInvalidCredentials, err)
 	}
-	if err := bcrypt.CompareHashAndPassword([]byte(u.PwdHash().String()), []byte(input.Pwd)); err != nil {
-		return nil, fmt.Errorf("%w: %v", do.ErrInvalidCredentials, err)
+	ok, err := uc.hasher.Verify(input.Pwd, u.PwdHash())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if tErr := uc.throttler.registerFailure(ctx, targets, now); tErr != nil {
+			return nil, tErr
+		}
+		return nil, do.ErrInvalidCredentials
+	}
+
+	uc.rehash(ctx, u, input.Pwd)
+
+	c, err := startSecondFactor(ctx, uc.twoFactors, uc.challenges, u.Id(), now, uc.cfg.LoginChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		// счётчик аккаунта сбросим только после второго фактора, иначе знание пароля
+		// позволило бы перебирать коды без ограничений
+		return &LoginOutput{User: u, Challenge: c}, nil
 	}
 
-	token, err := getAccessToken(u.Id().String(), uc.cfg.JWTSecret, uc.cfg.AccessTokenTTL)
+	if err := uc.throttler.reset(ctx, email); err != nil {
+		return nil, err
+	}
+
+	token, err := getAccessToken(u.Id().String(), uc.cfg.JWTSecret, uc.cfg.AccessTokenTTL, now)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", do.ErrInvalidCredentials, err)
 	}
 
 	return &LoginOutput{User: u, AccessToken: token}, nil
 }
+
+// rehash переводит хэш на текущий алгоритм, пока у нас есть открытый пароль.
+// Ошибка входу не мешает: попробуем снова при следующем логине.
+func (uc *LoginUseCase) rehash(ctx context.Context, u *do.User, rawPwd string) {
+	if !uc.hasher.NeedsRehash(u.PwdHash()) {
+		return
+	}
+
+	pwdHash, err := uc.hasher.Hash(do.Pwd(rawPwd))
+	if errors.Is(err, do.ErrPwdIsToLong) {
+		// bcrypt не принимает пароли длиннее 72 байт — оставляем прежний хэш
+		return
+	}
+	if err != nil {
+		uc.log.Warn().Err(err).Str("user_id", u.Id().String()).Msg("password rehash failed")
+		return
+	}
+	if err := uc.repo.UpdatePwdHash(ctx, u.Id(), pwdHash); err != nil {
+		uc.log.Warn().Err(err).Str("user_id", u.Id().String()).Msg("failed to save rehashed password")
+		return
+	}
+	u.ChangePwdHash(pwdHash)
+}
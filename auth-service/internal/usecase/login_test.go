@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+// migratingHasher проверяет как plainHasher, но считает каждый хэш устаревшим.
+type migratingHasher struct {
+	plainHasher
+	hashErr error
+}
+
+func (h migratingHasher) Hash(pwd do.Pwd) (do.PwdHash, error) {
+	if h.hashErr != nil {
+		return "", h.hashErr
+	}
+	return do.PwdHash("new:" + pwd.String()), nil
+}
+
+func (migratingHasher) NeedsRehash(hash do.PwdHash) bool {
+	return !strings.HasPrefix(hash.String(), "new:")
+}
+
+func TestLoginRehashesOutdatedHash(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+
+	login := NewLoginUseCase(env.users, env.twoFactors, env.challenges, env.throttles, migratingHasher{}, env.clock, env.cfg, &env.log)
+	if _, err := login.Execute(context.Background(), LoginInput{Email: "alice@example.com", Pwd: "password-1"}); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if got := u.PwdHash().String(); got != "new:password-1" {
+		t.Fatalf("hash was not migrated: %s", got)
+	}
+}
+
+func TestLoginLogsRehashFailure(t *testing.T) {
+	cases := []struct {
+		name   string
+		err    error
+		logged bool
+	}{
+		{"hash error", errors.New("boom"), true},
+		// пароль длиннее лимита bcrypt — штатная ситуация, старый хэш остаётся
+		{"password too long for algorithm", do.ErrPwdIsToLong, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newAuthEnv()
+			var buf bytes.Buffer
+			env.log = zerolog.New(&buf)
+			u := env.addUser(t, "alice@example.com", "password-1")
+
+			login := NewLoginUseCase(env.users, env.twoFactors, env.challenges, env.throttles, migratingHasher{hashErr: c.err}, env.clock, env.cfg, &env.log)
+			out, err := login.Execute(context.Background(), LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+			if err != nil {
+				t.Fatalf("rehash failure must not break login: %v", err)
+			}
+			if out.AccessToken == "" {
+				t.Fatal("token is missing")
+			}
+			if u.PwdHash().String() != "plain:password-1" {
+				t.Fatal("old hash must be kept")
+			}
+			if logged := strings.Contains(buf.String(), "password rehash failed"); logged != c.logged {
+				t.Fatalf("logged = %v, want %v; log: %s", logged, c.logged, buf.String())
+			}
+		})
+	}
+}
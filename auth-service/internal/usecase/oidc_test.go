@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type memIdentities struct {
+	mu         sync.Mutex
+	identities map[[2]string]do.UserId
+}
+
+func newMemIdentities() *memIdentities {
+	return &memIdentities{identities: map[[2]string]do.UserId{}}
+}
+
+func (r *memIdentities) GetUserId(_ context.Context, issuer string, subject string) (do.UserId, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id, ok := r.identities[[2]string{issuer, subject}]
+	if !ok {
+		return do.UserId{}, do.ErrIdentityNotFound
+	}
+	return id, nil
+}
+
+func (r *memIdentities) Create(_ context.Context, i *do.UserIdentity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.identities[[2]string{i.Issuer(), i.Subject()}] = i.UserId()
+	return nil
+}
+
+type memOidcRequests struct {
+	mu       sync.Mutex
+	requests map[string]*do.OidcAuthRequest
+}
+
+func newMemOidcRequests() *memOidcRequests {
+	return &memOidcRequests{requests: map[string]*do.OidcAuthRequest{}}
+}
+
+func (r *memOidcRequests) Create(_ context.Context, req *do.OidcAuthRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests[req.State()] = req
+	return nil
+}
+
+func (r *memOidcRequests) Take(_ context.Context, state string) (*do.OidcAuthRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, ok := r.requests[state]
+	if !ok {
+		return nil, do.ErrOidcInvalidState
+	}
+	delete(r.requests, state)
+	return req, nil
+}
+
+// stubProvider отдаёт заранее заданную учётку и проверяет, что в обмен ушли verifier и nonce.
+type stubProvider struct {
+	identity *do.ExternalIdentity
+	verifier string
+	nonce    string
+}
+
+func (p *stubProvider) Issuer() string { return "https://idp.example.com" }
+
+func (p *stubProvider) AuthCodeUrl(_ context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	return "https://idp.example.com/authorize?state=" + state, nil
+}
+
+func (p *stubProvider) Exchange(_ context.Context, code string, codeVerifier string, nonce string) (*do.ExternalIdentity, error) {
+	if code != "good-code" {
+		return nil, do.ErrOidcExchange
+	}
+	p.verifier, p.nonce = codeVerifier, nonce
+	return p.identity, nil
+}
+
+type oidcEnv struct {
+	*authEnv
+	identities *memIdentities
+	requests   *memOidcRequests
+	provider   *stubProvider
+}
+
+func newOidcEnv(identity *do.ExternalIdentity) *oidcEnv {
+	return &oidcEnv{
+		authEnv:    newAuthEnv(),
+		identities: newMemIdentities(),
+		requests:   newMemOidcRequests(),
+		provider:   &stubProvider{identity: identity},
+	}
+}
+
+// start проходит первый шаг и возвращает state из сохранённого запроса.
+func (e *oidcEnv) start(t *testing.T) *do.OidcAuthRequest {
+	t.Helper()
+	if _, err := NewStartOidcLoginUseCase(e.provider, e.requests, e.clock, e.cfg).Execute(context.Background()); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	for _, r := range e.requests.requests {
+		return r
+	}
+	t.Fatal("auth request was not stored")
+	return nil
+}
+
+func (e *oidcEnv) finish() *FinishOidcLoginUseCase {
+	return NewFinishOidcLoginUseCase(e.users, e.identities, e.requests, e.twoFactors, e.challenges, e.provider, e.clock, e.cfg)
+}
+
+func verifiedIdentity() *do.ExternalIdentity {
+	return &do.ExternalIdentity{
+		Issuer:            "https://idp.example.com",
+		Subject:           "sub-1",
+		Email:             "alice@example.com",
+		EmailVerified:     true,
+		PreferredUsername: "alice",
+	}
+}
+
+func TestFinishOidcLoginProvisionsUser(t *testing.T) {
+	env := newOidcEnv(verifiedIdentity())
+	req := env.start(t)
+
+	out, err := env.finish().Execute(context.Background(), FinishOidcLoginInput{State: req.State(), Code: "good-code"})
+	if err != nil {
+		t.Fatalf("finish: %v", err)
+	}
+	if out.AccessToken == "" || out.Challenge != nil {
+		t.Fatalf("want token, got %+v", out)
+	}
+	if out.User.Username() != "alice" {
+		t.Fatalf("username = %s", out.User.Username())
+	}
+	if env.provider.verifier != req.CodeVerifier() || env.provider.nonce != req.Nonce() {
+		t.Fatal("exchange must use the stored verifier and nonce")
+	}
+
+	// state одноразовый
+	_, err = env.finish().Execute(context.Background(), FinishOidcLoginInput{State: req.State(), Code: "good-code"})
+	if !errors.Is(err, do.ErrOidcInvalidState) {
+		t.Fatalf("reused state: %v", err)
+	}
+}
+
+func TestFinishOidcLoginLinksExistingUserByVerifiedEmail(t *testing.T) {
+	env := newOidcEnv(verifiedIdentity())
+	u := env.addUser(t, "alice@example.com", "password-1")
+
+	out, err := env.finish().Execute(context.Background(), FinishOidcLoginInput{State: env.start(t).State(), Code: "good-code"})
+	if err != nil {
+		t.Fatalf("finish: %v", err)
+	}
+	if out.User.Id() != u.Id() {
+		t.Fatal("must log into the existing account")
+	}
+	if id, err := env.identities.GetUserId(context.Background(), "https://idp.example.com", "sub-1"); err != nil || id != u.Id() {
+		t.Fatalf("identity not linked: %v", err)
+	}
+}
+
+func TestFinishOidcLoginRejectsUnverifiedEmail(t *testing.T) {
+	ident := verifiedIdentity()
+	ident.EmailVerified = false
+	env := newOidcEnv(ident)
+	env.addUser(t, "alice@example.com", "password-1")
+
+	_, err := env.finish().Execute(context.Background(), FinishOidcLoginInput{State: env.start(t).State(), Code: "good-code"})
+	if !errors.Is(err, do.ErrOidcEmailNotVerified) {
+		t.Fatalf("unverified email: %v", err)
+	}
+}
+
+func TestFinishOidcLoginRejectsExpiredState(t *testing.T) {
+	env := newOidcEnv(verifiedIdentity())
+	req := env.start(t)
+	env.clock.Advance(env.cfg.OidcAuthRequestTTL)
+
+	_, err := env.finish().Execute(context.Background(), FinishOidcLoginInput{State: req.State(), Code: "good-code"})
+	if !errors.Is(err, do.ErrOidcInvalidState) {
+		t.Fatalf("expired state: %v", err)
+	}
+}
+
+func TestFinishOidcLoginRequiresSecondFactor(t *testing.T) {
+	env := newOidcEnv(verifiedIdentity())
+	u := env.addUser(t, "alice@example.com", "password-1")
+	secret, _ := env.enableTotp(t, u)
+	ctx := context.Background()
+
+	out, err := env.finish().Execute(ctx, FinishOidcLoginInput{State: env.start(t).State(), Code: "good-code"})
+	if err != nil {
+		t.Fatalf("finish: %v", err)
+	}
+	if out.AccessToken != "" {
+		t.Fatal("token must not be issued before the second factor")
+	}
+	if out.Challenge == nil {
+		t.Fatal("challenge is missing")
+	}
+
+	verified, err := env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{
+		ChallengeId: out.Challenge.Id().String(),
+		Code:        secret.Code(do.TotpStep(env.clock.Now())),
+	})
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if verified.User.Id() != u.Id() || verified.AccessToken == "" {
+		t.Fatalf("unexpected verify output %+v", verified)
+	}
+}
+
+func TestOidcDisabled(t *testing.T) {
+	env := newOidcEnv(verifiedIdentity())
+
+	if _, err := NewStartOidcLoginUseCase(nil, env.requests, env.clock, env.cfg).Execute(context.Background()); !errors.Is(err, do.ErrOidcDisabled) {
+		t.Fatalf("start: %v", err)
+	}
+	finish := NewFinishOidcLoginUseCase(env.users, env.identities, env.requests, env.twoFactors, env.challenges, nil, env.clock, env.cfg)
+	if _, err := finish.Execute(context.Background(), FinishOidcLoginInput{State: "s", Code: "c"}); !errors.Is(err, do.ErrOidcDisabled) {
+		t.Fatalf("finish: %v", err)
+	}
+}
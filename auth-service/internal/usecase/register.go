@@ -2,14 +2,16 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/smarrog/task-board/auth-service/internal/config"
 	do "github.com/smarrog/task-board/auth-service/internal/domain"
 )
 
 type RegisterUseCase struct {
-	repo do.Repository
-	cfg  *config.Config
+	repo   do.Repository
+	hasher do.PasswordHasher
+	cfg    *config.Config
 }
 
 type RegisterInput struct {
@@ -23,8 +25,8 @@ type RegisterOutput struct {
 	AccessToken do.AccessToken
 }
 
-func NewRegisterUseCase(repo do.Repository, cfg *config.Config) *RegisterUseCase {
-	return &RegisterUseCase{repo: repo, cfg: cfg}
+func NewRegisterUseCase(repo do.Repository, hasher do.PasswordHasher, cfg *config.Config) *RegisterUseCase {
+	return &RegisterUseCase{repo: repo, hasher: hasher, cfg: cfg}
 }
 
 func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*RegisterOutput, error) {
@@ -40,7 +42,7 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*R
 	if err != nil {
 		return nil, err
 	}
-	pwdHash, err := getPwdHash(pwd)
+	pwdHash, err := uc.hasher.Hash(pwd)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +52,7 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*R
 		return nil, err
 	}
 
-	token, err := getAccessToken(u.Id().String(), uc.cfg.JWTSecret, uc.cfg.AccessTokenTTL)
+	token, err := getAccessToken(u.Id().String(), uc.cfg.JWTSecret, uc.cfg.AccessTokenTTL, time.Now().UTC())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+const MaxResolveUsernames = 100
+
+type ResolveUsernamesUseCase struct {
+	repo do.Repository
+}
+
+type ResolveUsernamesInput struct {
+	Usernames []string
+}
+
+type ResolvedUsername struct {
+	Username string
+	UserId   do.UserId
+}
+
+type ResolveUsernamesOutput struct {
+	Users []ResolvedUsername
+}
+
+func NewResolveUsernamesUseCase(repo do.Repository) *ResolveUsernamesUseCase {
+	return &ResolveUsernamesUseCase{repo: repo}
+}
+
+func (uc *ResolveUsernamesUseCase) Execute(ctx context.Context, input ResolveUsernamesInput) (*ResolveUsernamesOutput, error) {
+	if len(input.Usernames) > MaxResolveUsernames {
+		return nil, do.ErrTooManyUserNames
+	}
+
+	names := make([]do.UserName, 0, len(input.Usernames))
+	requested := make(map[string]string, len(input.Usernames))
+	for _, raw := range input.Usernames {
+		name, err := do.NewUserName(raw)
+		if err != nil {
+			// такого имени не может быть ни у кого
+			continue
+		}
+		key := strings.ToLower(name.String())
+		if _, ok := requested[key]; ok {
+			continue
+		}
+		requested[key] = raw
+		names = append(names, name)
+	}
+
+	found, err := uc.repo.ListIdsByUsernames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ResolveUsernamesOutput{Users: make([]ResolvedUsername, 0, len(found))}
+	for _, name := range names {
+		key := strings.ToLower(name.String())
+		// имена не уникальны: неоднозначное упоминание лучше пропустить, чем уведомить не того
+		if ids := found[key]; len(ids) == 1 {
+			out.Users = append(out.Users, ResolvedUsername{Username: requested[key], UserId: ids[0]})
+		}
+	}
+
+	return out, nil
+}
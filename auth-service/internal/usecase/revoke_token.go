@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type RevokeTokenUseCase struct {
+	tokens do.PersonalAccessTokenRepository
+	clock  Clock
+}
+
+type RevokeTokenInput struct {
+	UserId  string
+	TokenId string
+}
+
+func NewRevokeTokenUseCase(tokens do.PersonalAccessTokenRepository, clock Clock) *RevokeTokenUseCase {
+	return &RevokeTokenUseCase{tokens: tokens, clock: clock}
+}
+
+func (uc *RevokeTokenUseCase) Execute(ctx context.Context, input RevokeTokenInput) error {
+	userId, err := do.UserIdFromString(input.UserId)
+	if err != nil {
+		return err
+	}
+	tokenId, err := do.TokenIdFromString(input.TokenId)
+	if err != nil {
+		return err
+	}
+
+	return uc.tokens.Revoke(ctx, userId, tokenId, uc.clock.Now())
+}
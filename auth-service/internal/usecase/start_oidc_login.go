@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/auth-service/internal/config"
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type StartOidcLoginUseCase struct {
+	provider do.IdentityProvider
+	requests do.OidcAuthRequestRepository
+	clock    Clock
+	cfg      *config.Config
+}
+
+type StartOidcLoginOutput struct {
+	AuthorizationUrl string
+}
+
+func NewStartOidcLoginUseCase(
+	provider do.IdentityProvider,
+	requests do.OidcAuthRequestRepository,
+	clock Clock,
+	cfg *config.Config,
+) *StartOidcLoginUseCase {
+	return &StartOidcLoginUseCase{provider: provider, requests: requests, clock: clock, cfg: cfg}
+}
+
+func (uc *StartOidcLoginUseCase) Execute(ctx context.Context) (*StartOidcLoginOutput, error) {
+	if uc.provider == nil {
+		return nil, do.ErrOidcDisabled
+	}
+
+	r, err := do.NewOidcAuthRequest(uc.clock.Now(), uc.cfg.OidcAuthRequestTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	authUrl, err := uc.provider.AuthCodeUrl(ctx, r.State(), r.Nonce(), r.CodeChallenge())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.requests.Create(ctx, r); err != nil {
+		return nil, err
+	}
+
+	return &StartOidcLoginOutput{AuthorizationUrl: authUrl}, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/smarrog/task-board/auth-service/internal/config"
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type throttleTarget struct {
+	key    do.ThrottleKey
+	policy do.ThrottlePolicy
+}
+
+type loginThrottler struct {
+	repo do.LoginThrottleRepository
+	cfg  *config.Config
+}
+
+func newLoginThrottler(repo do.LoginThrottleRepository, cfg *config.Config) *loginThrottler {
+	return &loginThrottler{repo: repo, cfg: cfg}
+}
+
+func (t *loginThrottler) targets(email do.Email, clientIp string) []throttleTarget {
+	targets := []throttleTarget{{
+		key: do.NewAccountThrottleKey(email),
+		policy: do.ThrottlePolicy{
+			MaxFailures: t.cfg.LoginMaxFailures,
+			Window:      t.cfg.LoginFailureWindow,
+			BaseLockout: t.cfg.LoginLockoutBase,
+			MaxLockout:  t.cfg.LoginLockoutMax,
+		},
+	}}
+
+	if key, ok := do.NewIpThrottleKey(clientIp); ok {
+		targets = append(targets, throttleTarget{
+			key: key,
+			policy: do.ThrottlePolicy{
+				MaxFailures: t.cfg.LoginIpMaxFailures,
+				Window:      t.cfg.LoginFailureWindow,
+				BaseLockout: t.cfg.LoginLockoutBase,
+				MaxLockout:  t.cfg.LoginLockoutMax,
+			},
+		})
+	}
+
+	return targets
+}
+
+func (t *loginThrottler) check(ctx context.Context, targets []throttleTarget, now time.Time) error {
+	var retryAt time.Time
+	for _, target := range targets {
+		th, err := t.repo.Get(ctx, target.key)
+		if err != nil {
+			return err
+		}
+		if th.IsLocked(now) && th.LockedUntil().After(retryAt) {
+			retryAt = th.LockedUntil()
+		}
+	}
+
+	if !retryAt.IsZero() {
+		return &do.LoginLockedError{RetryAt: retryAt}
+	}
+	return nil
+}
+
+func (t *loginThrottler) registerFailure(ctx context.Context, targets []throttleTarget, now time.Time) error {
+	for _, target := range targets {
+		th, err := t.repo.RegisterFailure(ctx, target.key, now, now.Add(-target.policy.Window))
+		if err != nil {
+			return err
+		}
+
+		if until, ok := th.LockUntil(now, target.policy); ok {
+			if err := t.repo.Lock(ctx, target.key, until); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reset сбрасывает только счётчик аккаунта: счётчик по IP не обнуляем,
+// иначе один валидный аккаунт обнулял бы перебор по чужим.
+func (t *loginThrottler) reset(ctx context.Context, email do.Email) error {
+	return t.repo.Delete(ctx, do.NewAccountThrottleKey(email))
+}
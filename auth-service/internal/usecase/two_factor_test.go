@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+func TestEnrollTotp(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+	ctx := context.Background()
+	enroll := NewEnrollTotpUseCase(env.users, env.twoFactors, env.clock, env.cfg)
+
+	first, err := enroll.Execute(ctx, EnrollTotpInput{UserId: u.Id().String()})
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+	if first.OtpauthUri == "" {
+		t.Fatal("otpauth uri is empty")
+	}
+
+	// до подтверждения секрет можно перевыпустить
+	second, err := enroll.Execute(ctx, EnrollTotpInput{UserId: u.Id().String()})
+	if err != nil {
+		t.Fatalf("re-enroll: %v", err)
+	}
+	if second.Secret.String() == first.Secret.String() {
+		t.Fatal("re-enroll must issue a new secret")
+	}
+
+	confirm := NewConfirmTotpUseCase(env.twoFactors, env.clock, env.cfg)
+	_, err = confirm.Execute(ctx, ConfirmTotpInput{
+		UserId: u.Id().String(),
+		Code:   first.Secret.Code(do.TotpStep(env.clock.Now())),
+	})
+	if !errors.Is(err, do.ErrInvalidSecondFactor) {
+		t.Fatalf("confirm with replaced secret: %v", err)
+	}
+
+	out, err := confirm.Execute(ctx, ConfirmTotpInput{
+		UserId: u.Id().String(),
+		Code:   second.Secret.Code(do.TotpStep(env.clock.Now())),
+	})
+	if err != nil {
+		t.Fatalf("confirm: %v", err)
+	}
+	if len(out.RecoveryCodes) != env.cfg.RecoveryCodesCount {
+		t.Fatalf("got %d recovery codes, want %d", len(out.RecoveryCodes), env.cfg.RecoveryCodesCount)
+	}
+
+	if _, err := enroll.Execute(ctx, EnrollTotpInput{UserId: u.Id().String()}); !errors.Is(err, do.ErrTwoFactorAlreadyEnabled) {
+		t.Fatalf("enroll after confirm: %v", err)
+	}
+}
+
+func TestLoginWithoutTwoFactorIssuesToken(t *testing.T) {
+	env := newAuthEnv()
+	env.addUser(t, "alice@example.com", "password-1")
+
+	out, err := env.login().Execute(context.Background(), LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if out.Challenge != nil || out.AccessToken == "" {
+		t.Fatalf("want token without challenge, got %+v", out)
+	}
+}
+
+func TestLoginWithTwoFactorRequiresChallenge(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+	secret, _ := env.enableTotp(t, u)
+	ctx := context.Background()
+
+	out, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if out.AccessToken != "" {
+		t.Fatal("token must not be issued before the second factor")
+	}
+	if out.Challenge == nil {
+		t.Fatal("challenge is missing")
+	}
+
+	verified, err := env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{
+		ChallengeId: out.Challenge.Id().String(),
+		Code:        secret.Code(do.TotpStep(env.clock.Now())),
+	})
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if verified.AccessToken == "" || verified.User.Id() != u.Id() {
+		t.Fatalf("unexpected verify output %+v", verified)
+	}
+
+	// челлендж одноразовый
+	_, err = env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{
+		ChallengeId: out.Challenge.Id().String(),
+		Code:        secret.Code(do.TotpStep(env.clock.Now()) + 1),
+	})
+	if !errors.Is(err, do.ErrChallengeNotFound) {
+		t.Fatalf("reused challenge: %v", err)
+	}
+}
+
+func TestVerifySecondFactorRejectsReplayedCode(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+	secret, _ := env.enableTotp(t, u)
+	ctx := context.Background()
+	code := secret.Code(do.TotpStep(env.clock.Now()))
+
+	first, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{ChallengeId: first.Challenge.Id().String(), Code: code}); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+
+	second, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{ChallengeId: second.Challenge.Id().String(), Code: code})
+	if !errors.Is(err, do.ErrInvalidSecondFactor) {
+		t.Fatalf("replayed code: %v", err)
+	}
+}
+
+func TestVerifySecondFactorWithRecoveryCode(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+	_, recovery := env.enableTotp(t, u)
+	ctx := context.Background()
+
+	first, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{
+		ChallengeId: first.Challenge.Id().String(),
+		Code:        "  " + recovery[0] + " ",
+	}); err != nil {
+		t.Fatalf("recovery code: %v", err)
+	}
+
+	second, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{
+		ChallengeId: second.Challenge.Id().String(),
+		Code:        recovery[0],
+	})
+	if !errors.Is(err, do.ErrInvalidSecondFactor) {
+		t.Fatalf("reused recovery code: %v", err)
+	}
+}
+
+func TestVerifySecondFactorExhaustsAttempts(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+	secret, _ := env.enableTotp(t, u)
+	ctx := context.Background()
+
+	out, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// код далеко за окном допуска
+	wrong := secret.Code(do.TotpStep(env.clock.Now()) + 10)
+
+	for i := 0; i < env.cfg.LoginChallengeMaxAttempts; i++ {
+		_, err := env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{ChallengeId: out.Challenge.Id().String(), Code: wrong})
+		if !errors.Is(err, do.ErrInvalidSecondFactor) {
+			t.Fatalf("attempt %d: %v", i+1, err)
+		}
+	}
+
+	_, err = env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{
+		ChallengeId: out.Challenge.Id().String(),
+		Code:        secret.Code(do.TotpStep(env.clock.Now())),
+	})
+	if !errors.Is(err, do.ErrChallengeNotFound) {
+		t.Fatalf("challenge must be dropped after max attempts: %v", err)
+	}
+}
+
+func TestVerifySecondFactorExpiredChallenge(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+	secret, _ := env.enableTotp(t, u)
+	ctx := context.Background()
+
+	out, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	env.clock.Advance(env.cfg.LoginChallengeTTL)
+
+	_, err = env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{
+		ChallengeId: out.Challenge.Id().String(),
+		Code:        secret.Code(do.TotpStep(env.clock.Now())),
+	})
+	if !errors.Is(err, do.ErrChallengeNotFound) {
+		t.Fatalf("expired challenge: %v", err)
+	}
+}
+
+func TestVerifySecondFactorFailuresLockAccount(t *testing.T) {
+	env := newAuthEnv()
+	env.cfg.LoginMaxFailures = 2
+	u := env.addUser(t, "alice@example.com", "password-1")
+	secret, _ := env.enableTotp(t, u)
+	ctx := context.Background()
+	wrong := secret.Code(do.TotpStep(env.clock.Now()) + 10)
+
+	for i := 0; i < env.cfg.LoginMaxFailures; i++ {
+		out, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+		if err != nil {
+			t.Fatalf("login %d: %v", i+1, err)
+		}
+		_, err = env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{ChallengeId: out.Challenge.Id().String(), Code: wrong})
+		if !errors.Is(err, do.ErrInvalidSecondFactor) {
+			t.Fatalf("verify %d: %v", i+1, err)
+		}
+	}
+
+	// перебор кодов упирается в тот же счётчик, что и перебор паролей
+	_, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if !errors.Is(err, do.ErrLoginLocked) {
+		t.Fatalf("login after failed codes: %v", err)
+	}
+}
+
+// parallel запускает fn в n горутинах и собирает ошибки. Пока идут вызовы, каждое
+// прочитавший 2FA ждёт, пока прочитают все n: так все проверки видят состояние до чьей-либо записи.
+func (e *authEnv) parallel(n int, fn func() error) []error {
+	var read sync.WaitGroup
+	read.Add(n)
+	e.twoFactors.onGet = func() {
+		read.Done()
+		read.Wait()
+	}
+	defer func() { e.twoFactors.onGet = nil }()
+
+	errs := make([]error, n)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			errs[i] = fn()
+		}()
+	}
+	close(start)
+	wg.Wait()
+	return errs
+}
+
+func TestVerifySecondFactorConcurrentSameCodeIssuesOneToken(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+	secret, recovery := env.enableTotp(t, u)
+	ctx := context.Background()
+	code := secret.Code(do.TotpStep(env.clock.Now()))
+
+	// каждый вход со своим челленджем: гонку решает только гашение шага TOTP;
+	// проигравших меньше LoginMaxFailures, чтобы блокировка аккаунта не вмешалась
+	var challenges []string
+	for range 4 {
+		out, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		challenges = append(challenges, out.Challenge.Id().String())
+	}
+	var next atomic.Int32
+	errs := env.parallel(len(challenges), func() error {
+		_, err := env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{ChallengeId: challenges[next.Add(1)-1], Code: code})
+		return err
+	})
+	assertOneSuccess(t, errs, do.ErrInvalidSecondFactor)
+
+	// один челлендж и два разных верных кода: токен получает только тот, кто погасил челлендж
+	out, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes := []string{recovery[0], recovery[1]}
+	next.Store(0)
+	errs = env.parallel(len(codes), func() error {
+		_, err := env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{ChallengeId: out.Challenge.Id().String(), Code: codes[next.Add(1)-1]})
+		return err
+	})
+	assertOneSuccess(t, errs, do.ErrChallengeNotFound)
+}
+
+func TestVerifySecondFactorConcurrentWrongCodesExhaustAttempts(t *testing.T) {
+	env := newAuthEnv()
+	u := env.addUser(t, "alice@example.com", "password-1")
+	secret, _ := env.enableTotp(t, u)
+	ctx := context.Background()
+
+	out, err := env.login().Execute(ctx, LoginInput{Email: "alice@example.com", Pwd: "password-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrong := secret.Code(do.TotpStep(env.clock.Now()) + 10)
+
+	errs := env.parallel(env.cfg.LoginChallengeMaxAttempts, func() error {
+		_, err := env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{ChallengeId: out.Challenge.Id().String(), Code: wrong})
+		return err
+	})
+	for _, err := range errs {
+		if !errors.Is(err, do.ErrInvalidSecondFactor) {
+			t.Fatalf("wrong code: %v", err)
+		}
+	}
+
+	_, err = env.verifySecondFactor().Execute(ctx, VerifySecondFactorInput{
+		ChallengeId: out.Challenge.Id().String(),
+		Code:        secret.Code(do.TotpStep(env.clock.Now())),
+	})
+	if !errors.Is(err, do.ErrChallengeNotFound) {
+		t.Fatalf("challenge must be dropped after max attempts: %v", err)
+	}
+}
+
+func assertOneSuccess(t *testing.T, errs []error, loserErr error) {
+	t.Helper()
+	ok := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			ok++
+		case !errors.Is(err, loserErr):
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if ok != 1 {
+		t.Fatalf("%d of %d calls succeeded, want exactly one", ok, len(errs))
+	}
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/smarrog/task-board/auth-service/internal/config"
+	do "github.com/smarrog/task-board/auth-service/internal/domain"
+)
+
+type VerifySecondFactorUseCase struct {
+	repo       do.Repository
+	twoFactors do.TwoFactorRepository
+	challenges do.LoginChallengeRepository
+	throttler  *loginThrottler
+	clock      Clock
+	cfg        *config.Config
+}
+
+type VerifySecondFactorInput struct {
+	ChallengeId string
+	Code        string
+	ClientIp    string
+}
+
+type VerifySecondFactorOutput struct {
+	User        *do.User
+	AccessToken do.AccessToken
+}
+
+func NewVerifySecondFactorUseCase(
+	repo do.Repository,
+	twoFactors do.TwoFactorRepository,
+	challenges do.LoginChallengeRepository,
+	throttles do.LoginThrottleRepository,
+	clock Clock,
+	cfg *config.Config,
+) *VerifySecondFactorUseCase {
+	return &VerifySecondFactorUseCase{
+		repo:       repo,
+		twoFactors: twoFactors,
+		challenges: challenges,
+		throttler:  newLoginThrottler(throttles, cfg),
+		clock:      clock,
+		cfg:        cfg,
+	}
+}
+
+func (uc *VerifySecondFactorUseCase) Execute(ctx context.Context, input VerifySecondFactorInput) (*VerifySecondFactorOutput, error) {
+	challengeId, err := do.ChallengeIdFromString(input.ChallengeId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+
+	c, err := uc.challenges.Get(ctx, challengeId)
+	if err != nil {
+		return nil, err
+	}
+	if c.IsExpired(now) {
+		if err := uc.challenges.Delete(ctx, c.Id()); err != nil {
+			return nil, err
+		}
+		return nil, do.ErrChallengeNotFound
+	}
+
+	u, err := uc.repo.GetById(ctx, c.UserId())
+	if err != nil {
+		return nil, err
+	}
+
+	targets := uc.throttler.targets(u.Email(), input.ClientIp)
+	if err := uc.throttler.check(ctx, targets, now); err != nil {
+		return nil, err
+	}
+
+	tf, err := uc.twoFactors.Get(ctx, u.Id())
+	if err != nil {
+		return nil, err
+	}
+	if !tf.IsEnabled() {
+		return nil, do.ErrTwoFactorNotFound
+	}
+
+	ok, err := uc.verify(ctx, tf, strings.TrimSpace(input.Code), now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := uc.throttler.registerFailure(ctx, targets, now); err != nil {
+			return nil, err
+		}
+		c, err = uc.challenges.RegisterAttempt(ctx, c.Id())
+		if err != nil {
+			return nil, err
+		}
+		if !c.HasAttemptsLeft(uc.cfg.LoginChallengeMaxAttempts) {
+			// челлендж могла уже удалить параллельная попытка — это не ошибка
+			if err := uc.challenges.Delete(ctx, c.Id()); err != nil && !errors.Is(err, do.ErrChallengeNotFound) {
+				return nil, err
+			}
+		}
+		return nil, do.ErrInvalidSecondFactor
+	}
+
+	// удаление гасит челлендж: из параллельных верных кодов токен получит только первый
+	if err := uc.challenges.Delete(ctx, c.Id()); err != nil {
+		return nil, err
+	}
+	if err := uc.throttler.reset(ctx, u.Email()); err != nil {
+		return nil, err
+	}
+
+	token, err := getAccessToken(u.Id().String(), uc.cfg.JWTSecret, uc.cfg.AccessTokenTTL, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifySecondFactorOutput{User: u, AccessToken: token}, nil
+}
+
+func (uc *VerifySecondFactorUseCase) verify(ctx context.Context, tf *do.TwoFactor, code string, now time.Time) (bool, error) {
+	if do.IsTotpCode(code) {
+		if !tf.VerifyCode(code, now) {
+			return false, nil
+		}
+		// проверка выше шла по прочитанному шагу; гасим его атомарно, иначе параллельный
+		// вход с тем же кодом тоже прошёл бы
+		return uc.twoFactors.ConsumeStep(ctx, tf.UserId(), tf.LastUsedStep())
+	}
+	return uc.twoFactors.UseRecoveryCode(ctx, tf.UserId(), do.HashRecoveryCode(code))
+}
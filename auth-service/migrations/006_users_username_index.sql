@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS idx_users_username_lower ON users(lower(username));
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_username_lower;
\ No newline at end of file
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/core-service/internal/config"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/logger"
+)
+
+// Перенумеровывает живые колонки подряд с нуля и раздаёт задачам равномерные ключи
+// порядка, сохраняя текущий порядок. Нужен для досок, где удаления до компактизации
+// позиций оставили дыры, и для колонок, где ключи задач разрослись.
+// Ограничение уникальности позиций колонок отложенное, поэтому хватает одного UPDATE.
+
+const renumberColumnsSQL = `
+	UPDATE columns c
+	SET position = r.pos, version = c.version + 1
+	FROM (
+		SELECT id, ROW_NUMBER() OVER (PARTITION BY board_id ORDER BY position, created_at, id) - 1 AS pos
+		FROM columns
+		WHERE deleted_at IS NULL
+	) r
+	WHERE c.id = r.id AND c.position <> r.pos
+`
+
+func main() {
+	cfg := config.Load()
+	dsn := cfg.PostgresDSN
+	log := logger.New("core-repair-positions", zerolog.DebugLevel)
+
+	if dsn == "" {
+		log.Fatal().Msg("POSTGRES_DSN is empty")
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to database")
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	columns, tasks, err := renumber(ctx, db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Repair failed")
+	}
+
+	// кэш досок не сбрасываем: он живёт REDIS_CACHE_TTL
+	log.Info().Int64("columns", columns).Int64("tasks", tasks).Msg("positions repaired")
+}
+
+func renumber(ctx context.Context, db *sql.DB) (int64, int64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// параллельные перемещения подождут, пока идёт починка
+	if _, err := tx.ExecContext(ctx, `LOCK TABLE columns, tasks IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return 0, 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, renumberColumnsSQL)
+	if err != nil {
+		return 0, 0, err
+	}
+	columns, err := res.RowsAffected()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tasks, err := rebalanceTasks(ctx, tx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return columns, tasks, tx.Commit()
+}
+
+// rebalanceTasks переписывает sort_key всех задач, включая корзину, колонка за колонкой.
+func rebalanceTasks(ctx context.Context, tx *sql.Tx) (int64, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT column_id, id FROM tasks ORDER BY column_id, sort_key, id`)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var order []string
+	byColumn := make(map[string][]string)
+	for rows.Next() {
+		var columnId, id string
+		if err := rows.Scan(&columnId, &id); err != nil {
+			return 0, err
+		}
+		if _, ok := byColumn[columnId]; !ok {
+			order = append(order, columnId)
+		}
+		byColumn[columnId] = append(byColumn[columnId], id)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, columnId := range order {
+		ids := byColumn[columnId]
+		keys := make([]string, 0, len(ids))
+		for _, k := range task.SpreadSortKeys(len(ids)) {
+			keys = append(keys, k.String())
+		}
+
+		res, err := tx.ExecContext(ctx, `
+			UPDATE tasks t SET sort_key = k.sort_key
+			FROM unnest($1::uuid[], $2::text[]) AS k(id, sort_key)
+			WHERE t.id = k.id AND t.sort_key <> k.sort_key
+		`, ids, keys)
+		if err != nil {
+			return 0, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		total += n
+	}
+	return total, nil
+}
@@ -3,24 +3,42 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 	"github.com/smarrog/task-board/core-service/internal/config"
+	attachmentdo "github.com/smarrog/task-board/core-service/internal/domain/attachment"
 	boarddo "github.com/smarrog/task-board/core-service/internal/domain/board"
+	checklistdo "github.com/smarrog/task-board/core-service/internal/domain/checklist"
 	columndo "github.com/smarrog/task-board/core-service/internal/domain/column"
+	commentdo "github.com/smarrog/task-board/core-service/internal/domain/comment"
+	labeldo "github.com/smarrog/task-board/core-service/internal/domain/label"
 	taskdo "github.com/smarrog/task-board/core-service/internal/domain/task"
+	templatedo "github.com/smarrog/task-board/core-service/internal/domain/template"
+	watcherdo "github.com/smarrog/task-board/core-service/internal/domain/watcher"
+	appauth "github.com/smarrog/task-board/core-service/internal/infrastructure/auth"
+	"github.com/smarrog/task-board/core-service/internal/infrastructure/blob"
 	appcache "github.com/smarrog/task-board/core-service/internal/infrastructure/cache"
 	appkafka "github.com/smarrog/task-board/core-service/internal/infrastructure/kafka"
 	"github.com/smarrog/task-board/core-service/internal/infrastructure/persistence"
 	"github.com/smarrog/task-board/core-service/internal/transport/grpc"
+	attachmentuc "github.com/smarrog/task-board/core-service/internal/usecase/attachment"
 	boarduc "github.com/smarrog/task-board/core-service/internal/usecase/board"
 	commonuc "github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	checklistuc "github.com/smarrog/task-board/core-service/internal/usecase/checklist"
 	columnuc "github.com/smarrog/task-board/core-service/internal/usecase/column"
+	commentuc "github.com/smarrog/task-board/core-service/internal/usecase/comment"
+	labeluc "github.com/smarrog/task-board/core-service/internal/usecase/label"
 	taskuc "github.com/smarrog/task-board/core-service/internal/usecase/task"
+	templateuc "github.com/smarrog/task-board/core-service/internal/usecase/template"
+	watcheruc "github.com/smarrog/task-board/core-service/internal/usecase/watcher"
 	"github.com/smarrog/task-board/shared/logger"
+	authv1 "github.com/smarrog/task-board/shared/proto/auth/v1"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var ErrDisabled = errors.New("redis disabled")
@@ -31,7 +49,10 @@ type App struct {
 	grpc          *grpc.Server
 	pg            *pgxpool.Pool
 	redis         *redis.Client
+	authConn      *grpclib.ClientConn
 	outboxWorker  *persistence.OutboxWorker
+	dueReminder   *persistence.DueReminderWorker
+	trashPurge    *persistence.TrashPurgeWorker
 	kafkaProducer *appkafka.Producer
 }
 
@@ -68,12 +89,47 @@ func (a *App) Init() error {
 	boardsRepo := persistence.NewBoardsRepo(txm, log, outboxRepo)
 	columnsRepo := persistence.NewColumnsRepo(txm, log, outboxRepo)
 	tasksRepo := persistence.NewTasksRepo(txm, log, outboxRepo)
+	labelsRepo := persistence.NewLabelsRepo(txm, log)
+	checklistRepo := persistence.NewChecklistRepo(txm, log, outboxRepo)
+	commentsRepo := persistence.NewCommentsRepo(txm, log, outboxRepo)
+	watchersRepo := persistence.NewWatchersRepo(txm, log)
+	attachmentsRepo := persistence.NewAttachmentsRepo(txm, log)
+	templatesRepo := persistence.NewTemplatesRepo(txm, log)
+
+	blobs, err := newBlobStore(cfg)
+	if err != nil {
+		return err
+	}
+	policy := attachmentdo.Policy{MaxSize: int64(cfg.AttachmentMaxSize), AllowedTypes: cfg.AttachmentAllowedTypes}
+
+	var mentions commentdo.MentionResolver
+	if cfg.AuthGRPCAddr != "" {
+		authConn, err := grpclib.NewClient(cfg.AuthGRPCAddr, grpclib.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return err
+		}
+		a.authConn = authConn
+		mentions = appauth.NewMentionResolver(authv1.NewAuthServiceClient(authConn), cfg.AuthTimeout)
+	} else {
+		log.Warn().Msg("AUTH_GRPC_ADDR is empty, comment mentions will not be resolved")
+	}
 
-	boardsHandler := createBoardsHandler(log, boardsRepo, columnsRepo, tasksRepo, cache, cfg.RedisCacheTtl)
-	columnsHandler := createColumnsHandler(log, columnsRepo, tasksRepo, cache)
-	tasksHandler := createTasksHandler(log, tasksRepo, columnsRepo, cache)
+	boardsHandler := createBoardsHandler(log, boardsRepo, columnsRepo, tasksRepo, labelsRepo, checklistRepo, attachmentsRepo, templatesRepo, cache, cfg.RedisCacheTtl)
+	columnsHandler := createColumnsHandler(log, columnsRepo, boardsRepo, tasksRepo, labelsRepo, cache)
+	tasksHandler := createTasksHandler(log, tasksRepo, columnsRepo, boardsRepo, labelsRepo, attachmentsRepo, cache)
+	labelsHandler := createLabelsHandler(log, boardsRepo, labelsRepo, tasksRepo, cache)
+	checklistsHandler := createChecklistsHandler(log, checklistRepo, tasksRepo, columnsRepo, cache)
+	commentsHandler := createCommentsHandler(log, commentsRepo, tasksRepo, mentions)
+	watchersHandler := createWatchersHandler(log, watchersRepo, tasksRepo, columnsRepo, boardsRepo)
+	attachmentsHandler := createAttachmentsHandler(log, attachmentsRepo, blobs, tasksRepo, columnsRepo, cache, policy)
+	templatesHandler := createTemplatesHandler(log, templatesRepo, boardsRepo, columnsRepo, labelsRepo)
 
-	a.grpc = grpc.NewServer(log, boardsHandler, columnsHandler, tasksHandler)
+	// запас сверх размера файла — на остальные поля сообщения
+	maxMsgSize := cfg.AttachmentMaxSize + 1<<20
+	a.grpc = grpc.NewServer(log, boardsHandler, columnsHandler, tasksHandler, labelsHandler, checklistsHandler, commentsHandler, watchersHandler, attachmentsHandler, templatesHandler, maxMsgSize)
+
+	a.dueReminder = persistence.NewDueReminderWorker(txm, outboxRepo, cfg.DueSoonWindow, cfg.DueReminderBatchSize, cfg.DueReminderPollInterval, log)
+	a.trashPurge = persistence.NewTrashPurgeWorker(txm, blobs, cfg.TrashRetention, cfg.TrashPurgeInterval, log)
 
 	producer, err := appkafka.NewProducer(cfg, log)
 	if err != nil {
@@ -89,13 +145,19 @@ func (a *App) Init() error {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	errCh := make(chan error, 1)
+	errCh := make(chan error, 4)
 
 	if a.outboxWorker != nil {
 		go func() {
 			errCh <- a.outboxWorker.Run(ctx)
 		}()
 	}
+	go func() {
+		errCh <- a.dueReminder.Run(ctx)
+	}()
+	go func() {
+		errCh <- a.trashPurge.Run(ctx)
+	}()
 	go func() {
 		errCh <- a.grpc.Run(":" + a.cfg.GRPCPort)
 	}()
@@ -115,6 +177,9 @@ func (a *App) closeAll() {
 	if a.redis != nil {
 		_ = a.redis.Close()
 	}
+	if a.authConn != nil {
+		_ = a.authConn.Close()
+	}
 	a.kafkaProducer.Close()
 	a.pg.Close()
 	a.grpc.Stop()
@@ -143,6 +208,25 @@ func newRedis(cfg *config.Config) (*redis.Client, error) {
 	return rdb, nil
 }
 
+func newBlobStore(cfg *config.Config) (attachmentdo.BlobStore, error) {
+	switch cfg.BlobStore {
+	case "local":
+		return blob.NewLocalStore(cfg.BlobLocalDir)
+	case "s3":
+		return blob.NewS3Store(blob.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PathStyle: cfg.S3PathStyle,
+			Timeout:   cfg.S3Timeout,
+		})
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", cfg.BlobStore)
+	}
+}
+
 func newPG(cfg *config.Config) (*pgxpool.Pool, error) {
 	pgCfg, err := pgxpool.ParseConfig(cfg.PostgresDSN)
 	if err != nil {
@@ -175,31 +259,59 @@ func createBoardsHandler(
 	boardsRepo boarddo.Repository,
 	columnsRepo columndo.Repository,
 	tasksRepo taskdo.Repository,
+	labelsRepo labeldo.Repository,
+	checklistRepo checklistdo.Repository,
+	attachmentsRepo attachmentdo.Repository,
+	templatesRepo templatedo.Repository,
 	cache commonuc.Cacher,
 	redisCacheTTL time.Duration,
 ) *grpc.BoardsHandler {
-	createBoard := boarduc.NewCreateBoardUseCase(boardsRepo)
-	getBoard := boarduc.NewGetBoardUseCase(boardsRepo, columnsRepo, tasksRepo, cache, redisCacheTTL)
-	listBoards := boarduc.NewListBoardsUseCase(boardsRepo, columnsRepo, tasksRepo, cache, redisCacheTTL)
+	createBoard := boarduc.NewCreateBoardUseCase(boardsRepo, columnsRepo, labelsRepo, templatesRepo)
+	getBoard := boarduc.NewGetBoardUseCase(boardsRepo, columnsRepo, tasksRepo, labelsRepo, checklistRepo, attachmentsRepo, cache, redisCacheTTL)
+	listBoards := boarduc.NewListBoardsUseCase(boardsRepo, columnsRepo, tasksRepo, labelsRepo, checklistRepo, attachmentsRepo, cache, redisCacheTTL)
 	updateBoard := boarduc.NewUpdateBoardUseCase(boardsRepo, cache)
 	deleteBoard := boarduc.NewDeleteBoardUseCase(boardsRepo, cache)
+	restoreBoard := boarduc.NewRestoreBoardUseCase(boardsRepo, cache)
+	listTrash := boarduc.NewListTrashUseCase(boardsRepo, columnsRepo, tasksRepo)
+	archiveBoard := boarduc.NewArchiveBoardUseCase(boardsRepo, cache)
+	unarchiveBoard := boarduc.NewUnarchiveBoardUseCase(boardsRepo, cache)
+	duplicateBoard := boarduc.NewDuplicateBoardUseCase(boardsRepo, columnsRepo, tasksRepo, labelsRepo)
 
-	boardsHandler := grpc.NewBoardsHandler(log, createBoard, getBoard, listBoards, updateBoard, deleteBoard)
+	boardsHandler := grpc.NewBoardsHandler(log, createBoard, getBoard, listBoards, updateBoard, deleteBoard, restoreBoard, listTrash, archiveBoard, unarchiveBoard, duplicateBoard)
 	return boardsHandler
 }
 
+func createTemplatesHandler(
+	log *zerolog.Logger,
+	templatesRepo templatedo.Repository,
+	boardsRepo boarddo.Repository,
+	columnsRepo columndo.Repository,
+	labelsRepo labeldo.Repository,
+) *grpc.TemplatesHandler {
+	createTemplate := templateuc.NewCreateTemplateUseCase(templatesRepo, boardsRepo, columnsRepo, labelsRepo)
+	listTemplates := templateuc.NewListTemplatesUseCase(templatesRepo)
+	deleteTemplate := templateuc.NewDeleteTemplateUseCase(templatesRepo)
+
+	templatesHandler := grpc.NewTemplatesHandler(log, createTemplate, listTemplates, deleteTemplate)
+	return templatesHandler
+}
+
 func createColumnsHandler(
 	log *zerolog.Logger,
 	columnsRepo columndo.Repository,
+	boardsRepo boarddo.Repository,
 	tasksRepo taskdo.Repository,
+	labelsRepo labeldo.Repository,
 	cache commonuc.Cacher,
 ) *grpc.ColumnsHandler {
-	createColumn := columnuc.NewCreateColumnUseCase(columnsRepo, cache)
+	createColumn := columnuc.NewCreateColumnUseCase(columnsRepo, boardsRepo, cache)
 	getColumn := columnuc.NewGetColumnUseCase(columnsRepo, tasksRepo)
-	moveColumn := columnuc.NewMoveColumnUseCase(columnsRepo, cache)
+	moveColumn := columnuc.NewMoveColumnUseCase(columnsRepo, boardsRepo, labelsRepo, cache)
+	reorderColumns := columnuc.NewReorderColumnsUseCase(columnsRepo, boardsRepo, cache)
 	deleteColumn := columnuc.NewDeleteColumnUseCase(columnsRepo, cache)
+	restoreColumn := columnuc.NewRestoreColumnUseCase(columnsRepo, boardsRepo, cache)
 
-	columnsHandler := grpc.NewColumnsHandler(log, createColumn, getColumn, moveColumn, deleteColumn)
+	columnsHandler := grpc.NewColumnsHandler(log, createColumn, getColumn, moveColumn, reorderColumns, deleteColumn, restoreColumn)
 	return columnsHandler
 }
 
@@ -207,14 +319,107 @@ func createTasksHandler(
 	log *zerolog.Logger,
 	tasksRepo taskdo.Repository,
 	columnsRepo columndo.Repository,
+	boardsRepo boarddo.Repository,
+	labelsRepo labeldo.Repository,
+	attachmentsRepo attachmentdo.Repository,
 	cache commonuc.Cacher,
 ) *grpc.TasksHandler {
 	createTask := taskuc.NewCreateTaskUseCase(tasksRepo, columnsRepo, cache)
-	getTask := taskuc.NewGetTaskUseCase(tasksRepo)
+	getTask := taskuc.NewGetTaskUseCase(tasksRepo, attachmentsRepo)
 	updateTask := taskuc.NewUpdateTaskUseCase(tasksRepo, columnsRepo, cache)
-	moveTask := taskuc.NewMoveTaskUseCase(tasksRepo, columnsRepo, cache)
+	moveTask := taskuc.NewMoveTaskUseCase(tasksRepo, columnsRepo, boardsRepo, labelsRepo, cache)
 	deleteTask := taskuc.NewDeleteTaskUseCase(tasksRepo, columnsRepo, cache)
-
-	tasksHandler := grpc.NewTasksHandler(log, createTask, getTask, updateTask, moveTask, deleteTask)
+	restoreTask := taskuc.NewRestoreTaskUseCase(tasksRepo, columnsRepo, cache)
+	archiveTask := taskuc.NewArchiveTaskUseCase(tasksRepo, columnsRepo, cache)
+	unarchiveTask := taskuc.NewUnarchiveTaskUseCase(tasksRepo, columnsRepo, cache)
+	searchTasks := taskuc.NewSearchTasksUseCase(tasksRepo)
+	byAssignee := taskuc.NewListTasksByAssigneeUseCase(tasksRepo)
+	setLabels := taskuc.NewSetTaskLabelsUseCase(tasksRepo, columnsRepo, labelsRepo, cache)
+	bulk := taskuc.NewBulkTasksUseCase(tasksRepo, columnsRepo, boardsRepo, labelsRepo, cache)
+
+	tasksHandler := grpc.NewTasksHandler(log, createTask, getTask, updateTask, moveTask, deleteTask, restoreTask, archiveTask, unarchiveTask, searchTasks, byAssignee, setLabels, bulk)
 	return tasksHandler
 }
+
+func createLabelsHandler(
+	log *zerolog.Logger,
+	boardsRepo boarddo.Repository,
+	labelsRepo labeldo.Repository,
+	tasksRepo taskdo.Repository,
+	cache commonuc.Cacher,
+) *grpc.LabelsHandler {
+	createLabel := labeluc.NewCreateLabelUseCase(labelsRepo, boardsRepo, cache)
+	listLabels := labeluc.NewListLabelsUseCase(labelsRepo, boardsRepo)
+	updateLabel := labeluc.NewUpdateLabelUseCase(labelsRepo, boardsRepo, cache)
+	deleteLabel := labeluc.NewDeleteLabelUseCase(labelsRepo, boardsRepo, tasksRepo, cache)
+
+	labelsHandler := grpc.NewLabelsHandler(log, createLabel, listLabels, updateLabel, deleteLabel)
+	return labelsHandler
+}
+
+func createChecklistsHandler(
+	log *zerolog.Logger,
+	checklistRepo checklistdo.Repository,
+	tasksRepo taskdo.Repository,
+	columnsRepo columndo.Repository,
+	cache commonuc.Cacher,
+) *grpc.ChecklistsHandler {
+	createItem := checklistuc.NewCreateItemUseCase(checklistRepo, tasksRepo, columnsRepo, cache)
+	listItems := checklistuc.NewListItemsUseCase(checklistRepo, tasksRepo)
+	updateItem := checklistuc.NewUpdateItemUseCase(checklistRepo)
+	setItemDone := checklistuc.NewSetItemDoneUseCase(checklistRepo, tasksRepo, columnsRepo, cache)
+	moveItem := checklistuc.NewMoveItemUseCase(checklistRepo)
+	deleteItem := checklistuc.NewDeleteItemUseCase(checklistRepo, tasksRepo, columnsRepo, cache)
+
+	checklistsHandler := grpc.NewChecklistsHandler(log, createItem, listItems, updateItem, setItemDone, moveItem, deleteItem)
+	return checklistsHandler
+}
+
+func createCommentsHandler(
+	log *zerolog.Logger,
+	commentsRepo commentdo.Repository,
+	tasksRepo taskdo.Repository,
+	mentions commentdo.MentionResolver,
+) *grpc.CommentsHandler {
+	createComment := commentuc.NewCreateCommentUseCase(commentsRepo, tasksRepo, mentions, log)
+	listComments := commentuc.NewListCommentsUseCase(commentsRepo, tasksRepo)
+	updateComment := commentuc.NewUpdateCommentUseCase(commentsRepo, mentions, log)
+	deleteComment := commentuc.NewDeleteCommentUseCase(commentsRepo)
+
+	commentsHandler := grpc.NewCommentsHandler(log, createComment, listComments, updateComment, deleteComment)
+	return commentsHandler
+}
+
+func createWatchersHandler(
+	log *zerolog.Logger,
+	watchersRepo watcherdo.Repository,
+	tasksRepo taskdo.Repository,
+	columnsRepo columndo.Repository,
+	boardsRepo boarddo.Repository,
+) *grpc.WatchersHandler {
+	setTaskWatching := watcheruc.NewSetTaskWatchingUseCase(watchersRepo, tasksRepo, columnsRepo, boardsRepo)
+	listTaskWatchers := watcheruc.NewListTaskWatchersUseCase(watchersRepo, tasksRepo)
+	setBoardWatching := watcheruc.NewSetBoardWatchingUseCase(watchersRepo, boardsRepo)
+	listBoardWatchers := watcheruc.NewListBoardWatchersUseCase(watchersRepo, boardsRepo)
+
+	watchersHandler := grpc.NewWatchersHandler(log, setTaskWatching, listTaskWatchers, setBoardWatching, listBoardWatchers)
+	return watchersHandler
+}
+
+func createAttachmentsHandler(
+	log *zerolog.Logger,
+	attachmentsRepo attachmentdo.Repository,
+	blobs attachmentdo.BlobStore,
+	tasksRepo taskdo.Repository,
+	columnsRepo columndo.Repository,
+	cache commonuc.Cacher,
+	policy attachmentdo.Policy,
+) *grpc.AttachmentsHandler {
+	upload := attachmentuc.NewUploadAttachmentUseCase(attachmentsRepo, blobs, tasksRepo, columnsRepo, cache, policy)
+	list := attachmentuc.NewListAttachmentsUseCase(attachmentsRepo, tasksRepo)
+	download := attachmentuc.NewDownloadAttachmentUseCase(attachmentsRepo, blobs)
+	remove := attachmentuc.NewDeleteAttachmentUseCase(attachmentsRepo, blobs, tasksRepo, columnsRepo, cache)
+
+	attachmentsHandler := grpc.NewAttachmentsHandler(log, upload, list, download, remove)
+	return attachmentsHandler
+}
@@ -32,6 +32,29 @@ type Config struct {
 	OutboxPollInterval time.Duration
 	OutboxBatchSize    int
 
+	DueReminderPollInterval time.Duration
+	DueReminderBatchSize    int
+	DueSoonWindow           time.Duration
+
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+
+	AuthGRPCAddr string
+	AuthTimeout  time.Duration
+
+	BlobStore    string
+	BlobLocalDir string
+	S3Endpoint   string
+	S3Region     string
+	S3Bucket     string
+	S3AccessKey  string
+	S3SecretKey  string
+	S3PathStyle  bool
+	S3Timeout    time.Duration
+
+	AttachmentMaxSize      int
+	AttachmentAllowedTypes []string
+
 	LogLevel zerolog.Level
 }
 
@@ -60,6 +83,31 @@ func Load() *Config {
 		OutboxPollInterval: env.GetDuration("OUTBOX_POLL_INTERVAL", 5000*time.Millisecond),
 		OutboxBatchSize:    env.GetInt("OUTBOX_BATCH_SIZE", 50),
 
+		DueReminderPollInterval: env.GetDuration("DUE_REMINDER_POLL_INTERVAL", time.Minute),
+		DueReminderBatchSize:    env.GetInt("DUE_REMINDER_BATCH_SIZE", 100),
+		DueSoonWindow:           env.GetDuration("DUE_SOON_WINDOW", 24*time.Hour),
+
+		TrashRetention:     env.GetDuration("TRASH_RETENTION", 30*24*time.Hour),
+		TrashPurgeInterval: env.GetDuration("TRASH_PURGE_INTERVAL", time.Hour),
+
+		AuthGRPCAddr: env.GetString("AUTH_GRPC_ADDR", ""),
+		AuthTimeout:  env.GetDuration("AUTH_TIMEOUT", 2*time.Second),
+
+		BlobStore:    env.GetString("BLOB_STORE", "local"),
+		BlobLocalDir: env.GetString("BLOB_LOCAL_DIR", "./data/attachments"),
+		S3Endpoint:   env.GetString("S3_ENDPOINT", ""),
+		S3Region:     env.GetString("S3_REGION", "us-east-1"),
+		S3Bucket:     env.GetString("S3_BUCKET", ""),
+		S3AccessKey:  env.GetString("S3_ACCESS_KEY", ""),
+		S3SecretKey:  env.GetString("S3_SECRET_KEY", ""),
+		S3PathStyle:  env.GetBool("S3_PATH_STYLE", true),
+		S3Timeout:    env.GetDuration("S3_TIMEOUT", 30*time.Second),
+
+		AttachmentMaxSize: env.GetInt("ATTACHMENT_MAX_SIZE", 10<<20),
+		AttachmentAllowedTypes: env.GetSplitString("ATTACHMENT_ALLOWED_TYPES", []string{
+			"image/*", "application/pdf", "text/plain", "text/markdown", "text/csv",
+		}),
+
 		LogLevel: logger.StrToLogLevel(env.GetString("LOG_LEVEL", "info")),
 	}
 
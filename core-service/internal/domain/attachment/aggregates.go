@@ -0,0 +1,64 @@
+package attachment
+
+import (
+	"time"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type Attachment struct {
+	id          Id
+	taskId      task.Id
+	uploaderId  shared.UserId
+	fileName    FileName
+	contentType ContentType
+	size        int64
+	storageKey  string
+	createdAt   time.Time
+}
+
+func New(taskId task.Id, uploaderId shared.UserId, fileName FileName, contentType ContentType, size int64) *Attachment {
+	id := NewId()
+	return &Attachment{
+		id:          id,
+		taskId:      taskId,
+		uploaderId:  uploaderId,
+		fileName:    fileName,
+		contentType: contentType,
+		size:        size,
+		storageKey:  "tasks/" + taskId.String() + "/" + id.String(),
+		createdAt:   time.Now().UTC(),
+	}
+}
+
+func Rehydrate(
+	id Id,
+	taskId task.Id,
+	uploaderId shared.UserId,
+	fileName FileName,
+	contentType ContentType,
+	size int64,
+	storageKey string,
+	createdAt time.Time,
+) *Attachment {
+	return &Attachment{
+		id:          id,
+		taskId:      taskId,
+		uploaderId:  uploaderId,
+		fileName:    fileName,
+		contentType: contentType,
+		size:        size,
+		storageKey:  storageKey,
+		createdAt:   createdAt,
+	}
+}
+
+func (a *Attachment) Id() Id                    { return a.id }
+func (a *Attachment) TaskId() task.Id           { return a.taskId }
+func (a *Attachment) UploaderId() shared.UserId { return a.uploaderId }
+func (a *Attachment) FileName() FileName        { return a.fileName }
+func (a *Attachment) ContentType() ContentType  { return a.contentType }
+func (a *Attachment) Size() int64               { return a.size }
+func (a *Attachment) StorageKey() string        { return a.storageKey }
+func (a *Attachment) CreatedAt() time.Time      { return a.createdAt }
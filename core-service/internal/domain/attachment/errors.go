@@ -0,0 +1,18 @@
+package attachment
+
+import (
+	"fmt"
+
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+var (
+	ErrNotFound         = fmt.Errorf("%s %w", "attachment", shared.ErrNotFound)
+	ErrInvalidId        = fmt.Errorf("%s %w", "attachment id", shared.ErrIsInvalid)
+	ErrFileNameEmpty    = fmt.Errorf("%s %w", "attachment file name", shared.ErrIsEmpty)
+	ErrFileNameTooLong  = fmt.Errorf("%s %w", "attachment file name", shared.ErrIsTooLong)
+	ErrContentEmpty     = fmt.Errorf("%s %w", "attachment content", shared.ErrIsEmpty)
+	ErrTooLarge         = fmt.Errorf("%s %w", "attachment size", shared.ErrIsTooLong)
+	ErrTypeNotAllowed   = fmt.Errorf("%s %w", "attachment content type", shared.ErrIsInvalid)
+	ErrUploaderMismatch = fmt.Errorf("%s %w", "attachment uploader id", shared.ErrIsMismatch)
+)
@@ -0,0 +1,26 @@
+package attachment
+
+import (
+	"context"
+	"io"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+)
+
+// Repository хранит только метаданные; сами файлы лежат в BlobStore.
+type Repository interface {
+	Save(ctx context.Context, a *Attachment) error
+	Get(ctx context.Context, id Id) (*Attachment, error)
+	ListByTask(ctx context.Context, taskId task.Id) ([]*Attachment, error)
+	// CountByTasks — число вложений по задачам; задачи без вложений отсутствуют.
+	CountByTasks(ctx context.Context, taskIds []task.Id) (map[task.Id]int, error)
+	Delete(ctx context.Context, id Id) error
+}
+
+// BlobStore — хранилище содержимого файлов. Отсутствующий ключ при Get — ErrNotFound,
+// при Delete — не ошибка.
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
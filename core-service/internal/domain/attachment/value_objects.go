@@ -0,0 +1,107 @@
+package attachment
+
+import (
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const MaxFileNameLength = 255
+
+type Id struct {
+	value uuid.UUID
+}
+
+func NewId() Id {
+	return Id{uuid.New()}
+}
+
+func IdFromUUID(id uuid.UUID) (Id, error) {
+	if id == uuid.Nil {
+		return Id{}, ErrInvalidId
+	}
+	return Id{value: id}, nil
+}
+
+func IdFromString(s string) (Id, error) {
+	id, err := uuid.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return Id{}, fmt.Errorf("%w: %v", ErrInvalidId, err)
+	}
+	return IdFromUUID(id)
+}
+
+func (id Id) UUID() uuid.UUID { return id.value }
+func (id Id) String() string  { return id.value.String() }
+
+type FileName struct {
+	value string
+}
+
+// NewFileName оставляет только имя файла: браузеры иногда присылают полный путь.
+func NewFileName(raw string) (FileName, error) {
+	v := path.Base(strings.ReplaceAll(strings.TrimSpace(raw), `\`, "/"))
+	if v == "" || v == "." || v == ".." || v == "/" {
+		return FileName{}, ErrFileNameEmpty
+	}
+	if len(v) > MaxFileNameLength {
+		return FileName{}, ErrFileNameTooLong
+	}
+	return FileName{value: v}, nil
+}
+
+func (n FileName) String() string { return n.value }
+
+type ContentType struct {
+	value string
+}
+
+// NewContentType приводит тип к виду "type/subtype" без параметров; пустой тип — octet-stream.
+func NewContentType(raw string) (ContentType, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ContentType{value: "application/octet-stream"}, nil
+	}
+	mt, _, err := mime.ParseMediaType(raw)
+	if err != nil {
+		return ContentType{}, fmt.Errorf("%w: %v", ErrTypeNotAllowed, err)
+	}
+	return ContentType{value: mt}, nil
+}
+
+func (t ContentType) String() string { return t.value }
+
+// Policy — ограничения на загружаемые файлы.
+type Policy struct {
+	MaxSize      int64
+	AllowedTypes []string
+}
+
+func (p Policy) Check(ct ContentType, size int64) error {
+	if size <= 0 {
+		return ErrContentEmpty
+	}
+	if p.MaxSize > 0 && size > p.MaxSize {
+		return ErrTooLarge
+	}
+	if len(p.AllowedTypes) == 0 {
+		return nil
+	}
+	for _, allowed := range p.AllowedTypes {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		// "image/*" разрешает все подтипы
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok {
+			if strings.HasPrefix(ct.value, prefix+"/") {
+				return nil
+			}
+			continue
+		}
+		if ct.value == allowed {
+			return nil
+		}
+	}
+	return ErrTypeNotAllowed
+}
@@ -15,6 +15,8 @@ type Board struct {
 	description Description
 	createdAt   time.Time
 	updatedAt   time.Time
+	archivedAt  time.Time
+	version     int64
 	events      []shared.DomainEvent
 }
 
@@ -49,6 +51,8 @@ func Rehydrate(
 	description Description,
 	createdAt time.Time,
 	updatedAt time.Time,
+	archivedAt time.Time,
+	version int64,
 ) *Board {
 	return &Board{
 		id:          id,
@@ -57,6 +61,8 @@ func Rehydrate(
 		description: description,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
+		archivedAt:  archivedAt,
+		version:     version,
 	}
 }
 
@@ -66,17 +72,47 @@ func (b *Board) Title() Title             { return b.title }
 func (b *Board) Description() Description { return b.description }
 func (b *Board) CreatedAt() time.Time     { return b.createdAt }
 func (b *Board) UpdatedAt() time.Time     { return b.updatedAt }
+func (b *Board) ArchivedAt() time.Time    { return b.archivedAt }
+func (b *Board) IsArchived() bool         { return !b.archivedAt.IsZero() }
+
+func (b *Board) Version() int64 { return b.version }
+
+// CheckVersion сверяет версию, которую видел клиент (If-Match); 0 — без проверки.
+func (b *Board) CheckVersion(expected int64) error {
+	if expected != 0 && expected != b.version {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// SetVersion фиксирует версию, присвоенную хранилищем при записи.
+func (b *Board) SetVersion(v int64) { b.version = v }
+
+// UpdatableFields — пути, допустимые в маске обновления доски.
+var UpdatableFields = []string{board.FieldTitle, board.FieldDescription}
+
+// Update меняет заголовок и описание; в событие попадают только изменённые поля.
+// Возвращает false, если менять нечего.
+func (b *Board) Update(title Title, description Description) bool {
+	ev := board.UpdatedEvent{Id: b.id.String()}
+	if title != b.title {
+		b.title = title
+		ev.Fields = append(ev.Fields, board.FieldTitle)
+		ev.Title = strPtr(title.String())
+	}
+	if description != b.description {
+		b.description = description
+		ev.Fields = append(ev.Fields, board.FieldDescription)
+		ev.Description = strPtr(description.String())
+	}
+	if len(ev.Fields) == 0 {
+		return false
+	}
 
-func (b *Board) Update(title Title, description Description) {
-	b.title = title
-	b.description = description
 	b.updatedAt = time.Now().UTC()
-	b.events = append(b.events, board.UpdatedEvent{
-		Id:          b.id.String(),
-		Title:       b.title.String(),
-		Description: b.description.String(),
-		At:          b.updatedAt,
-	})
+	ev.At = b.updatedAt
+	b.events = append(b.events, ev)
+	return true
 }
 
 func (b *Board) MarkDeleted() {
@@ -87,6 +123,41 @@ func (b *Board) MarkDeleted() {
 	})
 }
 
+func (b *Board) Restore() {
+	b.updatedAt = time.Now().UTC()
+	b.events = append(b.events, board.RestoredEvent{
+		Id: b.id.String(),
+		At: b.updatedAt,
+	})
+}
+
+// Archive убирает доску из списка досок; её колонки и задачи не меняются.
+func (b *Board) Archive() error {
+	if b.IsArchived() {
+		return ErrArchived
+	}
+	b.archivedAt = time.Now().UTC()
+	b.updatedAt = b.archivedAt
+	b.events = append(b.events, board.ArchivedEvent{
+		Id: b.id.String(),
+		At: b.archivedAt,
+	})
+	return nil
+}
+
+func (b *Board) Unarchive() error {
+	if !b.IsArchived() {
+		return ErrNotArchived
+	}
+	b.archivedAt = time.Time{}
+	b.updatedAt = time.Now().UTC()
+	b.events = append(b.events, board.UnarchivedEvent{
+		Id: b.id.String(),
+		At: b.updatedAt,
+	})
+	return nil
+}
+
 func (b *Board) PullEvents() []shared.DomainEvent {
 	if len(b.events) == 0 {
 		return nil
@@ -100,3 +171,5 @@ func (b *Board) PullEvents() []shared.DomainEvent {
 type Columns struct {
 	boardId Id
 }
+
+func strPtr(s string) *string { return &s }
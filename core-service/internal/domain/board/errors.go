@@ -14,4 +14,12 @@ var (
 	ErrTitleEmpty         = fmt.Errorf("%s %w", "board title", shared.ErrIsEmpty)
 	ErrTitleTooLong       = fmt.Errorf("%s %w", "board title", shared.ErrIsTooLong)
 	ErrDescriptionTooLong = fmt.Errorf("%s %w", "board description", shared.ErrIsTooLong)
+	ErrInvalidSort        = fmt.Errorf("%s %w", "boards sort", shared.ErrIsInvalid)
+	ErrInvalidCursor      = fmt.Errorf("%s %w", "boards cursor", shared.ErrIsInvalid)
+	ErrSearchTooLong      = fmt.Errorf("%s %w", "boards search", shared.ErrIsTooLong)
+	ErrArchived           = fmt.Errorf("%s %w", "board", shared.ErrIsArchived)
+	ErrNotArchived        = fmt.Errorf("%s %w", "board", shared.ErrIsNotArchived)
+	ErrVersionMismatch    = fmt.Errorf("%s %w", "board version", shared.ErrIsStale)
+	ErrConcurrentUpdate   = fmt.Errorf("%s %w", "board update", shared.ErrIsConflict)
+	ErrInvalidUpdateMask  = fmt.Errorf("%s %w", "board update mask", shared.ErrIsInvalid)
 )
@@ -2,6 +2,7 @@ package board
 
 import (
 	"context"
+	"time"
 
 	"github.com/smarrog/task-board/shared/domain/shared"
 )
@@ -11,6 +12,15 @@ type Repository interface {
 
 	Save(ctx context.Context, b *Board) error
 	Get(ctx context.Context, id Id) (*Board, error)
-	ListByOwner(ctx context.Context, ownerId shared.UserId) ([]*Board, error)
+	ListByOwner(ctx context.Context, ownerId shared.UserId, q ListQuery) ([]*Board, error)
 	Delete(ctx context.Context, id Id) error
+
+	GetTrashed(ctx context.Context, id Id) (*Trashed, error)
+	Restore(ctx context.Context, b *Board) error
+}
+
+// Trashed — доска в корзине и момент её удаления.
+type Trashed struct {
+	Board     *Board
+	DeletedAt time.Time
 }
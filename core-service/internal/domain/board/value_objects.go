@@ -1,8 +1,11 @@
 package board
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,6 +13,7 @@ import (
 const (
 	MaxTitleLength       = 255
 	MaxDescriptionLength = 1_024
+	MaxSearchLength      = 255
 )
 
 type Id struct {
@@ -74,3 +78,86 @@ func NewDescription(raw string) (Description, error) {
 }
 
 func (d Description) String() string { return d.value }
+
+type Sort string
+
+const (
+	SortUpdatedAt Sort = "updated_at"
+	SortTitle     Sort = "title"
+)
+
+func NewSort(raw string) (Sort, error) {
+	switch v := Sort(strings.TrimSpace(raw)); v {
+	case "":
+		return SortUpdatedAt, nil
+	case SortUpdatedAt, SortTitle:
+		return v, nil
+	default:
+		return "", ErrInvalidSort
+	}
+}
+
+func (s Sort) String() string { return string(s) }
+
+// Cursor — позиция последней отданной доски в выбранной сортировке.
+type Cursor struct {
+	Sort      Sort
+	UpdatedAt time.Time
+	Title     string
+	Id        Id
+}
+
+type cursorPayload struct {
+	Sort      Sort      `json:"s"`
+	UpdatedAt time.Time `json:"u"`
+	Title     string    `json:"t,omitempty"`
+	Id        uuid.UUID `json:"i"`
+}
+
+func CursorAfter(b *Board, sort Sort) Cursor {
+	c := Cursor{Sort: sort, Id: b.Id()}
+	switch sort {
+	case SortTitle:
+		c.Title = b.Title().String()
+	default:
+		c.UpdatedAt = b.UpdatedAt()
+	}
+	return c
+}
+
+func DecodeCursor(raw string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	var p cursorPayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if p.Sort != SortUpdatedAt && p.Sort != SortTitle {
+		return Cursor{}, ErrInvalidCursor
+	}
+	id, err := IdFromUUID(p.Id)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{Sort: p.Sort, UpdatedAt: p.UpdatedAt, Title: p.Title, Id: id}, nil
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(cursorPayload{
+		Sort:      c.Sort,
+		UpdatedAt: c.UpdatedAt,
+		Title:     c.Title,
+		Id:        c.Id.UUID(),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+type ListQuery struct {
+	Search          string
+	Sort            Sort
+	After           *Cursor
+	Limit           int
+	IncludeArchived bool
+}
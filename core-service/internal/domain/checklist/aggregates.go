@@ -0,0 +1,105 @@
+package checklist
+
+import (
+	"time"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/checklist"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type Item struct {
+	id        Id
+	taskId    task.Id
+	position  Position
+	text      Text
+	done      bool
+	createdAt time.Time
+	updatedAt time.Time
+	events    []shared.DomainEvent
+}
+
+func New(taskId task.Id, position Position, text Text) *Item {
+	now := time.Now().UTC()
+	return &Item{
+		id:        NewId(),
+		taskId:    taskId,
+		position:  position,
+		text:      text,
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+func Rehydrate(
+	id Id,
+	taskId task.Id,
+	position Position,
+	text Text,
+	done bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Item {
+	return &Item{
+		id:        id,
+		taskId:    taskId,
+		position:  position,
+		text:      text,
+		done:      done,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (i *Item) Id() Id               { return i.id }
+func (i *Item) TaskId() task.Id      { return i.taskId }
+func (i *Item) Position() Position   { return i.position }
+func (i *Item) Text() Text           { return i.text }
+func (i *Item) Done() bool           { return i.done }
+func (i *Item) CreatedAt() time.Time { return i.createdAt }
+func (i *Item) UpdatedAt() time.Time { return i.updatedAt }
+
+func (i *Item) SetText(text Text) {
+	i.text = text
+	i.updatedAt = time.Now().UTC()
+}
+
+func (i *Item) SetDone(done bool) {
+	if i.done == done {
+		return
+	}
+
+	i.done = done
+	i.updatedAt = time.Now().UTC()
+
+	if done {
+		i.events = append(i.events, checklist.ItemCompletedEvent{
+			Id:     i.id.String(),
+			TaskId: i.taskId.String(),
+			Text:   i.text.String(),
+			At:     i.updatedAt,
+		})
+		return
+	}
+	i.events = append(i.events, checklist.ItemReopenedEvent{
+		Id:     i.id.String(),
+		TaskId: i.taskId.String(),
+		Text:   i.text.String(),
+		At:     i.updatedAt,
+	})
+}
+
+func (i *Item) Move(toPosition Position) {
+	i.position = toPosition
+	i.updatedAt = time.Now().UTC()
+}
+
+func (i *Item) PullEvents() []shared.DomainEvent {
+	if len(i.events) == 0 {
+		return nil
+	}
+	out := make([]shared.DomainEvent, len(i.events))
+	copy(out, i.events)
+	i.events = nil
+	return out
+}
@@ -0,0 +1,16 @@
+package checklist
+
+import (
+	"fmt"
+
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+var (
+	ErrNotFound        = fmt.Errorf("%s %w", "checklist item", shared.ErrNotFound)
+	ErrInvalidId       = fmt.Errorf("%s %w", "checklist item id", shared.ErrIsInvalid)
+	ErrInvalidPosition = fmt.Errorf("%s %w", "checklist item position", shared.ErrIsInvalid)
+	ErrTextEmpty       = fmt.Errorf("%s %w", "checklist item text", shared.ErrIsEmpty)
+	ErrTextTooLong     = fmt.Errorf("%s %w", "checklist item text", shared.ErrIsTooLong)
+	ErrEmpty           = fmt.Errorf("%s %w", "checklist", shared.ErrIsEmpty)
+)
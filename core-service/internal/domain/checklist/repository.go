@@ -0,0 +1,24 @@
+package checklist
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+)
+
+type Repository interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+
+	Save(ctx context.Context, i *Item) error
+	Get(ctx context.Context, id Id) (*Item, error)
+	ListByTask(ctx context.Context, taskId task.Id) ([]*Item, error)
+	ProgressByTasks(ctx context.Context, taskIds []task.Id) (map[task.Id]Progress, error)
+	Delete(ctx context.Context, id Id) error
+
+	LockTaskItems(ctx context.Context, taskId task.Id) error
+	CountInTask(ctx context.Context, taskId task.Id) (int, error)
+
+	ShiftPositions(ctx context.Context, taskId task.Id, fromIncl, toIncl int, delta int) error
+	ShiftAfterRemove(ctx context.Context, taskId task.Id, fromPos int) error
+	ShiftForInsert(ctx context.Context, taskId task.Id, toPos int) error
+}
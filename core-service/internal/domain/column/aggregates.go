@@ -12,23 +12,27 @@ type Column struct {
 	id        Id
 	boardId   board.Id
 	position  Position
+	title     Title
 	createdAt time.Time
 	updatedAt time.Time
+	version   int64
 	events    []shared.DomainEvent
 }
 
-func New(boardId board.Id, position Position) *Column {
+func New(boardId board.Id, position Position, title Title) *Column {
 	now := time.Now().UTC()
 	c := &Column{
 		id:        NewId(),
 		boardId:   boardId,
 		position:  position,
+		title:     title,
 		createdAt: now,
 		updatedAt: now,
 	}
 	c.events = append(c.events, column.CreatedEvent{
 		Id:      c.id.String(),
 		BoardId: c.boardId.String(),
+		Title:   c.title.String(),
 		At:      c.createdAt,
 	})
 	return c
@@ -38,34 +42,75 @@ func Rehydrate(
 	id Id,
 	boardId board.Id,
 	position Position,
+	title Title,
 	createdAt time.Time,
 	updatedAt time.Time,
+	version int64,
 ) *Column {
 	return &Column{
 		id:        id,
 		boardId:   boardId,
 		position:  position,
+		title:     title,
 		createdAt: createdAt,
 		updatedAt: updatedAt,
+		version:   version,
 	}
 }
 
 func (c *Column) Id() Id               { return c.id }
 func (c *Column) BoardId() board.Id    { return c.boardId }
 func (c *Column) Position() Position   { return c.position }
+func (c *Column) Title() Title         { return c.title }
 func (c *Column) CreatedAt() time.Time { return c.createdAt }
 func (c *Column) UpdatedAt() time.Time { return c.updatedAt }
 
+func (c *Column) Version() int64 { return c.version }
+
+// CheckVersion сверяет версию, которую видел клиент (If-Match); 0 — без проверки.
+func (c *Column) CheckVersion(expected int64) error {
+	if expected != 0 && expected != c.version {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// SetVersion фиксирует версию, присвоенную хранилищем при записи.
+func (c *Column) SetVersion(v int64) { c.version = v }
+
 func (c *Column) Move(toPosition Position) {
+	c.MoveToBoard(c.boardId, toPosition)
+}
+
+// MoveToBoard переносит колонку вместе с задачами на позицию toPosition доски toBoardId.
+func (c *Column) MoveToBoard(toBoardId board.Id, toPosition Position) {
+	fromBoardId := c.boardId
 	fromPosition := c.position
 
+	c.boardId = toBoardId
 	c.position = toPosition
+	c.updatedAt = time.Now().UTC()
 
 	c.events = append(c.events, column.MovedEvent{
 		Id:           c.id.String(),
+		FromBoardId:  fromBoardId.String(),
+		ToBoardId:    toBoardId.String(),
 		FromPosition: fromPosition.Int(),
 		ToPosition:   toPosition.Int(),
-		At:           time.Now().UTC(),
+		At:           c.updatedAt,
+	})
+}
+
+// Restore возвращает колонку из корзины на позицию position.
+func (c *Column) Restore(position Position) {
+	c.position = position
+	c.updatedAt = time.Now().UTC()
+
+	c.events = append(c.events, column.RestoredEvent{
+		Id:       c.id.String(),
+		BoardId:  c.boardId.String(),
+		Position: position.Int(),
+		At:       c.updatedAt,
 	})
 }
 
@@ -7,7 +7,13 @@ import (
 )
 
 var (
-	ErrNotFound        = fmt.Errorf("%s %w", "column", shared.ErrNotFound)
-	ErrInvalidId       = fmt.Errorf("%s %w", "column id", shared.ErrIsInvalid)
-	ErrInvalidPosition = fmt.Errorf("%s %w", "column position", shared.ErrIsInvalid)
+	ErrNotFound         = fmt.Errorf("%s %w", "column", shared.ErrNotFound)
+	ErrInvalidId        = fmt.Errorf("%s %w", "column id", shared.ErrIsInvalid)
+	ErrInvalidPosition  = fmt.Errorf("%s %w", "column position", shared.ErrIsInvalid)
+	ErrBoardDeleted     = fmt.Errorf("%s %w", "column board", shared.ErrIsDeleted)
+	ErrBoardMismatch    = fmt.Errorf("%s %w", "column board id", shared.ErrIsMismatch)
+	ErrTitleTooLong     = fmt.Errorf("%s %w", "column title", shared.ErrIsTooLong)
+	ErrOrderMismatch    = fmt.Errorf("%s %w", "column order", shared.ErrIsMismatch)
+	ErrVersionMismatch  = fmt.Errorf("%s %w", "column version", shared.ErrIsStale)
+	ErrConcurrentUpdate = fmt.Errorf("%s %w", "column update", shared.ErrIsConflict)
 )
@@ -0,0 +1,81 @@
+package column
+
+import (
+	"time"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/shared/domain/column"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+// Order — порядок всех живых колонок доски, переставленных одной операцией.
+type Order struct {
+	boardId board.Id
+	columns []*Column
+	changed bool
+	events  []shared.DomainEvent
+}
+
+// NewOrder расставляет колонки доски по порядку ids. Набор ids должен в точности совпадать
+// с columns; событие появляется, только если порядок действительно изменился.
+func NewOrder(boardId board.Id, columns []*Column, ids []Id) (*Order, error) {
+	if len(ids) != len(columns) {
+		return nil, ErrOrderMismatch
+	}
+
+	byId := make(map[Id]*Column, len(columns))
+	for _, c := range columns {
+		if c.boardId != boardId {
+			return nil, ErrBoardMismatch
+		}
+		byId[c.id] = c
+	}
+
+	o := &Order{boardId: boardId, columns: make([]*Column, 0, len(ids))}
+	for _, id := range ids {
+		c, ok := byId[id]
+		if !ok {
+			// чужая, удалённая или повторённая колонка
+			return nil, ErrOrderMismatch
+		}
+		delete(byId, id)
+		o.columns = append(o.columns, c)
+		if c.position != Position(len(o.columns)-1) {
+			o.changed = true
+		}
+	}
+	if !o.changed {
+		return o, nil
+	}
+
+	now := time.Now().UTC()
+	evIds := make([]string, 0, len(o.columns))
+	for i, c := range o.columns {
+		c.position = Position(i)
+		c.updatedAt = now
+		evIds = append(evIds, c.id.String())
+	}
+	o.events = append(o.events, column.ReorderedEvent{
+		BoardId:   boardId.String(),
+		ColumnIds: evIds,
+		At:        now,
+	})
+
+	return o, nil
+}
+
+func (o *Order) BoardId() board.Id  { return o.boardId }
+func (o *Order) Columns() []*Column { return o.columns }
+
+// Changed сообщает, поменялся ли порядок и нужно ли его сохранять.
+func (o *Order) Changed() bool { return o.changed }
+
+func (o *Order) PullEvents() []shared.DomainEvent {
+	if len(o.events) == 0 {
+		return nil
+	}
+	out := make([]shared.DomainEvent, len(o.events))
+	copy(out, o.events)
+	o.events = nil
+	return out
+}
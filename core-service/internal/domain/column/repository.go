@@ -2,6 +2,7 @@ package column
 
 import (
 	"context"
+	"time"
 
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
 )
@@ -15,8 +16,19 @@ type Repository interface {
 	ListByBoards(ctx context.Context, boardIds []board.Id) ([]*Column, error)
 	Delete(ctx context.Context, id Id) error
 
+	GetTrashed(ctx context.Context, id Id) (*Trashed, error)
+	ListTrashed(ctx context.Context, boardId board.Id) ([]Trashed, error)
+	Restore(ctx context.Context, c *Column) error
+
 	LockBoardColumns(ctx context.Context, boardId board.Id) error
 	CountInBoard(ctx context.Context, boardId board.Id) (int, error)
 
 	ShiftPositions(ctx context.Context, boardId board.Id, fromIncl, toIncl int, delta int) error
+	SaveOrder(ctx context.Context, o *Order) error
+}
+
+// Trashed — колонка в корзине и момент её удаления.
+type Trashed struct {
+	Column    *Column
+	DeletedAt time.Time
 }
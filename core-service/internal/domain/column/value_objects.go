@@ -7,6 +7,8 @@ import (
 	"github.com/google/uuid"
 )
 
+const MaxTitleLength = 255
+
 type Id struct {
 	value uuid.UUID
 }
@@ -44,3 +46,18 @@ func NewPosition(pos int) (Position, error) {
 }
 
 func (p Position) Int() int { return int(p) }
+
+// Title — название колонки; пустое допустимо, у колонок, созданных до появления названий, его нет.
+type Title struct {
+	value string
+}
+
+func NewTitle(raw string) (Title, error) {
+	v := strings.TrimSpace(raw)
+	if len(v) > MaxTitleLength {
+		return Title{}, ErrTitleTooLong
+	}
+	return Title{value: v}, nil
+}
+
+func (t Title) String() string { return t.value }
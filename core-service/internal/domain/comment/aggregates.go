@@ -0,0 +1,159 @@
+package comment
+
+import (
+	"time"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/comment"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type Comment struct {
+	id        Id
+	taskId    task.Id
+	authorId  shared.UserId
+	body      Body
+	mentions  []shared.UserId
+	createdAt time.Time
+	updatedAt time.Time
+	events    []shared.DomainEvent
+}
+
+func New(taskId task.Id, authorId shared.UserId, body Body, mentions []shared.UserId) *Comment {
+	now := time.Now().UTC()
+	c := &Comment{
+		id:        NewId(),
+		taskId:    taskId,
+		authorId:  authorId,
+		body:      body,
+		mentions:  withoutUser(mentions, authorId),
+		createdAt: now,
+		updatedAt: now,
+	}
+	c.events = append(c.events, comment.CreatedEvent{
+		Id:       c.id.String(),
+		TaskId:   c.taskId.String(),
+		AuthorId: c.authorId.String(),
+		Body:     c.body.String(),
+		Mentions: userIdsToStrings(c.mentions),
+		At:       c.createdAt,
+	})
+	c.recordMentions(c.mentions)
+	return c
+}
+
+func Rehydrate(
+	id Id,
+	taskId task.Id,
+	authorId shared.UserId,
+	body Body,
+	mentions []shared.UserId,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Comment {
+	return &Comment{
+		id:        id,
+		taskId:    taskId,
+		authorId:  authorId,
+		body:      body,
+		mentions:  mentions,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (c *Comment) Id() Id                    { return c.id }
+func (c *Comment) TaskId() task.Id           { return c.taskId }
+func (c *Comment) AuthorId() shared.UserId   { return c.authorId }
+func (c *Comment) Body() Body                { return c.body }
+func (c *Comment) Mentions() []shared.UserId { return c.mentions }
+func (c *Comment) CreatedAt() time.Time      { return c.createdAt }
+func (c *Comment) UpdatedAt() time.Time      { return c.updatedAt }
+
+// Edit меняет текст; править может только автор. Уведомления уходят лишь новым упомянутым.
+func (c *Comment) Edit(editorId shared.UserId, body Body, mentions []shared.UserId) error {
+	if editorId != c.authorId {
+		return ErrAuthorMismatch
+	}
+
+	mentions = withoutUser(mentions, c.authorId)
+	prev := make(map[shared.UserId]struct{}, len(c.mentions))
+	for _, id := range c.mentions {
+		prev[id] = struct{}{}
+	}
+	added := make([]shared.UserId, 0)
+	for _, id := range mentions {
+		if _, ok := prev[id]; !ok {
+			added = append(added, id)
+		}
+	}
+
+	c.body = body
+	c.mentions = mentions
+	c.updatedAt = time.Now().UTC()
+
+	c.events = append(c.events, comment.UpdatedEvent{
+		Id:       c.id.String(),
+		TaskId:   c.taskId.String(),
+		AuthorId: c.authorId.String(),
+		Body:     c.body.String(),
+		Mentions: userIdsToStrings(c.mentions),
+		At:       c.updatedAt,
+	})
+	c.recordMentions(added)
+	return nil
+}
+
+func (c *Comment) PullEvents() []shared.DomainEvent {
+	if len(c.events) == 0 {
+		return nil
+	}
+	out := make([]shared.DomainEvent, len(c.events))
+	copy(out, c.events)
+	c.events = nil
+	return out
+}
+
+func (c *Comment) recordMentions(userIds []shared.UserId) {
+	for _, uid := range userIds {
+		c.events = append(c.events, comment.MentionedEvent{
+			CommentId: c.id.String(),
+			TaskId:    c.taskId.String(),
+			AuthorId:  c.authorId.String(),
+			UserId:    uid.String(),
+			At:        c.updatedAt,
+		})
+	}
+}
+
+// IsNew сообщает, что комментарий создан и ещё не сохранён.
+func (c *Comment) IsNew() bool {
+	for _, ev := range c.events {
+		if _, ok := ev.(comment.CreatedEvent); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutUser убирает повторы и самого автора: себя упоминать незачем.
+func withoutUser(ids []shared.UserId, userId shared.UserId) []shared.UserId {
+	out := make([]shared.UserId, 0, len(ids))
+	seen := make(map[shared.UserId]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok || id == userId {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
+
+func userIdsToStrings(ids []shared.UserId) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return out
+}
@@ -0,0 +1,16 @@
+package comment
+
+import (
+	"fmt"
+
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+var (
+	ErrNotFound       = fmt.Errorf("%s %w", "comment", shared.ErrNotFound)
+	ErrInvalidId      = fmt.Errorf("%s %w", "comment id", shared.ErrIsInvalid)
+	ErrBodyEmpty      = fmt.Errorf("%s %w", "comment body", shared.ErrIsEmpty)
+	ErrBodyTooLong    = fmt.Errorf("%s %w", "comment body", shared.ErrIsTooLong)
+	ErrAuthorMismatch = fmt.Errorf("%s %w", "comment author id", shared.ErrIsMismatch)
+	ErrInvalidCursor  = fmt.Errorf("%s %w", "comments cursor", shared.ErrIsInvalid)
+)
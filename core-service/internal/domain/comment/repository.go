@@ -0,0 +1,19 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type Repository interface {
+	Save(ctx context.Context, c *Comment) error
+	Get(ctx context.Context, id Id) (*Comment, error)
+	ListByTask(ctx context.Context, q ListQuery) ([]*Comment, error)
+	Delete(ctx context.Context, id Id) error
+}
+
+// MentionResolver превращает имена из @username в id пользователей; неизвестные имена пропускаются.
+type MentionResolver interface {
+	Resolve(ctx context.Context, usernames []string) ([]shared.UserId, error)
+}
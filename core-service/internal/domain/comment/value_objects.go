@@ -0,0 +1,128 @@
+package comment
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+)
+
+const (
+	MaxBodyLength = 4_000
+	MaxMentions   = 20
+)
+
+type Id struct {
+	value uuid.UUID
+}
+
+func NewId() Id {
+	return Id{uuid.New()}
+}
+
+func IdFromUUID(id uuid.UUID) (Id, error) {
+	if id == uuid.Nil {
+		return Id{}, ErrInvalidId
+	}
+	return Id{value: id}, nil
+}
+
+func IdFromString(s string) (Id, error) {
+	id, err := uuid.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return Id{}, fmt.Errorf("%w: %v", ErrInvalidId, err)
+	}
+	return IdFromUUID(id)
+}
+
+func (id Id) UUID() uuid.UUID { return id.value }
+func (id Id) String() string  { return id.value.String() }
+
+type Body struct {
+	value string
+}
+
+func NewBody(raw string) (Body, error) {
+	v := strings.TrimSpace(raw)
+	if v == "" {
+		return Body{}, ErrBodyEmpty
+	}
+	if len(v) > MaxBodyLength {
+		return Body{}, ErrBodyTooLong
+	}
+	return Body{value: v}, nil
+}
+
+func (b Body) String() string { return b.value }
+
+// @ не должен идти сразу после буквы или точки, иначе это e-mail, а не упоминание.
+var mentionRe = regexp.MustCompile(`(?:^|[^A-Za-z0-9_.@])@([A-Za-z0-9_][A-Za-z0-9_.\-]*)`)
+
+// Mentions возвращает имена из @username в порядке появления, без повторов (без учёта регистра).
+func (b Body) Mentions() []string {
+	out := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, m := range mentionRe.FindAllStringSubmatch(b.value, -1) {
+		name := strings.TrimRight(m[1], ".-")
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok || name == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, name)
+		if len(out) == MaxMentions {
+			break
+		}
+	}
+	return out
+}
+
+// Cursor — последний отданный комментарий; комментарии идут от старых к новым.
+type Cursor struct {
+	CreatedAt time.Time
+	Id        Id
+}
+
+type cursorPayload struct {
+	CreatedAt time.Time `json:"c"`
+	Id        uuid.UUID `json:"i"`
+}
+
+func CursorAfter(c *Comment) Cursor {
+	return Cursor{CreatedAt: c.CreatedAt(), Id: c.Id()}
+}
+
+func DecodeCursor(raw string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	var p cursorPayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	id, err := IdFromUUID(p.Id)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return Cursor{CreatedAt: p.CreatedAt, Id: id}, nil
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(cursorPayload{
+		CreatedAt: c.CreatedAt,
+		Id:        c.Id.UUID(),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+type ListQuery struct {
+	TaskId task.Id
+	After  *Cursor
+	Limit  int
+}
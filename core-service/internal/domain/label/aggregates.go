@@ -0,0 +1,59 @@
+package label
+
+import (
+	"time"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+)
+
+type Label struct {
+	id        Id
+	boardId   board.Id
+	name      Name
+	color     Color
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func New(boardId board.Id, name Name, color Color) *Label {
+	now := time.Now().UTC()
+	return &Label{
+		id:        NewId(),
+		boardId:   boardId,
+		name:      name,
+		color:     color,
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+func Rehydrate(
+	id Id,
+	boardId board.Id,
+	name Name,
+	color Color,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Label {
+	return &Label{
+		id:        id,
+		boardId:   boardId,
+		name:      name,
+		color:     color,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (l *Label) Id() Id               { return l.id }
+func (l *Label) BoardId() board.Id    { return l.boardId }
+func (l *Label) Name() Name           { return l.name }
+func (l *Label) Color() Color         { return l.color }
+func (l *Label) CreatedAt() time.Time { return l.createdAt }
+func (l *Label) UpdatedAt() time.Time { return l.updatedAt }
+
+func (l *Label) Update(name Name, color Color) {
+	l.name = name
+	l.color = color
+	l.updatedAt = time.Now().UTC()
+}
@@ -0,0 +1,17 @@
+package label
+
+import (
+	"fmt"
+
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+var (
+	ErrNotFound      = fmt.Errorf("%s %w", "label", shared.ErrNotFound)
+	ErrInvalidId     = fmt.Errorf("%s %w", "label id", shared.ErrIsInvalid)
+	ErrNameEmpty     = fmt.Errorf("%s %w", "label name", shared.ErrIsEmpty)
+	ErrNameTooLong   = fmt.Errorf("%s %w", "label name", shared.ErrIsTooLong)
+	ErrInvalidColor  = fmt.Errorf("%s %w", "label color", shared.ErrIsInvalid)
+	ErrBoardMismatch = fmt.Errorf("%s %w", "label board id", shared.ErrIsMismatch)
+	ErrNameTaken     = fmt.Errorf("%s %w", "label name on board", shared.ErrIsConflict)
+)
@@ -0,0 +1,21 @@
+package label
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+)
+
+type Repository interface {
+	Save(ctx context.Context, l *Label) error
+	Get(ctx context.Context, id Id) (*Label, error)
+	ListByIds(ctx context.Context, ids []Id) ([]*Label, error)
+	ListByBoard(ctx context.Context, boardId board.Id) ([]*Label, error)
+	ListByBoards(ctx context.Context, boardIds []board.Id) ([]*Label, error)
+	Delete(ctx context.Context, id Id) error
+
+	// RetargetColumn переводит метки всех задач колонки на одноимённые метки доски toBoardId;
+	// метки без пары на новой доске с задач снимаются.
+	RetargetColumn(ctx context.Context, columnId column.Id, toBoardId board.Id) error
+}
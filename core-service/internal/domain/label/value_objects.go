@@ -0,0 +1,71 @@
+package label
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const MaxNameLength = 64
+
+var colorRe = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+type Id struct {
+	value uuid.UUID
+}
+
+func NewId() Id {
+	return Id{value: uuid.New()}
+}
+
+func IdFromUUID(id uuid.UUID) (Id, error) {
+	if id == uuid.Nil {
+		return Id{}, ErrInvalidId
+	}
+	return Id{value: id}, nil
+}
+
+func IdFromString(s string) (Id, error) {
+	id, err := uuid.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return Id{}, fmt.Errorf("%w: %v", ErrInvalidId, err)
+	}
+	return IdFromUUID(id)
+}
+
+func (id Id) UUID() uuid.UUID { return id.value }
+func (id Id) String() string  { return id.value.String() }
+
+type Name struct {
+	value string
+}
+
+func NewName(raw string) (Name, error) {
+	v := strings.TrimSpace(raw)
+	if v == "" {
+		return Name{}, ErrNameEmpty
+	}
+	if len(v) > MaxNameLength {
+		return Name{}, ErrNameTooLong
+	}
+	return Name{value: v}, nil
+}
+
+func (n Name) String() string { return n.value }
+
+// Color — цвет в виде #rrggbb, хранится в нижнем регистре.
+type Color struct {
+	value string
+}
+
+func NewColor(raw string) (Color, error) {
+	v := strings.ToLower(strings.TrimSpace(raw))
+	if !colorRe.MatchString(v) {
+		return Color{}, ErrInvalidColor
+	}
+	return Color{value: v}, nil
+}
+
+func (c Color) String() string { return c.value }
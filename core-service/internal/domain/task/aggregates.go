@@ -1,9 +1,12 @@
 package task
 
 import (
+	"slices"
 	"time"
 
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
 	"github.com/smarrog/task-board/shared/domain/shared"
 	"github.com/smarrog/task-board/shared/domain/task"
 )
@@ -12,20 +15,31 @@ type Task struct {
 	id          Id
 	columnId    column.Id
 	position    Position
+	sortKey     SortKey
 	title       Title
 	description Description
 	assigneeId  shared.UserId
+	dates       Dates
+	priority    Priority
+	estimate    Estimate
+	labelIds    []label.Id
 	createdAt   time.Time
 	updatedAt   time.Time
+	archivedAt  time.Time
+	version     int64
 	events      []shared.DomainEvent
 }
 
 func New(
 	columnId column.Id,
 	position Position,
+	sortKey SortKey,
 	title Title,
 	desc Description,
 	assigneeId shared.UserId,
+	dates Dates,
+	priority Priority,
+	estimate Estimate,
 ) *Task {
 	now := time.Now().UTC()
 
@@ -33,9 +47,13 @@ func New(
 		id:          NewId(),
 		columnId:    columnId,
 		position:    position,
+		sortKey:     sortKey,
 		title:       title,
 		description: desc,
 		assigneeId:  assigneeId,
+		dates:       dates,
+		priority:    priority,
+		estimate:    estimate,
 		createdAt:   now,
 		updatedAt:   now,
 	}
@@ -47,6 +65,10 @@ func New(
 		Title:       title.String(),
 		Description: desc.String(),
 		AssigneeId:  assigneeId.String(),
+		StartAt:     timePtr(dates.StartAt()),
+		DueAt:       timePtr(dates.DueAt()),
+		Priority:    priority.String(),
+		Estimate:    estimate.Ptr(),
 		At:          now,
 	})
 	return t
@@ -56,51 +78,215 @@ func Rehydrate(
 	id Id,
 	columnId column.Id,
 	position Position,
+	sortKey SortKey,
 	title Title,
 	desc Description,
 	assigneeId shared.UserId,
+	dates Dates,
+	priority Priority,
+	estimate Estimate,
+	labelIds []label.Id,
 	createdAt time.Time,
 	updatedAt time.Time,
+	archivedAt time.Time,
+	version int64,
 ) *Task {
 	return &Task{
 		id:          id,
 		columnId:    columnId,
 		position:    position,
+		sortKey:     sortKey,
 		title:       title,
 		description: desc,
 		assigneeId:  assigneeId,
+		dates:       dates,
+		priority:    priority,
+		estimate:    estimate,
+		labelIds:    labelIds,
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
+		archivedAt:  archivedAt,
+		version:     version,
 	}
 }
 
 func (t *Task) Id() Id                    { return t.id }
 func (t *Task) ColumnId() column.Id       { return t.columnId }
 func (t *Task) Position() Position        { return t.position }
+func (t *Task) SortKey() SortKey          { return t.sortKey }
 func (t *Task) Title() Title              { return t.title }
 func (t *Task) Description() Description  { return t.description }
 func (t *Task) AssigneeId() shared.UserId { return t.assigneeId }
+func (t *Task) Dates() Dates              { return t.dates }
+func (t *Task) Priority() Priority        { return t.priority }
+func (t *Task) Estimate() Estimate        { return t.estimate }
+func (t *Task) LabelIds() []label.Id      { return t.labelIds }
 func (t *Task) CreatedAt() time.Time      { return t.createdAt }
 func (t *Task) UpdatedAt() time.Time      { return t.updatedAt }
+func (t *Task) ArchivedAt() time.Time     { return t.archivedAt }
+func (t *Task) IsArchived() bool          { return !t.archivedAt.IsZero() }
 
-func (t *Task) Update(title Title, desc Description, assigneeId shared.UserId) {
-	now := time.Now().UTC()
+func (t *Task) Version() int64 { return t.version }
 
-	t.title = title
-	t.description = desc
-	t.assigneeId = assigneeId
-	t.updatedAt = now
+// CheckVersion сверяет версию, которую видел клиент (If-Match); 0 — без проверки.
+func (t *Task) CheckVersion(expected int64) error {
+	if expected != 0 && expected != t.version {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// SetVersion фиксирует версию, присвоенную хранилищем при записи.
+func (t *Task) SetVersion(v int64) { t.version = v }
 
+// UpdatableFields — пути, допустимые в маске обновления задачи.
+var UpdatableFields = []string{
+	task.FieldTitle, task.FieldDescription, task.FieldAssigneeId,
+	task.FieldStartAt, task.FieldDueAt, task.FieldPriority, task.FieldEstimate,
+}
+
+// Update заменяет редактируемые поля задачи; в событие попадают только изменённые.
+// Возвращает false, если менять нечего.
+func (t *Task) Update(title Title, desc Description, assigneeId shared.UserId, dates Dates, priority Priority, estimate Estimate) bool {
+	ev := task.UpdatedEvent{Id: t.id.String()}
+	if title != t.title {
+		t.title = title
+		ev.Fields = append(ev.Fields, task.FieldTitle)
+		ev.Title = strPtr(title.String())
+	}
+	if desc != t.description {
+		t.description = desc
+		ev.Fields = append(ev.Fields, task.FieldDescription)
+		ev.Description = strPtr(desc.String())
+	}
+	if assigneeId != t.assigneeId {
+		t.assigneeId = assigneeId
+		ev.Fields = append(ev.Fields, task.FieldAssigneeId)
+		ev.AssigneeId = strPtr(assigneeId.String())
+	}
+	if !dates.StartAt().Equal(t.dates.StartAt()) {
+		ev.Fields = append(ev.Fields, task.FieldStartAt)
+		ev.StartAt = timePtr(dates.StartAt())
+	}
+	if !dates.DueAt().Equal(t.dates.DueAt()) {
+		ev.Fields = append(ev.Fields, task.FieldDueAt)
+		ev.DueAt = timePtr(dates.DueAt())
+	}
+	t.dates = dates
+	if priority != t.priority {
+		t.priority = priority
+		ev.Fields = append(ev.Fields, task.FieldPriority)
+		ev.Priority = strPtr(priority.String())
+	}
+	if estimate != t.estimate {
+		t.estimate = estimate
+		ev.Fields = append(ev.Fields, task.FieldEstimate)
+		ev.Estimate = estimate.Ptr()
+	}
+	if len(ev.Fields) == 0 {
+		return false
+	}
+
+	t.updatedAt = time.Now().UTC()
+	ev.At = t.updatedAt
+	t.events = append(t.events, ev)
+	return true
+}
+
+// SetLabels заменяет набор меток задачи и возвращает false, если он не изменился.
+func (t *Task) SetLabels(labelIds []label.Id) bool {
+	current := make(map[label.Id]struct{}, len(t.labelIds))
+	for _, id := range t.labelIds {
+		current[id] = struct{}{}
+	}
+
+	next := make([]label.Id, 0, len(labelIds))
+	seen := make(map[label.Id]struct{}, len(labelIds))
+	var added []string
+	for _, id := range labelIds {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		next = append(next, id)
+		if _, ok := current[id]; !ok {
+			added = append(added, id.String())
+		}
+	}
+	var removed []string
+	for _, id := range t.labelIds {
+		if _, ok := seen[id]; !ok {
+			removed = append(removed, id.String())
+		}
+	}
+
+	if len(added) == 0 && len(removed) == 0 {
+		return false
+	}
+
+	t.labelIds = next
+	t.updatedAt = time.Now().UTC()
 	t.events = append(t.events, task.UpdatedEvent{
-		Id:          t.id.String(),
-		Title:       t.title.String(),
-		Description: t.description.String(),
-		AssigneeId:  assigneeId.String(),
-		At:          t.updatedAt,
+		Id:            t.id.String(),
+		Fields:        []string{task.FieldLabelIds},
+		LabelsAdded:   added,
+		LabelsRemoved: removed,
+		At:            t.updatedAt,
 	})
+	return true
+}
+
+// LabelsChanged сообщает, что набор меток менялся с последнего сохранения:
+// только тогда репозиторию нужно синхронизировать task_labels.
+func (t *Task) LabelsChanged() bool {
+	for _, ev := range t.events {
+		switch e := ev.(type) {
+		case task.CreatedEvent:
+			return true
+		case task.UpdatedEvent:
+			if slices.Contains(e.Fields, task.FieldLabelIds) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AssigneeChanged сообщает, что задача создана или сменила исполнителя с последнего сохранения.
+func (t *Task) AssigneeChanged() bool {
+	for _, ev := range t.events {
+		switch e := ev.(type) {
+		case task.CreatedEvent:
+			return true
+		case task.UpdatedEvent:
+			if slices.Contains(e.Fields, task.FieldAssigneeId) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
-func (t *Task) Move(toColumnId column.Id, toPosition Position) {
+// CopyTo создаёт новую задачу с теми же полями в колонке columnId; позиция и ключ
+// сортировки сохраняются. Метки передаются уже пересопоставленными на новую доску.
+func (t *Task) CopyTo(columnId column.Id, labelIds []label.Id) *Task {
+	c := New(columnId, t.position, t.sortKey, t.title, t.description, t.assigneeId, t.dates, t.priority, t.estimate)
+	c.labelIds = labelIds
+	return c
+}
+
+func (t *Task) HasAnyLabel(ids map[label.Id]struct{}) bool {
+	for _, id := range t.labelIds {
+		if _, ok := ids[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Move ставит задачу на позицию toPosition колонки toColumnId; sortKey должен задавать то же место.
+// Доски колонок нужны только для события: при переносе между досками они различаются.
+func (t *Task) Move(toColumnId column.Id, toPosition Position, sortKey SortKey, fromBoardId, toBoardId board.Id) {
 	now := time.Now().UTC()
 
 	fromColumnId := t.columnId
@@ -108,18 +294,73 @@ func (t *Task) Move(toColumnId column.Id, toPosition Position) {
 
 	t.columnId = toColumnId
 	t.position = toPosition
+	t.sortKey = sortKey
 	t.updatedAt = now
 
 	t.events = append(t.events, task.MovedEvent{
 		Id:           t.id.String(),
 		FromColumnId: fromColumnId.String(),
 		ToColumnId:   toColumnId.String(),
+		FromBoardId:  fromBoardId.String(),
+		ToBoardId:    toBoardId.String(),
 		FromPosition: fromPosition.Int(),
 		ToPosition:   toPosition.Int(),
 		At:           t.updatedAt,
 	})
 }
 
+// Restore возвращает задачу из корзины; по прежнему ключу она встаёт на позицию position.
+func (t *Task) Restore(position Position) {
+	t.position = position
+	t.updatedAt = time.Now().UTC()
+
+	t.events = append(t.events, task.RestoredEvent{
+		Id:       t.id.String(),
+		ColumnId: t.columnId.String(),
+		Position: position.Int(),
+		At:       t.updatedAt,
+	})
+}
+
+// Archive убирает задачу из порядка колонки; она остаётся доступной по id и в поиске.
+func (t *Task) Archive() error {
+	if t.IsArchived() {
+		return ErrArchived
+	}
+	t.archivedAt = time.Now().UTC()
+	t.updatedAt = t.archivedAt
+
+	t.events = append(t.events, task.ArchivedEvent{
+		Id:       t.id.String(),
+		ColumnId: t.columnId.String(),
+		At:       t.archivedAt,
+	})
+	return nil
+}
+
+// Unarchive возвращает задачу в колонку toColumnId на позицию toPosition; sortKey должен задавать то же место.
+func (t *Task) Unarchive(toColumnId column.Id, toPosition Position, sortKey SortKey) error {
+	if !t.IsArchived() {
+		return ErrNotArchived
+	}
+	fromColumnId := t.columnId
+
+	t.columnId = toColumnId
+	t.position = toPosition
+	t.sortKey = sortKey
+	t.archivedAt = time.Time{}
+	t.updatedAt = time.Now().UTC()
+
+	t.events = append(t.events, task.UnarchivedEvent{
+		Id:           t.id.String(),
+		FromColumnId: fromColumnId.String(),
+		ToColumnId:   toColumnId.String(),
+		Position:     toPosition.Int(),
+		At:           t.updatedAt,
+	})
+	return nil
+}
+
 func (t *Task) PullEvents() []shared.DomainEvent {
 	if len(t.events) == 0 {
 		return nil
@@ -129,3 +370,12 @@ func (t *Task) PullEvents() []shared.DomainEvent {
 	t.events = nil
 	return out
 }
+
+func strPtr(s string) *string { return &s }
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
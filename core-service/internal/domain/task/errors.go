@@ -11,6 +11,21 @@ var (
 	ErrInvalidId          = fmt.Errorf("%s %w", "task id", shared.ErrIsInvalid)
 	ErrTitleEmpty         = fmt.Errorf("%s %w", "task title", shared.ErrIsEmpty)
 	ErrInvalidPosition    = fmt.Errorf("%s %w", "task position", shared.ErrIsInvalid)
+	ErrInvalidSortKey     = fmt.Errorf("%s %w", "task sort key", shared.ErrIsInvalid)
 	ErrTitleTooLong       = fmt.Errorf("%s %w", "task title", shared.ErrIsTooLong)
 	ErrDescriptionTooLong = fmt.Errorf("%s %w", "task description", shared.ErrIsTooLong)
+	ErrSearchQueryEmpty   = fmt.Errorf("%s %w", "search query", shared.ErrIsEmpty)
+	ErrSearchQueryTooLong = fmt.Errorf("%s %w", "search query", shared.ErrIsTooLong)
+	ErrInvalidDateRange   = fmt.Errorf("%s %w", "search date range", shared.ErrIsInvalid)
+	ErrInvalidCursor      = fmt.Errorf("%s %w", "search cursor", shared.ErrIsInvalid)
+	ErrInvalidSort        = fmt.Errorf("%s %w", "tasks sort", shared.ErrIsInvalid)
+	ErrStartNotBeforeDue  = fmt.Errorf("%s %w", "task dates", shared.ErrIsInvalid)
+	ErrInvalidPriority    = fmt.Errorf("%s %w", "task priority", shared.ErrIsInvalid)
+	ErrInvalidEstimate    = fmt.Errorf("%s %w", "task estimate", shared.ErrIsInvalid)
+	ErrColumnDeleted      = fmt.Errorf("%s %w", "task column", shared.ErrIsDeleted)
+	ErrArchived           = fmt.Errorf("%s %w", "task", shared.ErrIsArchived)
+	ErrNotArchived        = fmt.Errorf("%s %w", "task", shared.ErrIsNotArchived)
+	ErrVersionMismatch    = fmt.Errorf("%s %w", "task version", shared.ErrIsStale)
+	ErrConcurrentUpdate   = fmt.Errorf("%s %w", "task update", shared.ErrIsConflict)
+	ErrInvalidUpdateMask  = fmt.Errorf("%s %w", "task update mask", shared.ErrIsInvalid)
 )
@@ -2,8 +2,12 @@ package task
 
 import (
 	"context"
+	"time"
 
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/shared/domain/shared"
 )
 
 type Repository interface {
@@ -13,12 +17,24 @@ type Repository interface {
 	Get(ctx context.Context, id Id) (*Task, error)
 	ListByColumn(ctx context.Context, columnId column.Id) ([]*Task, error)
 	ListByColumns(ctx context.Context, columnIds []column.Id) ([]*Task, error)
+	ListByLabel(ctx context.Context, labelId label.Id) ([]*Task, error)
 	Delete(ctx context.Context, id Id) error
 
-	LockColumnTasks(ctx context.Context, columnId column.Id) error
+	GetTrashed(ctx context.Context, id Id) (*Trashed, error)
+	ListTrashed(ctx context.Context, boardId board.Id) ([]Trashed, error)
+	Restore(ctx context.Context, t *Task) error
+	Search(ctx context.Context, q SearchQuery) ([]SearchHit, error)
+	ListTasksByAssignee(ctx context.Context, requesterId shared.UserId, assigneeId shared.UserId, sort AssignedSort, includeArchived bool) ([]AssignedTask, error)
+
+	// CountInColumn считает задачи в порядке колонки: без корзины и архива.
 	CountInColumn(ctx context.Context, columnId column.Id) (int, error)
+	// SortKeyAt подбирает ключ для места index среди живых задач колонки, не считая задачу skip.
+	// Если между соседями ключей не осталось, колонка перебалансируется.
+	SortKeyAt(ctx context.Context, columnId column.Id, index int, skip Id) (SortKey, error)
+}
 
-	ShiftPositions(ctx context.Context, columnId column.Id, fromIncl, toIncl int, delta int) error
-	ShiftAfterRemove(ctx context.Context, columnId column.Id, fromPos int) error
-	ShiftForInsert(ctx context.Context, columnId column.Id, toPos int) error
+// Trashed — задача в корзине и момент её удаления.
+type Trashed struct {
+	Task      *Task
+	DeletedAt time.Time
 }
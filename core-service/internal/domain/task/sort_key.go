@@ -0,0 +1,120 @@
+package task
+
+import (
+	"errors"
+	"strings"
+)
+
+// SortKey задаёт порядок задач в колонке: задачи сортируются по ключу как по строке
+// (COLLATE "C"), а позиция выводится из порядка. Ключ читается как дробь 0.xxx в
+// системе по основанию 36 и никогда не кончается на '0', поэтому между любыми двумя
+// ключами найдётся третий и перемещение задачи меняет только её собственную строку.
+type SortKey string
+
+const (
+	sortKeyDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	// MaxSortKeyLength — после него колонку пора перебалансировать.
+	MaxSortKeyLength = 64
+)
+
+// ErrSortKeyExhausted — между соседями не осталось короткого ключа.
+var ErrSortKeyExhausted = errors.New("task sort key exhausted")
+
+func NewSortKey(raw string) (SortKey, error) {
+	if raw == "" || len(raw) > MaxSortKeyLength || strings.HasSuffix(raw, "0") {
+		return "", ErrInvalidSortKey
+	}
+	for i := 0; i < len(raw); i++ {
+		if strings.IndexByte(sortKeyDigits, raw[i]) < 0 {
+			return "", ErrInvalidSortKey
+		}
+	}
+	return SortKey(raw), nil
+}
+
+func (k SortKey) String() string { return string(k) }
+
+// SortKeyBetween возвращает ключ строго между prev и next.
+// Пустой prev — начало колонки, пустой next — конец.
+func SortKeyBetween(prev, next SortKey) (SortKey, error) {
+	if next != "" && prev >= next {
+		return "", ErrSortKeyExhausted
+	}
+	k := SortKey(midpoint(string(prev), string(next)))
+	if len(k) > MaxSortKeyLength {
+		return "", ErrSortKeyExhausted
+	}
+	return k, nil
+}
+
+// SpreadSortKeys раздаёт n ключей одинаковой длины с равными промежутками —
+// так перебалансированной колонке надолго хватает места для вставок.
+func SpreadSortKeys(n int) []SortKey {
+	base := len(sortKeyDigits)
+	width, capacity := 1, base
+	for capacity < (n+1)*base {
+		width++
+		capacity *= base
+	}
+	step := capacity / (n + 1)
+
+	out := make([]SortKey, 0, n)
+	buf := make([]byte, width)
+	for i := 1; i <= n; i++ {
+		v := step * i
+		for j := width - 1; j >= 0; j-- {
+			buf[j] = sortKeyDigits[v%base]
+			v /= base
+		}
+		out = append(out, SortKey(strings.TrimRight(string(buf), "0")))
+	}
+	return out
+}
+
+// midpoint — середина между дробями a и b (пустая b — единица); a < b.
+func midpoint(a, b string) string {
+	if b != "" {
+		// общий префикс; недостающие разряды a считаем нулями
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + midpoint(rest, b[n:])
+		}
+	}
+
+	da := 0
+	if a != "" {
+		da = strings.IndexByte(sortKeyDigits, a[0])
+	}
+	db := len(sortKeyDigits)
+	if b != "" {
+		db = strings.IndexByte(sortKeyDigits, b[0])
+	}
+	if db-da > 1 {
+		return string(sortKeyDigits[(da+db)/2])
+	}
+
+	// разряды соседние: либо хватает первого разряда b, либо уходим вглубь после a
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(sortKeyDigits[da]) + midpoint(rest, "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return '0'
+}
@@ -0,0 +1,18 @@
+package task
+
+// Stats — сводка по набору задач колонки для планирования.
+type Stats struct {
+	Count         int
+	TotalEstimate float64
+	ByPriority    map[Priority]int
+}
+
+func StatsOf(tasks []*Task) Stats {
+	s := Stats{ByPriority: make(map[Priority]int)}
+	for _, t := range tasks {
+		s.Count++
+		s.TotalEstimate += t.Estimate().Points()
+		s.ByPriority[t.Priority()]++
+	}
+	return s
+}
@@ -1,15 +1,24 @@
 package task
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/shared/domain/shared"
 )
 
 const (
 	MaxTitleLength       = 255
 	MaxDescriptionLength = 1_024
+	MaxSearchQueryLength = 255
+	MaxEstimate          = 1_000
 )
 
 type Id struct {
@@ -82,3 +91,178 @@ func NewDescription(raw string) (Description, error) {
 }
 
 func (d Description) String() string { return d.value }
+
+// Dates — необязательные дата начала и срок задачи; нулевое время значит «не задано».
+type Dates struct {
+	startAt time.Time
+	dueAt   time.Time
+}
+
+func NewDates(startAt, dueAt time.Time) (Dates, error) {
+	if !startAt.IsZero() && !dueAt.IsZero() && !startAt.Before(dueAt) {
+		return Dates{}, ErrStartNotBeforeDue
+	}
+	return Dates{startAt: startAt.UTC(), dueAt: dueAt.UTC()}, nil
+}
+
+func (d Dates) StartAt() time.Time { return d.startAt }
+func (d Dates) DueAt() time.Time   { return d.dueAt }
+
+type Priority string
+
+const (
+	PriorityNone   Priority = ""
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+	PriorityUrgent Priority = "urgent"
+)
+
+// Priorities — все значения приоритета по возрастанию, включая «не задан».
+var Priorities = []Priority{PriorityNone, PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent}
+
+func NewPriority(raw string) (Priority, error) {
+	switch v := Priority(strings.ToLower(strings.TrimSpace(raw))); v {
+	case PriorityNone, PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent:
+		return v, nil
+	default:
+		return PriorityNone, ErrInvalidPriority
+	}
+}
+
+func (p Priority) String() string { return string(p) }
+
+// Estimate — оценка в story points; нулевое значение значит «не оценена».
+type Estimate struct {
+	points float64
+	set    bool
+}
+
+func NoEstimate() Estimate { return Estimate{} }
+
+func NewEstimate(points float64) (Estimate, error) {
+	if math.IsNaN(points) || math.IsInf(points, 0) || points < 0 || points > MaxEstimate {
+		return Estimate{}, ErrInvalidEstimate
+	}
+	// в БД оценка хранится с точностью до сотых
+	return Estimate{points: math.Round(points*100) / 100, set: true}, nil
+}
+
+// EstimateFromPtr — nil значит «не оценена».
+func EstimateFromPtr(points *float64) (Estimate, error) {
+	if points == nil {
+		return NoEstimate(), nil
+	}
+	return NewEstimate(*points)
+}
+
+func (e Estimate) Points() float64 { return e.points }
+func (e Estimate) IsSet() bool     { return e.set }
+
+func (e Estimate) Ptr() *float64 {
+	if !e.set {
+		return nil
+	}
+	v := e.points
+	return &v
+}
+
+type SearchText struct {
+	value string
+}
+
+func NewSearchText(raw string) (SearchText, error) {
+	v := strings.TrimSpace(raw)
+	if v == "" {
+		return SearchText{}, ErrSearchQueryEmpty
+	}
+	if len(v) > MaxSearchQueryLength {
+		return SearchText{}, ErrSearchQueryTooLong
+	}
+	return SearchText{value: v}, nil
+}
+
+func (s SearchText) String() string { return s.value }
+
+// SearchCursor — ранг и id последнего отданного результата.
+type SearchCursor struct {
+	Rank float32
+	Id   Id
+}
+
+type searchCursorPayload struct {
+	Rank float32   `json:"r"`
+	Id   uuid.UUID `json:"i"`
+}
+
+func DecodeSearchCursor(raw string) (SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return SearchCursor{}, ErrInvalidCursor
+	}
+	var p searchCursorPayload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return SearchCursor{}, ErrInvalidCursor
+	}
+	id, err := IdFromUUID(p.Id)
+	if err != nil {
+		return SearchCursor{}, ErrInvalidCursor
+	}
+	return SearchCursor{Rank: p.Rank, Id: id}, nil
+}
+
+func (c SearchCursor) Encode() string {
+	data, _ := json.Marshal(searchCursorPayload{Rank: c.Rank, Id: c.Id.UUID()})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// SearchQuery ищет задачи только на досках RequesterId; остальные фильтры необязательны.
+type SearchQuery struct {
+	Text            SearchText
+	RequesterId     shared.UserId
+	BoardId         *board.Id
+	ColumnId        *column.Id
+	AssigneeId      *shared.UserId
+	CreatedFrom     time.Time
+	CreatedTo       time.Time
+	After           *SearchCursor
+	Limit           int
+	IncludeArchived bool
+}
+
+type SearchHit struct {
+	Task    *Task
+	BoardId board.Id
+	Rank    float32
+	Snippet string
+}
+
+func (h SearchHit) Cursor() SearchCursor {
+	return SearchCursor{Rank: h.Rank, Id: h.Task.Id()}
+}
+
+type AssignedSort string
+
+const (
+	AssignedSortUpdatedAt AssignedSort = "updated_at"
+	AssignedSortDueAt     AssignedSort = "due_at"
+)
+
+func NewAssignedSort(raw string) (AssignedSort, error) {
+	switch v := AssignedSort(strings.TrimSpace(raw)); v {
+	case "":
+		return AssignedSortUpdatedAt, nil
+	case AssignedSortUpdatedAt, AssignedSortDueAt:
+		return v, nil
+	default:
+		return "", ErrInvalidSort
+	}
+}
+
+// AssignedTask — задача исполнителя вместе с доской и колонкой, в которых она лежит.
+type AssignedTask struct {
+	Task           *Task
+	BoardId        board.Id
+	BoardTitle     board.Title
+	ColumnPosition column.Position
+}
@@ -0,0 +1,80 @@
+package template
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+// Template — сохранённая раскладка доски: колонки по порядку и набор меток.
+// Шаблон без владельца встроенный и виден всем пользователям.
+type Template struct {
+	id          Id
+	ownerId     shared.UserId
+	name        Name
+	description Description
+	columns     []column.Title
+	labels      []LabelSpec
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func New(ownerId shared.UserId, name Name, description Description, columns []column.Title, labels []LabelSpec) (*Template, error) {
+	if ownerId.UUID() == uuid.Nil {
+		return nil, ErrOwnerRequired
+	}
+	if len(columns) == 0 {
+		return nil, ErrColumnsEmpty
+	}
+
+	now := time.Now().UTC()
+	return &Template{
+		id:          NewId(),
+		ownerId:     ownerId,
+		name:        name,
+		description: description,
+		columns:     columns,
+		labels:      labels,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+func Rehydrate(
+	id Id,
+	ownerId shared.UserId,
+	name Name,
+	description Description,
+	columns []column.Title,
+	labels []LabelSpec,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Template {
+	return &Template{
+		id:          id,
+		ownerId:     ownerId,
+		name:        name,
+		description: description,
+		columns:     columns,
+		labels:      labels,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+func (t *Template) Id() Id                   { return t.id }
+func (t *Template) OwnerId() shared.UserId   { return t.ownerId }
+func (t *Template) Name() Name               { return t.name }
+func (t *Template) Description() Description { return t.description }
+func (t *Template) Columns() []column.Title  { return t.columns }
+func (t *Template) Labels() []LabelSpec      { return t.labels }
+func (t *Template) CreatedAt() time.Time     { return t.createdAt }
+func (t *Template) UpdatedAt() time.Time     { return t.updatedAt }
+func (t *Template) IsBuiltIn() bool          { return t.ownerId.UUID() == uuid.Nil }
+
+// VisibleTo — встроенные шаблоны видны всем, остальные только владельцу.
+func (t *Template) VisibleTo(userId shared.UserId) bool {
+	return t.IsBuiltIn() || t.ownerId == userId
+}
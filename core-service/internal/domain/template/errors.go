@@ -0,0 +1,18 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+var (
+	ErrNotFound           = fmt.Errorf("%s %w", "board template", shared.ErrNotFound)
+	ErrInvalidId          = fmt.Errorf("%s %w", "board template id", shared.ErrIsInvalid)
+	ErrOwnerRequired      = fmt.Errorf("%s %w", "board template owner id", shared.ErrIsRequired)
+	ErrOwnerMismatch      = fmt.Errorf("%s %w", "board template owner id", shared.ErrIsMismatch)
+	ErrNameEmpty          = fmt.Errorf("%s %w", "board template name", shared.ErrIsEmpty)
+	ErrNameTooLong        = fmt.Errorf("%s %w", "board template name", shared.ErrIsTooLong)
+	ErrDescriptionTooLong = fmt.Errorf("%s %w", "board template description", shared.ErrIsTooLong)
+	ErrColumnsEmpty       = fmt.Errorf("%s %w", "board template columns", shared.ErrIsEmpty)
+)
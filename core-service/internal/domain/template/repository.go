@@ -0,0 +1,15 @@
+package template
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type Repository interface {
+	Save(ctx context.Context, t *Template) error
+	Get(ctx context.Context, id Id) (*Template, error)
+	// ListVisible возвращает встроенные шаблоны и шаблоны пользователя.
+	ListVisible(ctx context.Context, ownerId shared.UserId) ([]*Template, error)
+	Delete(ctx context.Context, id Id) error
+}
@@ -0,0 +1,77 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+)
+
+const (
+	MaxNameLength        = 255
+	MaxDescriptionLength = 1_024
+)
+
+type Id struct {
+	value uuid.UUID
+}
+
+func NewId() Id {
+	return Id{value: uuid.New()}
+}
+
+func IdFromUUID(id uuid.UUID) (Id, error) {
+	if id == uuid.Nil {
+		return Id{}, ErrInvalidId
+	}
+	return Id{value: id}, nil
+}
+
+func IdFromString(s string) (Id, error) {
+	id, err := uuid.Parse(strings.TrimSpace(s))
+	if err != nil {
+		return Id{}, fmt.Errorf("%w: %v", ErrInvalidId, err)
+	}
+	return IdFromUUID(id)
+}
+
+func (id Id) UUID() uuid.UUID { return id.value }
+func (id Id) String() string  { return id.value.String() }
+
+type Name struct {
+	value string
+}
+
+func NewName(raw string) (Name, error) {
+	v := strings.TrimSpace(raw)
+	if v == "" {
+		return Name{}, ErrNameEmpty
+	}
+	if len(v) > MaxNameLength {
+		return Name{}, ErrNameTooLong
+	}
+	return Name{value: v}, nil
+}
+
+func (n Name) String() string { return n.value }
+
+type Description struct {
+	value string
+}
+
+func NewDescription(raw string) (Description, error) {
+	v := strings.TrimSpace(raw)
+	if len(v) > MaxDescriptionLength {
+		return Description{}, ErrDescriptionTooLong
+	}
+	return Description{value: v}, nil
+}
+
+func (d Description) String() string { return d.value }
+
+// LabelSpec — метка, которую шаблон создаёт на новой доске.
+type LabelSpec struct {
+	Name  label.Name
+	Color label.Color
+}
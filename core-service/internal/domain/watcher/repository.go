@@ -0,0 +1,22 @@
+package watcher
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+// Repository хранит подписки пользователей на задачи и доски.
+// Повторная подписка и отписка без подписки — не ошибка.
+type Repository interface {
+	WatchTask(ctx context.Context, taskId task.Id, userId shared.UserId) error
+	UnwatchTask(ctx context.Context, taskId task.Id, userId shared.UserId) error
+	WatchBoard(ctx context.Context, boardId board.Id, userId shared.UserId) error
+	UnwatchBoard(ctx context.Context, boardId board.Id, userId shared.UserId) error
+
+	// ListByTask — все, кого касаются события задачи: подписчики самой задачи и её доски.
+	ListByTask(ctx context.Context, taskId task.Id) ([]shared.UserId, error)
+	ListByBoard(ctx context.Context, boardId board.Id) ([]shared.UserId, error)
+}
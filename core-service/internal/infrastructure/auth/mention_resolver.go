@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/smarrog/task-board/shared/domain/shared"
+	authv1 "github.com/smarrog/task-board/shared/proto/auth/v1"
+)
+
+// MentionResolver ищет пользователей по username через auth-service.
+type MentionResolver struct {
+	client  authv1.AuthServiceClient
+	timeout time.Duration
+}
+
+func NewMentionResolver(client authv1.AuthServiceClient, timeout time.Duration) *MentionResolver {
+	return &MentionResolver{client: client, timeout: timeout}
+}
+
+func (r *MentionResolver) Resolve(ctx context.Context, usernames []string) ([]shared.UserId, error) {
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	resp, err := r.client.ResolveUsernames(ctx, &authv1.ResolveUsernamesRequest{Usernames: usernames})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]shared.UserId, 0, len(resp.GetUsers()))
+	for _, u := range resp.GetUsers() {
+		uid, err := shared.UserIdFromString(u.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, uid)
+	}
+	return out, nil
+}
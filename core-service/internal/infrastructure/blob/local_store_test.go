@@ -0,0 +1,109 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
+)
+
+func newTestLocalStore(t *testing.T) (*LocalStore, string) {
+	t.Helper()
+	root := filepath.Join(t.TempDir(), "blobs")
+	s, err := NewLocalStore(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, root
+}
+
+func TestLocalStoreRoundTrip(t *testing.T) {
+	s, root := newTestLocalStore(t)
+	ctx := context.Background()
+
+	if err := s.Put(ctx, "tasks/1/a", strings.NewReader("hello"), 5, "text/plain"); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	rc, err := s.Get(ctx, "tasks/1/a")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	body, _ := io.ReadAll(rc)
+	_ = rc.Close()
+	if string(body) != "hello" {
+		t.Fatalf("body = %q", body)
+	}
+
+	if err := s.Delete(ctx, "tasks/1/a"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := s.Get(ctx, "tasks/1/a"); !errors.Is(err, attachment.ErrNotFound) {
+		t.Fatalf("get after delete: %v", err)
+	}
+	if err := s.Delete(ctx, "tasks/1/a"); err != nil {
+		t.Fatalf("delete missing: %v", err)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(root, "tasks", "1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("leftover files: %v", entries)
+	}
+}
+
+func TestLocalStoreSizeMismatchLeavesNoFile(t *testing.T) {
+	s, root := newTestLocalStore(t)
+
+	err := s.Put(context.Background(), "tasks/1/a", strings.NewReader("hello"), 10, "")
+	if err == nil {
+		t.Fatal("want size mismatch error")
+	}
+	if _, err := s.Get(context.Background(), "tasks/1/a"); !errors.Is(err, attachment.ErrNotFound) {
+		t.Fatalf("partial upload must not be visible: %v", err)
+	}
+	entries, _ := os.ReadDir(filepath.Join(root, "tasks", "1"))
+	if len(entries) != 0 {
+		t.Fatalf("temp file left behind: %v", entries)
+	}
+}
+
+func TestLocalStoreCancelledPutLeavesNoFile(t *testing.T) {
+	s, _ := newTestLocalStore(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := s.Put(ctx, "a", strings.NewReader("hello"), 5, ""); !errors.Is(err, context.Canceled) {
+		t.Fatalf("want context.Canceled, got %v", err)
+	}
+	if _, err := s.Get(context.Background(), "a"); !errors.Is(err, attachment.ErrNotFound) {
+		t.Fatalf("cancelled upload must not be visible: %v", err)
+	}
+}
+
+func TestLocalStoreRejectsKeysOutsideRoot(t *testing.T) {
+	s, _ := newTestLocalStore(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"", "..", "../x", "a/../../x", "/etc/passwd"} {
+		if err := s.Put(ctx, key, strings.NewReader("x"), 1, ""); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q) = %v, want ErrInvalidKey", key, err)
+		}
+		if _, err := s.Get(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Get(%q) = %v, want ErrInvalidKey", key, err)
+		}
+		if err := s.Delete(ctx, key); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Delete(%q) = %v, want ErrInvalidKey", key, err)
+		}
+	}
+	// ".." внутри ключа, не выводящий за root, допустим
+	if err := s.Put(ctx, "a/../b", strings.NewReader("x"), 1, ""); err != nil {
+		t.Fatalf("Put(a/../b): %v", err)
+	}
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/redis/go-redis/v9"
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 	commonuc "github.com/smarrog/task-board/core-service/internal/usecase/cache"
 	"github.com/smarrog/task-board/shared/domain/shared"
@@ -93,34 +95,62 @@ type getBoardDTO struct {
 	Board   boardDTO    `json:"board"`
 	Columns []columnDTO `json:"columns"`
 	Tasks   []taskDTO   `json:"tasks"`
+	Labels  []labelDTO  `json:"labels"`
 }
 
 type boardDTO struct {
-	Id          string    `json:"id"`
-	OwnerId     string    `json:"owner_id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	Id          string     `json:"id"`
+	OwnerId     string     `json:"owner_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	Version     int64      `json:"version"`
 }
 
 type columnDTO struct {
 	Id        string    `json:"id"`
 	BoardId   string    `json:"board_id"`
 	Position  int       `json:"position"`
+	Title     string    `json:"title,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	Version   int64     `json:"version"`
 }
 
 type taskDTO struct {
-	Id          string    `json:"id"`
-	ColumnId    string    `json:"column_id"`
-	Position    int       `json:"position"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	AssigneeId  string    `json:"assignee_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	Id          string       `json:"id"`
+	ColumnId    string       `json:"column_id"`
+	Position    int          `json:"position"`
+	SortKey     string       `json:"sort_key"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	AssigneeId  string       `json:"assignee_id"`
+	StartAt     *time.Time   `json:"start_at,omitempty"`
+	DueAt       *time.Time   `json:"due_at,omitempty"`
+	Priority    string       `json:"priority,omitempty"`
+	Estimate    *float64     `json:"estimate,omitempty"`
+	LabelIds    []string     `json:"label_ids,omitempty"`
+	Checklist   *progressDTO `json:"checklist,omitempty"`
+	Attachments int          `json:"attachments,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+	Version     int64        `json:"version"`
+}
+
+type progressDTO struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+type labelDTO struct {
+	Id        string    `json:"id"`
+	BoardId   string    `json:"board_id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 func dtoFromOutput(out *commonuc.BoardData) (*getBoardDTO, error) {
@@ -133,9 +163,12 @@ func dtoFromOutput(out *commonuc.BoardData) (*getBoardDTO, error) {
 			Description: b.Description().String(),
 			CreatedAt:   b.CreatedAt(),
 			UpdatedAt:   b.UpdatedAt(),
+			ArchivedAt:  zeroToNil(b.ArchivedAt()),
+			Version:     b.Version(),
 		},
 		Columns: make([]columnDTO, 0, len(out.Columns)),
 		Tasks:   make([]taskDTO, 0, len(out.Tasks)),
+		Labels:  make([]labelDTO, 0, len(out.Labels)),
 	}
 
 	for _, c := range out.Columns {
@@ -143,21 +176,51 @@ func dtoFromOutput(out *commonuc.BoardData) (*getBoardDTO, error) {
 			Id:        c.Id().String(),
 			BoardId:   c.BoardId().String(),
 			Position:  int(c.Position()),
+			Title:     c.Title().String(),
 			CreatedAt: c.CreatedAt(),
 			UpdatedAt: c.UpdatedAt(),
+			Version:   c.Version(),
 		})
 	}
 
 	for _, t := range out.Tasks {
+		labelIds := make([]string, 0, len(t.LabelIds()))
+		for _, lid := range t.LabelIds() {
+			labelIds = append(labelIds, lid.String())
+		}
+		var progress *progressDTO
+		if p, ok := out.Checklists[t.Id()]; ok {
+			progress = &progressDTO{Done: p.Done, Total: p.Total}
+		}
 		dto.Tasks = append(dto.Tasks, taskDTO{
 			Id:          t.Id().String(),
 			ColumnId:    t.ColumnId().String(),
 			Position:    int(t.Position()),
+			SortKey:     t.SortKey().String(),
 			Title:       t.Title().String(),
 			Description: t.Description().String(),
 			AssigneeId:  t.AssigneeId().String(),
+			StartAt:     zeroToNil(t.Dates().StartAt()),
+			DueAt:       zeroToNil(t.Dates().DueAt()),
+			Priority:    t.Priority().String(),
+			Estimate:    t.Estimate().Ptr(),
+			LabelIds:    labelIds,
+			Checklist:   progress,
+			Attachments: out.Attachments[t.Id()],
 			CreatedAt:   t.CreatedAt(),
 			UpdatedAt:   t.UpdatedAt(),
+			Version:     t.Version(),
+		})
+	}
+
+	for _, l := range out.Labels {
+		dto.Labels = append(dto.Labels, labelDTO{
+			Id:        l.Id().String(),
+			BoardId:   l.BoardId().String(),
+			Name:      l.Name().String(),
+			Color:     l.Color().String(),
+			CreatedAt: l.CreatedAt(),
+			UpdatedAt: l.UpdatedAt(),
 		})
 	}
 
@@ -165,6 +228,10 @@ func dtoFromOutput(out *commonuc.BoardData) (*getBoardDTO, error) {
 }
 
 func (d getBoardDTO) toOutput() (*commonuc.BoardData, error) {
+	// запись, положенная до появления версий, — промах: без версии не будет ETag
+	if d.Board.Version == 0 {
+		return nil, errors.New("cache entry without version")
+	}
 	bid, err := board.IdFromString(d.Board.Id)
 	if err != nil {
 		return nil, err
@@ -182,7 +249,7 @@ func (d getBoardDTO) toOutput() (*commonuc.BoardData, error) {
 		return nil, err
 	}
 
-	b := board.Rehydrate(bid, oid, bt, bd, d.Board.CreatedAt, d.Board.UpdatedAt)
+	b := board.Rehydrate(bid, oid, bt, bd, d.Board.CreatedAt, d.Board.UpdatedAt, timeOrZero(d.Board.ArchivedAt), d.Board.Version)
 
 	cols := make([]*column.Column, 0, len(d.Columns))
 	for _, c := range d.Columns {
@@ -198,10 +265,16 @@ func (d getBoardDTO) toOutput() (*commonuc.BoardData, error) {
 		if err != nil {
 			return nil, err
 		}
-		cols = append(cols, column.Rehydrate(cid, cb, pos, c.CreatedAt, c.UpdatedAt))
+		ct, err := column.NewTitle(c.Title)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, column.Rehydrate(cid, cb, pos, ct, c.CreatedAt, c.UpdatedAt, c.Version))
 	}
 
 	tasksOut := make([]*task.Task, 0, len(d.Tasks))
+	progress := make(map[task.Id]checklist.Progress)
+	files := make(map[task.Id]int)
 	for _, t := range d.Tasks {
 		tid, err := task.IdFromString(t.Id)
 		if err != nil {
@@ -215,6 +288,10 @@ func (d getBoardDTO) toOutput() (*commonuc.BoardData, error) {
 		if err != nil {
 			return nil, err
 		}
+		sk, err := task.NewSortKey(t.SortKey)
+		if err != nil {
+			return nil, err
+		}
 		tt, err := task.NewTitle(t.Title)
 		if err != nil {
 			return nil, err
@@ -227,9 +304,71 @@ func (d getBoardDTO) toOutput() (*commonuc.BoardData, error) {
 		if err != nil {
 			return nil, fmt.Errorf("assignee_id: %w", err)
 		}
+		dates, err := task.NewDates(timeOrZero(t.StartAt), timeOrZero(t.DueAt))
+		if err != nil {
+			return nil, err
+		}
+		priority, err := task.NewPriority(t.Priority)
+		if err != nil {
+			return nil, err
+		}
+		estimate, err := task.EstimateFromPtr(t.Estimate)
+		if err != nil {
+			return nil, err
+		}
+		labelIds := make([]label.Id, 0, len(t.LabelIds))
+		for _, raw := range t.LabelIds {
+			lid, err := label.IdFromString(raw)
+			if err != nil {
+				return nil, err
+			}
+			labelIds = append(labelIds, lid)
+		}
 
-		tasksOut = append(tasksOut, task.Rehydrate(tid, tc, pos, tt, td, aid, t.CreatedAt, t.UpdatedAt))
+		if t.Checklist != nil {
+			progress[tid] = checklist.Progress{Done: t.Checklist.Done, Total: t.Checklist.Total}
+		}
+		if t.Attachments > 0 {
+			files[tid] = t.Attachments
+		}
+
+		tasksOut = append(tasksOut, task.Rehydrate(tid, tc, pos, sk, tt, td, aid, dates, priority, estimate, labelIds, t.CreatedAt, t.UpdatedAt, time.Time{}, t.Version)) // задач из архива в составе доски нет
 	}
 
-	return &commonuc.BoardData{Board: b, Columns: cols, Tasks: tasksOut}, nil
+	labelsOut := make([]*label.Label, 0, len(d.Labels))
+	for _, l := range d.Labels {
+		lid, err := label.IdFromString(l.Id)
+		if err != nil {
+			return nil, err
+		}
+		lb, err := board.IdFromString(l.BoardId)
+		if err != nil {
+			return nil, err
+		}
+		ln, err := label.NewName(l.Name)
+		if err != nil {
+			return nil, err
+		}
+		lc, err := label.NewColor(l.Color)
+		if err != nil {
+			return nil, err
+		}
+		labelsOut = append(labelsOut, label.Rehydrate(lid, lb, ln, lc, l.CreatedAt, l.UpdatedAt))
+	}
+
+	return &commonuc.BoardData{Board: b, Columns: cols, Tasks: tasksOut, Labels: labelsOut, Checklists: progress, Attachments: files}, nil
+}
+
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func zeroToNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
 }
@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type AttachmentsRepo struct {
+	txm *TxManager
+	log *zerolog.Logger
+}
+
+func NewAttachmentsRepo(txm *TxManager, log *zerolog.Logger) *AttachmentsRepo {
+	return &AttachmentsRepo{txm: txm, log: log}
+}
+
+func (r *AttachmentsRepo) Save(ctx context.Context, a *attachment.Attachment) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO attachments (id, task_id, uploader_id, file_name, content_type, size, storage_key, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (id) DO UPDATE
+			SET file_name = EXCLUDED.file_name
+		`,
+			a.Id().UUID(),
+			a.TaskId().UUID(),
+			a.UploaderId().UUID(),
+			a.FileName().String(),
+			a.ContentType().String(),
+			a.Size(),
+			a.StorageKey(),
+			a.CreatedAt(),
+		)
+		return err
+	})
+}
+
+func (r *AttachmentsRepo) Get(ctx context.Context, id attachment.Id) (*attachment.Attachment, error) {
+	items, err := r.query(ctx, `WHERE id = $1`, id.UUID())
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, attachment.ErrNotFound
+	}
+	return items[0], nil
+}
+
+func (r *AttachmentsRepo) ListByTask(ctx context.Context, taskId task.Id) ([]*attachment.Attachment, error) {
+	return r.query(ctx, `WHERE task_id = $1 ORDER BY created_at, id`, taskId.UUID())
+}
+
+func (r *AttachmentsRepo) CountByTasks(ctx context.Context, taskIds []task.Id) (map[task.Id]int, error) {
+	out := make(map[task.Id]int)
+	if len(taskIds) == 0 {
+		return out, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(taskIds))
+	for _, id := range taskIds {
+		ids = append(ids, id.UUID())
+	}
+
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT task_id, COUNT(*)
+		FROM attachments
+		WHERE task_id = ANY($1)
+		GROUP BY task_id
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskIdRaw uuid.UUID
+		var n int
+		if err := rows.Scan(&taskIdRaw, &n); err != nil {
+			return nil, err
+		}
+		tid, err := task.IdFromUUID(taskIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		out[tid] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *AttachmentsRepo) Delete(ctx context.Context, id attachment.Id) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		ct, err := tx.Exec(ctx, `DELETE FROM attachments WHERE id = $1`, id.UUID())
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return attachment.ErrNotFound
+		}
+		return nil
+	})
+}
+
+func (r *AttachmentsRepo) query(ctx context.Context, where string, args ...any) ([]*attachment.Attachment, error) {
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT id, task_id, uploader_id, file_name, content_type, size, storage_key, created_at
+		FROM attachments
+	`+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]*attachment.Attachment, 0)
+	for rows.Next() {
+		var (
+			idRaw, taskIdRaw, uploaderIdRaw uuid.UUID
+			fileNameRaw, contentTypeRaw     string
+			size                            int64
+			storageKey                      string
+			createdAt                       time.Time
+		)
+		if err := rows.Scan(&idRaw, &taskIdRaw, &uploaderIdRaw, &fileNameRaw, &contentTypeRaw, &size, &storageKey, &createdAt); err != nil {
+			return nil, err
+		}
+
+		id, err := attachment.IdFromUUID(idRaw)
+		if err != nil {
+			return nil, err
+		}
+		taskId, err := task.IdFromUUID(taskIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		uploaderId, err := shared.UserIdFromUUID(uploaderIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		fileName, err := attachment.NewFileName(fileNameRaw)
+		if err != nil {
+			return nil, err
+		}
+		contentType, err := attachment.NewContentType(contentTypeRaw)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, attachment.Rehydrate(id, taskId, uploaderId, fileName, contentType, size, storageKey, createdAt))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -3,11 +3,14 @@ package persistence
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	shboard "github.com/smarrog/task-board/shared/domain/board"
 	"github.com/smarrog/task-board/shared/domain/shared"
 
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
@@ -35,14 +38,20 @@ func (r *BoardsRepo) InTx(ctx context.Context, fn func(ctx context.Context) erro
 
 func (r *BoardsRepo) Save(ctx context.Context, b *board.Board) error {
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `
-			INSERT INTO boards (id, owner_id, title, description, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5, $6)
+		// обновление проходит, только если с момента чтения доску никто не записал
+		var version int64
+		err := tx.QueryRow(ctx, `
+			INSERT INTO boards (id, owner_id, title, description, created_at, updated_at, archived_at, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8::bigint + 1)
 			ON CONFLICT (id) DO UPDATE
 			SET owner_id    = EXCLUDED.owner_id,
 				title       = EXCLUDED.title,
 				description = EXCLUDED.description,
-				updated_at  = EXCLUDED.updated_at
+				updated_at  = EXCLUDED.updated_at,
+				archived_at = EXCLUDED.archived_at,
+				version     = boards.version + 1
+			WHERE boards.version = $8
+			RETURNING version
 		`,
 			b.Id().UUID(),
 			b.OwnerId().UUID(),
@@ -50,10 +59,16 @@ func (r *BoardsRepo) Save(ctx context.Context, b *board.Board) error {
 			b.Description().String(),
 			b.CreatedAt(),
 			b.UpdatedAt(),
-		)
+			zeroToNil(b.ArchivedAt()),
+			b.Version(),
+		).Scan(&version)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return board.ErrConcurrentUpdate
+		}
 		if err != nil {
 			return err
 		}
+		b.SetVersion(version)
 
 		events := b.PullEvents()
 		return r.outbox.SaveEvents(ctx, events)
@@ -61,49 +76,96 @@ func (r *BoardsRepo) Save(ctx context.Context, b *board.Board) error {
 }
 
 func (r *BoardsRepo) Get(ctx context.Context, id board.Id) (*board.Board, error) {
+	b, _, err := r.get(ctx, id, false)
+	return b, err
+}
+
+func (r *BoardsRepo) GetTrashed(ctx context.Context, id board.Id) (*board.Trashed, error) {
+	b, deletedAt, err := r.get(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	return &board.Trashed{Board: b, DeletedAt: *deletedAt}, nil
+}
+
+// get ищет живую доску или, при trashed, доску в корзине.
+func (r *BoardsRepo) get(ctx context.Context, id board.Id, trashed bool) (*board.Board, *time.Time, error) {
 	db := r.txm.DB(ctx)
 
 	var ownerIdRaw uuid.UUID
 	var titleRaw, descRaw string
 	var createdAt, updatedAt time.Time
+	var archivedAt, deletedAt *time.Time
+	var version int64
 
 	err := db.QueryRow(ctx, `
-        SELECT owner_id, title, description, created_at, updated_at
+        SELECT owner_id, title, description, created_at, updated_at, archived_at, deleted_at, version
         FROM boards
-        WHERE id = $1
-    `, id.UUID()).Scan(&ownerIdRaw, &titleRaw, &descRaw, &createdAt, &updatedAt)
+        WHERE id = $1 AND (deleted_at IS NOT NULL) = $2
+    `, id.UUID(), trashed).Scan(&ownerIdRaw, &titleRaw, &descRaw, &createdAt, &updatedAt, &archivedAt, &deletedAt, &version)
 	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, board.ErrNotFound
+		return nil, nil, board.ErrNotFound
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	ownerId, err := shared.UserIdFromUUID(ownerIdRaw)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	title, err := board.NewTitle(titleRaw)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	desc, err := board.NewDescription(descRaw)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return board.Rehydrate(id, ownerId, title, desc, createdAt, updatedAt), nil
+	return board.Rehydrate(id, ownerId, title, desc, createdAt, updatedAt, timeOrZero(archivedAt), version), deletedAt, nil
 }
 
-func (r *BoardsRepo) ListByOwner(ctx context.Context, ownerId shared.UserId) ([]*board.Board, error) {
+func (r *BoardsRepo) ListByOwner(ctx context.Context, ownerId shared.UserId, q board.ListQuery) ([]*board.Board, error) {
 	db := r.txm.DB(ctx)
 
-	rows, err := db.Query(ctx, `
-        SELECT id, title, description, created_at, updated_at
+	var sb strings.Builder
+	args := []any{ownerId.UUID()}
+	sb.WriteString(`
+        SELECT id, title, description, created_at, updated_at, archived_at, version
         FROM boards
-        WHERE owner_id = $1
-        ORDER BY created_at DESC, id
-    `, ownerId.UUID())
+        WHERE owner_id = $1 AND deleted_at IS NULL`)
+
+	if !q.IncludeArchived {
+		sb.WriteString(" AND archived_at IS NULL")
+	}
+
+	if q.Search != "" {
+		args = append(args, "%"+escapeLike(q.Search)+"%")
+		fmt.Fprintf(&sb, " AND title ILIKE $%d", len(args))
+	}
+
+	switch q.Sort {
+	case board.SortTitle:
+		if q.After != nil {
+			args = append(args, q.After.Title, q.After.Id.UUID())
+			fmt.Fprintf(&sb, " AND (lower(title), id) > (lower($%d), $%d)", len(args)-1, len(args))
+		}
+		sb.WriteString(" ORDER BY lower(title), id")
+	default:
+		if q.After != nil {
+			args = append(args, q.After.UpdatedAt, q.After.Id.UUID())
+			fmt.Fprintf(&sb, " AND (updated_at, id) < ($%d, $%d)", len(args)-1, len(args))
+		}
+		sb.WriteString(" ORDER BY updated_at DESC, id DESC")
+	}
+
+	if q.Limit > 0 {
+		args = append(args, q.Limit)
+		fmt.Fprintf(&sb, " LIMIT $%d", len(args))
+	}
+
+	rows, err := db.Query(ctx, sb.String(), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -112,13 +174,15 @@ func (r *BoardsRepo) ListByOwner(ctx context.Context, ownerId shared.UserId) ([]
 	out := make([]*board.Board, 0)
 	for rows.Next() {
 		var (
-			idRaw     uuid.UUID
-			titleRaw  string
-			descRaw   string
-			createdAt time.Time
-			updatedAt time.Time
+			idRaw      uuid.UUID
+			titleRaw   string
+			descRaw    string
+			createdAt  time.Time
+			updatedAt  time.Time
+			archivedAt *time.Time
+			version    int64
 		)
-		if err := rows.Scan(&idRaw, &titleRaw, &descRaw, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&idRaw, &titleRaw, &descRaw, &createdAt, &updatedAt, &archivedAt, &version); err != nil {
 			return nil, err
 		}
 		id, err := board.IdFromUUID(idRaw)
@@ -133,7 +197,7 @@ func (r *BoardsRepo) ListByOwner(ctx context.Context, ownerId shared.UserId) ([]
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, board.Rehydrate(id, ownerId, t, d, createdAt, updatedAt))
+		out = append(out, board.Rehydrate(id, ownerId, t, d, createdAt, updatedAt, timeOrZero(archivedAt), version))
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -141,9 +205,12 @@ func (r *BoardsRepo) ListByOwner(ctx context.Context, ownerId shared.UserId) ([]
 	return out, nil
 }
 
+// Delete переносит доску в корзину вместе с живыми колонками и задачами.
 func (r *BoardsRepo) Delete(ctx context.Context, id board.Id) error {
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		ct, err := tx.Exec(ctx, `DELETE FROM boards WHERE id = $1`, id.UUID())
+		at := time.Now().UTC()
+
+		ct, err := tx.Exec(ctx, `UPDATE boards SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL`, id.UUID(), at)
 		if err != nil {
 			return err
 		}
@@ -151,11 +218,66 @@ func (r *BoardsRepo) Delete(ctx context.Context, id board.Id) error {
 			return board.ErrNotFound
 		}
 
-		events := []shared.DomainEvent{board.DeletedEvent{Id: id.String(), At: time.Now().UTC()}}
-		if err := r.outbox.SaveEvents(ctx, events); err != nil {
+		if _, err := tx.Exec(ctx, `
+			UPDATE columns SET deleted_at = $2 WHERE board_id = $1 AND deleted_at IS NULL
+		`, id.UUID(), at); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE tasks t SET deleted_at = $2
+			FROM columns c
+			WHERE c.id = t.column_id AND c.board_id = $1 AND t.deleted_at IS NULL
+		`, id.UUID(), at); err != nil {
 			return err
 		}
 
-		return nil
+		events := []shared.DomainEvent{shboard.DeletedEvent{Id: id.String(), At: at}}
+		return r.outbox.SaveEvents(ctx, events)
 	})
 }
+
+// Restore достаёт доску из корзины вместе с колонками и задачами, удалёнными с ней.
+func (r *BoardsRepo) Restore(ctx context.Context, b *board.Board) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var deletedAt time.Time
+		err := tx.QueryRow(ctx, `
+			SELECT deleted_at FROM boards WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE
+		`, b.Id().UUID()).Scan(&deletedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return board.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `
+			UPDATE tasks t SET deleted_at = NULL
+			FROM columns c
+			WHERE c.id = t.column_id AND c.board_id = $1 AND t.deleted_at = $2
+		`, b.Id().UUID(), deletedAt); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE columns SET deleted_at = NULL WHERE board_id = $1 AND deleted_at = $2
+		`, b.Id().UUID(), deletedAt); err != nil {
+			return err
+		}
+		var version int64
+		if err := tx.QueryRow(ctx, `
+			UPDATE boards SET deleted_at = NULL, updated_at = $2, version = version + 1 WHERE id = $1
+			RETURNING version
+		`, b.Id().UUID(), b.UpdatedAt()).Scan(&version); err != nil {
+			return err
+		}
+		b.SetVersion(version)
+
+		events := b.PullEvents()
+		return r.outbox.SaveEvents(ctx, events)
+	})
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
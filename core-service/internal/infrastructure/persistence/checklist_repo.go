@@ -0,0 +1,252 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+)
+
+type ChecklistRepo struct {
+	txm    *TxManager
+	log    *zerolog.Logger
+	outbox *OutboxRepo
+}
+
+func NewChecklistRepo(txm *TxManager, log *zerolog.Logger, outbox *OutboxRepo) *ChecklistRepo {
+	return &ChecklistRepo{
+		txm:    txm,
+		log:    log,
+		outbox: outbox,
+	}
+}
+
+func (r *ChecklistRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, _ pgx.Tx) error {
+		return fn(ctx)
+	})
+}
+
+func (r *ChecklistRepo) Save(ctx context.Context, i *checklist.Item) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO checklist_items (id, task_id, position, text, done, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (id) DO UPDATE
+			SET position   = EXCLUDED.position,
+				text       = EXCLUDED.text,
+				done       = EXCLUDED.done,
+				updated_at = EXCLUDED.updated_at
+		`,
+			i.Id().UUID(),
+			i.TaskId().UUID(),
+			i.Position(),
+			i.Text().String(),
+			i.Done(),
+			i.CreatedAt(),
+			i.UpdatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		events := i.PullEvents()
+		return r.outbox.SaveEvents(ctx, events)
+	})
+}
+
+func (r *ChecklistRepo) Get(ctx context.Context, id checklist.Id) (*checklist.Item, error) {
+	db := r.txm.DB(ctx)
+
+	var taskIdRaw uuid.UUID
+	var positionRaw int
+	var textRaw string
+	var done bool
+	var createdAt, updatedAt time.Time
+
+	err := db.QueryRow(ctx, `
+		SELECT task_id, position, text, done, created_at, updated_at
+		FROM checklist_items
+		WHERE id = $1
+	`, id.UUID()).Scan(&taskIdRaw, &positionRaw, &textRaw, &done, &createdAt, &updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, checklist.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rehydrateChecklistItem(id.UUID(), taskIdRaw, positionRaw, textRaw, done, createdAt, updatedAt)
+}
+
+func (r *ChecklistRepo) ListByTask(ctx context.Context, taskId task.Id) ([]*checklist.Item, error) {
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT id, position, text, done, created_at, updated_at
+		FROM checklist_items
+		WHERE task_id = $1
+		ORDER BY position ASC
+	`, taskId.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]*checklist.Item, 0)
+	for rows.Next() {
+		var (
+			idRaw       uuid.UUID
+			positionRaw int
+			textRaw     string
+			done        bool
+			createdAt   time.Time
+			updatedAt   time.Time
+		)
+		if err := rows.Scan(&idRaw, &positionRaw, &textRaw, &done, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		i, err := rehydrateChecklistItem(idRaw, taskId.UUID(), positionRaw, textRaw, done, createdAt, updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *ChecklistRepo) ProgressByTasks(ctx context.Context, taskIds []task.Id) (map[task.Id]checklist.Progress, error) {
+	out := make(map[task.Id]checklist.Progress)
+	if len(taskIds) == 0 {
+		return out, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(taskIds))
+	for _, id := range taskIds {
+		ids = append(ids, id.UUID())
+	}
+
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT task_id, COUNT(*) FILTER (WHERE done), COUNT(*)
+		FROM checklist_items
+		WHERE task_id = ANY($1)
+		GROUP BY task_id
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskIdRaw uuid.UUID
+		var p checklist.Progress
+		if err := rows.Scan(&taskIdRaw, &p.Done, &p.Total); err != nil {
+			return nil, err
+		}
+		tid, err := task.IdFromUUID(taskIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		out[tid] = p
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *ChecklistRepo) Delete(ctx context.Context, id checklist.Id) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		ct, err := tx.Exec(ctx, `DELETE FROM checklist_items WHERE id = $1`, id.UUID())
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return checklist.ErrNotFound
+		}
+		return nil
+	})
+}
+
+func (r *ChecklistRepo) LockTaskItems(ctx context.Context, taskId task.Id) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `SELECT id FROM checklist_items WHERE task_id=$1 FOR UPDATE`, taskId.UUID())
+		return err
+	})
+}
+
+func (r *ChecklistRepo) CountInTask(ctx context.Context, taskId task.Id) (int, error) {
+	var n int
+	err := r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(ctx, `SELECT COUNT(*) FROM checklist_items WHERE task_id=$1`, taskId.UUID()).Scan(&n)
+	})
+	return n, err
+}
+
+func (r *ChecklistRepo) ShiftPositions(ctx context.Context, taskId task.Id, fromIncl, toIncl int, delta int) error {
+	if fromIncl > toIncl || delta == 0 {
+		return nil
+	}
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			UPDATE checklist_items
+			SET position = position + $4
+			WHERE task_id=$1 AND position BETWEEN $2 AND $3
+		`, taskId.UUID(), fromIncl, toIncl, delta)
+		return err
+	})
+}
+
+func (r *ChecklistRepo) ShiftAfterRemove(ctx context.Context, taskId task.Id, fromPos int) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			UPDATE checklist_items
+			SET position = position - 1
+			WHERE task_id=$1 AND position > $2
+		`, taskId.UUID(), fromPos)
+		return err
+	})
+}
+
+func (r *ChecklistRepo) ShiftForInsert(ctx context.Context, taskId task.Id, toPos int) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			UPDATE checklist_items
+			SET position = position + 1
+			WHERE task_id=$1 AND position >= $2
+		`, taskId.UUID(), toPos)
+		return err
+	})
+}
+
+func rehydrateChecklistItem(idRaw, taskIdRaw uuid.UUID, positionRaw int, textRaw string, done bool, createdAt, updatedAt time.Time) (*checklist.Item, error) {
+	id, err := checklist.IdFromUUID(idRaw)
+	if err != nil {
+		return nil, err
+	}
+	taskId, err := task.IdFromUUID(taskIdRaw)
+	if err != nil {
+		return nil, err
+	}
+	pos, err := checklist.NewPosition(positionRaw)
+	if err != nil {
+		return nil, err
+	}
+	text, err := checklist.NewText(textRaw)
+	if err != nil {
+		return nil, err
+	}
+	return checklist.Rehydrate(id, taskId, pos, text, done, createdAt, updatedAt), nil
+}
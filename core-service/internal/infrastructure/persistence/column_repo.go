@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	shcolumn "github.com/smarrog/task-board/shared/domain/column"
 	"github.com/smarrog/task-board/shared/domain/shared"
 
 	"github.com/jackc/pgx/v5"
@@ -36,23 +37,35 @@ func (r *ColumnsRepo) InTx(ctx context.Context, fn func(ctx context.Context) err
 
 func (r *ColumnsRepo) Save(ctx context.Context, c *column.Column) error {
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `
-			INSERT INTO columns (id, board_id, position, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5)
+		// обновление проходит, только если с момента чтения колонку никто не записал
+		var version int64
+		err := tx.QueryRow(ctx, `
+			INSERT INTO columns (id, board_id, position, title, created_at, updated_at, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7::bigint + 1)
 			ON CONFLICT (id) DO UPDATE
 			SET board_id    = EXCLUDED.board_id,
 				position    = EXCLUDED.position,
-				updated_at  = EXCLUDED.updated_at
+				title       = EXCLUDED.title,
+				updated_at  = EXCLUDED.updated_at,
+				version     = columns.version + 1
+			WHERE columns.version = $7
+			RETURNING version
 		`,
 			c.Id().UUID(),
 			c.BoardId().UUID(),
 			c.Position(),
+			c.Title().String(),
 			c.CreatedAt(),
 			c.UpdatedAt(),
-		)
+			c.Version(),
+		).Scan(&version)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return column.ErrConcurrentUpdate
+		}
 		if err != nil {
 			return err
 		}
+		c.SetVersion(version)
 
 		events := c.PullEvents()
 		return r.outbox.SaveEvents(ctx, events)
@@ -60,43 +73,64 @@ func (r *ColumnsRepo) Save(ctx context.Context, c *column.Column) error {
 }
 
 func (r *ColumnsRepo) Get(ctx context.Context, id column.Id) (*column.Column, error) {
+	c, _, err := r.get(ctx, id, false)
+	return c, err
+}
+
+func (r *ColumnsRepo) GetTrashed(ctx context.Context, id column.Id) (*column.Trashed, error) {
+	c, deletedAt, err := r.get(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	return &column.Trashed{Column: c, DeletedAt: *deletedAt}, nil
+}
+
+// get ищет живую колонку или, при trashed, колонку в корзине.
+func (r *ColumnsRepo) get(ctx context.Context, id column.Id, trashed bool) (*column.Column, *time.Time, error) {
 	db := r.txm.DB(ctx)
 
 	var boardIdRaw string
 	var positionRaw int
+	var titleRaw string
 	var createdAt, updatedAt time.Time
+	var deletedAt *time.Time
+	var version int64
 
 	err := db.QueryRow(ctx, `
-		SELECT board_id, position, created_at, updated_at
+		SELECT board_id, position, title, created_at, updated_at, deleted_at, version
 		FROM columns
-		WHERE id = $1
-	`, id.UUID()).Scan(&boardIdRaw, &positionRaw, &createdAt, &updatedAt)
+		WHERE id = $1 AND (deleted_at IS NOT NULL) = $2
+	`, id.UUID(), trashed).Scan(&boardIdRaw, &positionRaw, &titleRaw, &createdAt, &updatedAt, &deletedAt, &version)
 	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, column.ErrNotFound
+		return nil, nil, column.ErrNotFound
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	boardId, err := board.IdFromString(boardIdRaw)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	position, err := column.NewPosition(positionRaw)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	title, err := column.NewTitle(titleRaw)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return column.Rehydrate(id, boardId, position, createdAt, updatedAt), nil
+	return column.Rehydrate(id, boardId, position, title, createdAt, updatedAt, version), deletedAt, nil
 }
 
 func (r *ColumnsRepo) ListByBoard(ctx context.Context, boardId board.Id) ([]*column.Column, error) {
 	db := r.txm.DB(ctx)
 
 	rows, err := db.Query(ctx, `
-		SELECT id, position, created_at, updated_at
+		SELECT id, position, title, created_at, updated_at, version
 		FROM columns
-		WHERE board_id = $1
+		WHERE board_id = $1 AND deleted_at IS NULL
 		ORDER BY position ASC
 	`, boardId.UUID())
 	if err != nil {
@@ -108,8 +142,10 @@ func (r *ColumnsRepo) ListByBoard(ctx context.Context, boardId board.Id) ([]*col
 	for rows.Next() {
 		var idRaw string
 		var positionRaw int
+		var titleRaw string
 		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&idRaw, &positionRaw, &createdAt, &updatedAt); err != nil {
+		var version int64
+		if err := rows.Scan(&idRaw, &positionRaw, &titleRaw, &createdAt, &updatedAt, &version); err != nil {
 			return nil, err
 		}
 		id, err := column.IdFromString(idRaw)
@@ -120,7 +156,11 @@ func (r *ColumnsRepo) ListByBoard(ctx context.Context, boardId board.Id) ([]*col
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, column.Rehydrate(id, boardId, pos, createdAt, updatedAt))
+		title, err := column.NewTitle(titleRaw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, column.Rehydrate(id, boardId, pos, title, createdAt, updatedAt, version))
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -141,9 +181,9 @@ func (r *ColumnsRepo) ListByBoards(ctx context.Context, boardIds []board.Id) ([]
 	}
 
 	rows, err := db.Query(ctx, `
-		SELECT id, board_id, position, created_at, updated_at
+		SELECT id, board_id, position, title, created_at, updated_at, version
 		FROM columns
-		WHERE board_id = ANY($1)
+		WHERE board_id = ANY($1) AND deleted_at IS NULL
 		ORDER BY board_id ASC, position ASC
 	`, ids)
 	if err != nil {
@@ -157,10 +197,12 @@ func (r *ColumnsRepo) ListByBoards(ctx context.Context, boardIds []board.Id) ([]
 			idRaw       string
 			boardIdRaw  string
 			positionRaw int
+			titleRaw    string
 			createdAt   time.Time
 			updatedAt   time.Time
+			version     int64
 		)
-		if err := rows.Scan(&idRaw, &boardIdRaw, &positionRaw, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&idRaw, &boardIdRaw, &positionRaw, &titleRaw, &createdAt, &updatedAt, &version); err != nil {
 			return nil, err
 		}
 		id, err := column.IdFromString(idRaw)
@@ -175,7 +217,11 @@ func (r *ColumnsRepo) ListByBoards(ctx context.Context, boardIds []board.Id) ([]
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, column.Rehydrate(id, bid, pos, createdAt, updatedAt))
+		title, err := column.NewTitle(titleRaw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, column.Rehydrate(id, bid, pos, title, createdAt, updatedAt, version))
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -184,9 +230,12 @@ func (r *ColumnsRepo) ListByBoards(ctx context.Context, boardIds []board.Id) ([]
 	return out, nil
 }
 
+// Delete переносит колонку в корзину вместе с её живыми задачами.
 func (r *ColumnsRepo) Delete(ctx context.Context, id column.Id) error {
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		ct, err := tx.Exec(ctx, `DELETE FROM columns WHERE id = $1`, id.UUID())
+		at := time.Now().UTC()
+
+		ct, err := tx.Exec(ctx, `UPDATE columns SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL`, id.UUID(), at)
 		if err != nil {
 			return err
 		}
@@ -194,18 +243,106 @@ func (r *ColumnsRepo) Delete(ctx context.Context, id column.Id) error {
 			return column.ErrNotFound
 		}
 
-		events := []shared.DomainEvent{column.DeletedEvent{Id: id.String(), At: time.Now().UTC()}}
-		if err := r.outbox.SaveEvents(ctx, events); err != nil {
+		if _, err := tx.Exec(ctx, `
+			UPDATE tasks SET deleted_at = $2 WHERE column_id = $1 AND deleted_at IS NULL
+		`, id.UUID(), at); err != nil {
 			return err
 		}
 
-		return nil
+		events := []shared.DomainEvent{shcolumn.DeletedEvent{Id: id.String(), At: at}}
+		return r.outbox.SaveEvents(ctx, events)
 	})
 }
 
+// Restore достаёт колонку из корзины на c.Position() вместе с задачами, удалёнными с ней.
+// Место под позицию освобождает вызывающий.
+func (r *ColumnsRepo) Restore(ctx context.Context, c *column.Column) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var deletedAt time.Time
+		err := tx.QueryRow(ctx, `
+			SELECT deleted_at FROM columns WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE
+		`, c.Id().UUID()).Scan(&deletedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return column.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `
+			UPDATE tasks SET deleted_at = NULL WHERE column_id = $1 AND deleted_at = $2
+		`, c.Id().UUID(), deletedAt); err != nil {
+			return err
+		}
+		var version int64
+		if err := tx.QueryRow(ctx, `
+			UPDATE columns SET deleted_at = NULL, position = $2, updated_at = $3, version = version + 1 WHERE id = $1
+			RETURNING version
+		`, c.Id().UUID(), c.Position(), c.UpdatedAt()).Scan(&version); err != nil {
+			return err
+		}
+		c.SetVersion(version)
+
+		events := c.PullEvents()
+		return r.outbox.SaveEvents(ctx, events)
+	})
+}
+
+// ListTrashed отдаёт колонки, удалённые сами по себе, а не вместе с доской.
+func (r *ColumnsRepo) ListTrashed(ctx context.Context, boardId board.Id) ([]column.Trashed, error) {
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT c.id, c.position, c.title, c.created_at, c.updated_at, c.deleted_at, c.version
+		FROM columns c
+		JOIN boards b ON b.id = c.board_id
+		WHERE c.board_id = $1 AND c.deleted_at IS NOT NULL
+		  AND c.deleted_at IS DISTINCT FROM b.deleted_at
+		ORDER BY c.deleted_at DESC, c.id
+	`, boardId.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]column.Trashed, 0)
+	for rows.Next() {
+		var (
+			idRaw       uuid.UUID
+			positionRaw int
+			titleRaw    string
+			createdAt   time.Time
+			updatedAt   time.Time
+			deletedAt   time.Time
+			version     int64
+		)
+		if err := rows.Scan(&idRaw, &positionRaw, &titleRaw, &createdAt, &updatedAt, &deletedAt, &version); err != nil {
+			return nil, err
+		}
+		id, err := column.IdFromUUID(idRaw)
+		if err != nil {
+			return nil, err
+		}
+		pos, err := column.NewPosition(positionRaw)
+		if err != nil {
+			return nil, err
+		}
+		title, err := column.NewTitle(titleRaw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, column.Trashed{Column: column.Rehydrate(id, boardId, pos, title, createdAt, updatedAt, version), DeletedAt: deletedAt})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
 func (r *ColumnsRepo) LockBoardColumns(ctx context.Context, boardId board.Id) error {
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `SELECT id FROM columns WHERE board_id=$1 FOR UPDATE`, boardId.UUID())
+		_, err := tx.Exec(ctx, `SELECT id FROM columns WHERE board_id=$1 AND deleted_at IS NULL FOR UPDATE`, boardId.UUID())
 		return err
 	})
 }
@@ -213,7 +350,7 @@ func (r *ColumnsRepo) LockBoardColumns(ctx context.Context, boardId board.Id) er
 func (r *ColumnsRepo) CountInBoard(ctx context.Context, boardId board.Id) (int, error) {
 	var n int
 	err := r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		return tx.QueryRow(ctx, `SELECT COUNT(*) FROM columns WHERE board_id=$1`, boardId.UUID()).Scan(&n)
+		return tx.QueryRow(ctx, `SELECT COUNT(*) FROM columns WHERE board_id=$1 AND deleted_at IS NULL`, boardId.UUID()).Scan(&n)
 	})
 	return n, err
 }
@@ -225,9 +362,56 @@ func (r *ColumnsRepo) ShiftPositions(ctx context.Context, boardId board.Id, from
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		_, err := tx.Exec(ctx, `
 			UPDATE columns
-			SET position = position + $4
-			WHERE board_id=$1 AND position BETWEEN $2 AND $3
+			SET position = position + $4, version = version + 1
+			WHERE board_id=$1 AND deleted_at IS NULL AND position BETWEEN $2 AND $3
 		`, boardId.UUID(), fromIncl, toIncl, delta)
 		return err
 	})
 }
+
+// SaveOrder переписывает позиции всех колонок доски одним запросом; уникальность
+// (board_id, position) отложенная, поэтому промежуточных конфликтов нет.
+func (r *ColumnsRepo) SaveOrder(ctx context.Context, o *column.Order) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		cols := o.Columns()
+		ids := make([]uuid.UUID, 0, len(cols))
+		positions := make([]int, 0, len(cols))
+		var at time.Time
+		for _, c := range cols {
+			ids = append(ids, c.Id().UUID())
+			positions = append(positions, c.Position().Int())
+			at = c.UpdatedAt()
+		}
+
+		rows, err := tx.Query(ctx, `
+			UPDATE columns c
+			SET position = o.position, updated_at = $4, version = c.version + 1
+			FROM unnest($2::uuid[], $3::int[]) AS o(id, position)
+			WHERE c.id = o.id AND c.board_id = $1 AND c.deleted_at IS NULL
+			RETURNING c.id, c.version
+		`, o.BoardId().UUID(), ids, positions, at)
+		if err != nil {
+			return err
+		}
+		versions := make(map[uuid.UUID]int64, len(cols))
+		for rows.Next() {
+			var id uuid.UUID
+			var version int64
+			if err := rows.Scan(&id, &version); err != nil {
+				rows.Close()
+				return err
+			}
+			versions[id] = version
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		for _, c := range cols {
+			c.SetVersion(versions[c.Id().UUID()])
+		}
+
+		events := o.PullEvents()
+		return r.outbox.SaveEvents(ctx, events)
+	})
+}
@@ -0,0 +1,187 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/core-service/internal/domain/comment"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	shcomment "github.com/smarrog/task-board/shared/domain/comment"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type CommentsRepo struct {
+	txm    *TxManager
+	log    *zerolog.Logger
+	outbox *OutboxRepo
+}
+
+func NewCommentsRepo(txm *TxManager, log *zerolog.Logger, outbox *OutboxRepo) *CommentsRepo {
+	return &CommentsRepo{
+		txm:    txm,
+		log:    log,
+		outbox: outbox,
+	}
+}
+
+func (r *CommentsRepo) Save(ctx context.Context, c *comment.Comment) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		mentions := make([]uuid.UUID, 0, len(c.Mentions()))
+		for _, id := range c.Mentions() {
+			mentions = append(mentions, id.UUID())
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO comments (id, task_id, author_id, body, mentions, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (id) DO UPDATE
+			SET body       = EXCLUDED.body,
+				mentions   = EXCLUDED.mentions,
+				updated_at = EXCLUDED.updated_at
+		`,
+			c.Id().UUID(),
+			c.TaskId().UUID(),
+			c.AuthorId().UUID(),
+			c.Body().String(),
+			mentions,
+			c.CreatedAt(),
+			c.UpdatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		// автор подписывается на задачу при создании комментария; правка подписку не возвращает
+		if c.IsNew() {
+			_, err = tx.Exec(ctx, `
+				INSERT INTO task_watchers (task_id, user_id) VALUES ($1, $2)
+				ON CONFLICT DO NOTHING
+			`, c.TaskId().UUID(), c.AuthorId().UUID())
+			if err != nil {
+				return err
+			}
+		}
+
+		events := c.PullEvents()
+		return r.outbox.SaveEvents(ctx, events)
+	})
+}
+
+func (r *CommentsRepo) Get(ctx context.Context, id comment.Id) (*comment.Comment, error) {
+	db := r.txm.DB(ctx)
+
+	var taskIdRaw, authorIdRaw uuid.UUID
+	var bodyRaw string
+	var mentionsRaw []uuid.UUID
+	var createdAt, updatedAt time.Time
+
+	err := db.QueryRow(ctx, `
+		SELECT task_id, author_id, body, mentions, created_at, updated_at
+		FROM comments
+		WHERE id = $1
+	`, id.UUID()).Scan(&taskIdRaw, &authorIdRaw, &bodyRaw, &mentionsRaw, &createdAt, &updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, comment.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rehydrateComment(id.UUID(), taskIdRaw, authorIdRaw, bodyRaw, mentionsRaw, createdAt, updatedAt)
+}
+
+func (r *CommentsRepo) ListByTask(ctx context.Context, q comment.ListQuery) ([]*comment.Comment, error) {
+	db := r.txm.DB(ctx)
+
+	args := []any{q.TaskId.UUID(), q.Limit}
+	where := `task_id = $1`
+	if q.After != nil {
+		args = append(args, q.After.CreatedAt, q.After.Id.UUID())
+		where += ` AND (created_at, id) > ($3, $4)`
+	}
+
+	rows, err := db.Query(ctx, `
+		SELECT id, author_id, body, mentions, created_at, updated_at
+		FROM comments
+		WHERE `+where+`
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]*comment.Comment, 0)
+	for rows.Next() {
+		var (
+			idRaw       uuid.UUID
+			authorIdRaw uuid.UUID
+			bodyRaw     string
+			mentionsRaw []uuid.UUID
+			createdAt   time.Time
+			updatedAt   time.Time
+		)
+		if err := rows.Scan(&idRaw, &authorIdRaw, &bodyRaw, &mentionsRaw, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		c, err := rehydrateComment(idRaw, q.TaskId.UUID(), authorIdRaw, bodyRaw, mentionsRaw, createdAt, updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *CommentsRepo) Delete(ctx context.Context, id comment.Id) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var taskIdRaw uuid.UUID
+		err := tx.QueryRow(ctx, `DELETE FROM comments WHERE id = $1 RETURNING task_id`, id.UUID()).Scan(&taskIdRaw)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return comment.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		events := []shared.DomainEvent{shcomment.DeletedEvent{Id: id.String(), TaskId: taskIdRaw.String(), At: time.Now().UTC()}}
+		return r.outbox.SaveEvents(ctx, events)
+	})
+}
+
+func rehydrateComment(idRaw, taskIdRaw, authorIdRaw uuid.UUID, bodyRaw string, mentionsRaw []uuid.UUID, createdAt, updatedAt time.Time) (*comment.Comment, error) {
+	id, err := comment.IdFromUUID(idRaw)
+	if err != nil {
+		return nil, err
+	}
+	taskId, err := task.IdFromUUID(taskIdRaw)
+	if err != nil {
+		return nil, err
+	}
+	authorId, err := shared.UserIdFromUUID(authorIdRaw)
+	if err != nil {
+		return nil, err
+	}
+	body, err := comment.NewBody(bodyRaw)
+	if err != nil {
+		return nil, err
+	}
+	mentions := make([]shared.UserId, 0, len(mentionsRaw))
+	for _, raw := range mentionsRaw {
+		uid, err := shared.UserIdFromUUID(raw)
+		if err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, uid)
+	}
+	return comment.Rehydrate(id, taskId, authorId, body, mentions, createdAt, updatedAt), nil
+}
@@ -0,0 +1,167 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/shared/domain/shared"
+	shtask "github.com/smarrog/task-board/shared/domain/task"
+)
+
+// DueReminderWorker периодически ищет задачи с подходящим или истёкшим сроком
+// и кладёт TaskDueSoon / TaskOverdue в outbox. Каждое напоминание отправляется
+// один раз на срок: при изменении due_at отметки сбрасываются в TasksRepo.Save.
+type DueReminderWorker struct {
+	txm          *TxManager
+	outbox       *OutboxRepo
+	dueSoon      time.Duration
+	batchSize    int
+	pollInterval time.Duration
+	log          *zerolog.Logger
+}
+
+func NewDueReminderWorker(
+	txm *TxManager,
+	outbox *OutboxRepo,
+	dueSoon time.Duration,
+	batchSize int,
+	pollInterval time.Duration,
+	log *zerolog.Logger,
+) *DueReminderWorker {
+	return &DueReminderWorker{
+		txm:          txm,
+		outbox:       outbox,
+		dueSoon:      dueSoon,
+		batchSize:    batchSize,
+		pollInterval: pollInterval,
+		log:          log,
+	}
+}
+
+func (w *DueReminderWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := w.processOnce(ctx); err != nil {
+			w.log.Err(err).Msg("due reminder worker iteration failed")
+		}
+	}
+}
+
+type dueTaskRow struct {
+	id         uuid.UUID
+	columnId   uuid.UUID
+	title      string
+	assigneeId uuid.UUID
+	dueAt      time.Time
+}
+
+func (w *DueReminderWorker) processOnce(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	return w.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		overdue, err := w.fetch(ctx, tx, `
+			SELECT id, column_id, title, assignee_id, due_at
+			FROM tasks
+			WHERE due_at IS NOT NULL AND due_at <= $1 AND overdue_notified_at IS NULL AND deleted_at IS NULL AND archived_at IS NULL
+			ORDER BY due_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		`, now, w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		dueSoon, err := w.fetch(ctx, tx, `
+			SELECT id, column_id, title, assignee_id, due_at
+			FROM tasks
+			WHERE due_at > $1 AND due_at <= $2 AND due_soon_notified_at IS NULL AND deleted_at IS NULL AND archived_at IS NULL
+			ORDER BY due_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		`, now, now.Add(w.dueSoon), w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(overdue) == 0 && len(dueSoon) == 0 {
+			return nil
+		}
+
+		events := make([]shared.DomainEvent, 0, len(overdue)+len(dueSoon))
+		overdueIds := make([]uuid.UUID, 0, len(overdue))
+		for _, t := range overdue {
+			events = append(events, shtask.OverdueEvent{
+				Id:         t.id.String(),
+				ColumnId:   t.columnId.String(),
+				Title:      t.title,
+				AssigneeId: t.assigneeId.String(),
+				DueAt:      t.dueAt,
+				At:         now,
+			})
+			overdueIds = append(overdueIds, t.id)
+		}
+		dueSoonIds := make([]uuid.UUID, 0, len(dueSoon))
+		for _, t := range dueSoon {
+			events = append(events, shtask.DueSoonEvent{
+				Id:         t.id.String(),
+				ColumnId:   t.columnId.String(),
+				Title:      t.title,
+				AssigneeId: t.assigneeId.String(),
+				DueAt:      t.dueAt,
+				At:         now,
+			})
+			dueSoonIds = append(dueSoonIds, t.id)
+		}
+
+		if err := w.outbox.SaveEvents(ctx, events); err != nil {
+			return err
+		}
+
+		// Просроченная задача больше не «скоро»: гасим обе отметки.
+		if _, err := tx.Exec(ctx, `
+			UPDATE tasks
+			SET overdue_notified_at = $2,
+			    due_soon_notified_at = COALESCE(due_soon_notified_at, $2)
+			WHERE id = ANY($1)
+		`, overdueIds, now); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE tasks SET due_soon_notified_at = $2 WHERE id = ANY($1)
+		`, dueSoonIds, now); err != nil {
+			return err
+		}
+
+		w.log.Debug().Int("overdue", len(overdue)).Int("due_soon", len(dueSoon)).Msg("due reminders queued")
+		return nil
+	})
+}
+
+func (w *DueReminderWorker) fetch(ctx context.Context, tx pgx.Tx, sql string, args ...any) ([]dueTaskRow, error) {
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]dueTaskRow, 0)
+	for rows.Next() {
+		var r dueTaskRow
+		if err := rows.Scan(&r.id, &r.columnId, &r.title, &r.assigneeId, &r.dueAt); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,205 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+)
+
+type LabelsRepo struct {
+	txm *TxManager
+	log *zerolog.Logger
+}
+
+func NewLabelsRepo(txm *TxManager, log *zerolog.Logger) *LabelsRepo {
+	return &LabelsRepo{
+		txm: txm,
+		log: log,
+	}
+}
+
+func (r *LabelsRepo) Save(ctx context.Context, l *label.Label) error {
+	db := r.txm.DB(ctx)
+
+	_, err := db.Exec(ctx, `
+		INSERT INTO labels (id, board_id, name, color, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE
+		SET name       = EXCLUDED.name,
+			color      = EXCLUDED.color,
+			updated_at = EXCLUDED.updated_at
+	`,
+		l.Id().UUID(),
+		l.BoardId().UUID(),
+		l.Name().String(),
+		l.Color().String(),
+		l.CreatedAt(),
+		l.UpdatedAt(),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "ux_labels_board_name" { // 23505 - UniqueViolation
+			return label.ErrNameTaken
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *LabelsRepo) Get(ctx context.Context, id label.Id) (*label.Label, error) {
+	db := r.txm.DB(ctx)
+
+	var boardIdRaw uuid.UUID
+	var nameRaw, colorRaw string
+	var createdAt, updatedAt time.Time
+
+	err := db.QueryRow(ctx, `
+		SELECT board_id, name, color, created_at, updated_at
+		FROM labels
+		WHERE id = $1
+	`, id.UUID()).Scan(&boardIdRaw, &nameRaw, &colorRaw, &createdAt, &updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, label.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rehydrateLabel(id.UUID(), boardIdRaw, nameRaw, colorRaw, createdAt, updatedAt)
+}
+
+func (r *LabelsRepo) ListByIds(ctx context.Context, ids []label.Id) ([]*label.Label, error) {
+	if len(ids) == 0 {
+		return []*label.Label{}, nil
+	}
+
+	raw := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		raw = append(raw, id.UUID())
+	}
+	return r.query(ctx, `WHERE id = ANY($1)`, raw)
+}
+
+func (r *LabelsRepo) ListByBoard(ctx context.Context, boardId board.Id) ([]*label.Label, error) {
+	return r.query(ctx, `WHERE board_id = $1`, boardId.UUID())
+}
+
+func (r *LabelsRepo) ListByBoards(ctx context.Context, boardIds []board.Id) ([]*label.Label, error) {
+	if len(boardIds) == 0 {
+		return []*label.Label{}, nil
+	}
+
+	raw := make([]uuid.UUID, 0, len(boardIds))
+	for _, id := range boardIds {
+		raw = append(raw, id.UUID())
+	}
+	return r.query(ctx, `WHERE board_id = ANY($1)`, raw)
+}
+
+func (r *LabelsRepo) Delete(ctx context.Context, id label.Id) error {
+	db := r.txm.DB(ctx)
+
+	ct, err := db.Exec(ctx, `DELETE FROM labels WHERE id = $1`, id.UUID())
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return label.ErrNotFound
+	}
+	return nil
+}
+
+func (r *LabelsRepo) RetargetColumn(ctx context.Context, columnId column.Id, toBoardId board.Id) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// имена меток на доске уникальны без учёта регистра, так что пара находится не больше одной
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO task_labels (task_id, label_id)
+			SELECT tl.task_id, nl.id
+			FROM task_labels tl
+			JOIN tasks t ON t.id = tl.task_id
+			JOIN labels ol ON ol.id = tl.label_id
+			JOIN labels nl ON nl.board_id = $2 AND lower(nl.name) = lower(ol.name)
+			WHERE t.column_id = $1 AND ol.board_id <> $2
+			ON CONFLICT DO NOTHING
+		`, columnId.UUID(), toBoardId.UUID()); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, `
+			DELETE FROM task_labels tl
+			USING tasks t, labels l
+			WHERE tl.task_id = t.id
+			  AND tl.label_id = l.id
+			  AND t.column_id = $1
+			  AND l.board_id <> $2
+		`, columnId.UUID(), toBoardId.UUID())
+		return err
+	})
+}
+
+func (r *LabelsRepo) query(ctx context.Context, where string, args ...any) ([]*label.Label, error) {
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT id, board_id, name, color, created_at, updated_at
+		FROM labels
+		`+where+`
+		ORDER BY board_id, lower(name), id
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]*label.Label, 0)
+	for rows.Next() {
+		var (
+			idRaw      uuid.UUID
+			boardIdRaw uuid.UUID
+			nameRaw    string
+			colorRaw   string
+			createdAt  time.Time
+			updatedAt  time.Time
+		)
+		if err := rows.Scan(&idRaw, &boardIdRaw, &nameRaw, &colorRaw, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		l, err := rehydrateLabel(idRaw, boardIdRaw, nameRaw, colorRaw, createdAt, updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func rehydrateLabel(idRaw, boardIdRaw uuid.UUID, nameRaw, colorRaw string, createdAt, updatedAt time.Time) (*label.Label, error) {
+	id, err := label.IdFromUUID(idRaw)
+	if err != nil {
+		return nil, err
+	}
+	boardId, err := board.IdFromUUID(boardIdRaw)
+	if err != nil {
+		return nil, err
+	}
+	name, err := label.NewName(nameRaw)
+	if err != nil {
+		return nil, err
+	}
+	color, err := label.NewColor(colorRaw)
+	if err != nil {
+		return nil, err
+	}
+	return label.Rehydrate(id, boardId, name, color, createdAt, updatedAt), nil
+}
@@ -0,0 +1,335 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+)
+
+// Бенчмарки сравнивают два способа упорядочить задачи в одной колонке:
+//   - shift: целые позиции, перемещение сдвигает диапазон соседей под блокировкой всей
+//     колонки (так TasksRepo работал до sort_key);
+//   - sort_key: ключи порядка, перемещение меняет одну строку, изредка колонка
+//     перебалансируется.
+//
+// BenchmarkOrderingModel повторяет обе стратегии в памяти и считает переписанные строки,
+// база ему не нужна. BenchmarkOrderingPostgres гоняет настоящие запросы в отдельной
+// схеме и запускается, только если задан BENCH_POSTGRES_DSN (база с миграциями):
+//
+//	BENCH_POSTGRES_DSN=postgres://... go test -run '^$' -bench Ordering ./internal/infrastructure/persistence/
+
+var orderingSizes = []int{1000, 10000}
+
+// orderingPatterns — куда ставится задача: в случайное место или всё время в одну щель,
+// худший случай для ключей — они удлиняются, пока не кончится место.
+var orderingPatterns = []struct {
+	name string
+	to   func(r *rand.Rand, n int) int
+}{
+	{"random", func(r *rand.Rand, n int) int { return r.IntN(n) }},
+	{"hotspot", func(*rand.Rand, int) int { return 1 }},
+}
+
+func BenchmarkOrderingModel(b *testing.B) {
+	for _, n := range orderingSizes {
+		for _, p := range orderingPatterns {
+			b.Run(fmt.Sprintf("shift/%s/tasks=%d", p.name, n), func(b *testing.B) {
+				r := rand.New(rand.NewPCG(1, 2))
+				order := make([]int, n)
+				for i := range order {
+					order[i] = i
+				}
+				var rows int64
+
+				b.ResetTimer()
+				for range b.N {
+					rows += modelMoveShift(order, r.IntN(n), p.to(r, n))
+				}
+				b.ReportMetric(float64(rows)/float64(b.N), "rows/op")
+			})
+
+			b.Run(fmt.Sprintf("sort_key/%s/tasks=%d", p.name, n), func(b *testing.B) {
+				r := rand.New(rand.NewPCG(1, 2))
+				keys := task.SpreadSortKeys(n)
+				var rows, rebalances int64
+
+				b.ResetTimer()
+				for range b.N {
+					touched, rebalanced := modelMoveSortKey(keys, r.IntN(n), p.to(r, n))
+					rows += touched
+					if rebalanced {
+						rebalances++
+					}
+				}
+				b.ReportMetric(float64(rows)/float64(b.N), "rows/op")
+				b.ReportMetric(float64(rebalances)/float64(b.N), "rebalances/op")
+			})
+		}
+	}
+}
+
+// modelMoveShift переносит задачу с места from на место to и возвращает число строк,
+// которым пришлось переписать позицию.
+func modelMoveShift(order []int, from, to int) int64 {
+	shift, ok := common.CalcShift(from, to)
+	if !ok {
+		return 1
+	}
+	moveInSlice(order, from, to)
+	return int64(shift.ToPosition-shift.FromPosition+1) + 1
+}
+
+// modelMoveSortKey делает то же, что TasksRepo.SortKeyAt: ключ между соседями места to,
+// а если он кончился — перебалансировка всей колонки и ещё одна попытка.
+func modelMoveSortKey(keys []task.SortKey, from, to int) (int64, bool) {
+	var rows int64 = 1
+	key, err := sortKeyAt(keys, from, to)
+	rebalanced := errors.Is(err, task.ErrSortKeyExhausted)
+	if rebalanced {
+		copy(keys, task.SpreadSortKeys(len(keys)))
+		rows += int64(len(keys))
+		key, err = sortKeyAt(keys, from, to)
+	}
+	if err != nil {
+		panic(err)
+	}
+	moveInSlice(keys, from, to)
+	keys[to] = key
+	return rows, rebalanced
+}
+
+// sortKeyAt — ключ для места index среди задач колонки без задачи skip.
+func sortKeyAt(keys []task.SortKey, skip, index int) (task.SortKey, error) {
+	at := func(i int) task.SortKey {
+		if i >= skip {
+			i++
+		}
+		if i < 0 || i >= len(keys) {
+			return ""
+		}
+		return keys[i]
+	}
+	return task.SortKeyBetween(at(index-1), at(index))
+}
+
+// moveInSlice переставляет элемент from на место to. ns/op модели — это перестановки
+// в памяти и вычисление ключей, а не записи в базу, поэтому стратегии в ней
+// сравниваются по rows/op.
+func moveInSlice[T any](s []T, from, to int) {
+	v := s[from]
+	if from < to {
+		copy(s[from:to], s[from+1:to+1])
+	} else {
+		copy(s[to+1:from+1], s[to:from])
+	}
+	s[to] = v
+}
+
+const benchOrderingSchema = "bench_ordering"
+
+func BenchmarkOrderingPostgres(b *testing.B) {
+	dsn := os.Getenv("BENCH_POSTGRES_DSN")
+	if dsn == "" {
+		b.Skip("BENCH_POSTGRES_DSN is not set")
+	}
+	ctx := context.Background()
+
+	// параллельные клиенты нужны, чтобы было видно, как стратегии ждут блокировки
+	const parallelism = 8
+	pool, err := newBenchPool(ctx, dsn, parallelism)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer pool.Close()
+
+	if err := setupBenchSchema(ctx, pool); err != nil {
+		b.Fatal(err)
+	}
+	defer func() {
+		if _, err := pool.Exec(ctx, `DROP SCHEMA `+benchOrderingSchema+` CASCADE`); err != nil {
+			b.Error(err)
+		}
+	}()
+
+	// отладочный лог перебалансировок не нужен
+	quiet := zerolog.Nop()
+	txm := NewTxManager(pool, &quiet)
+	repo := NewTasksRepo(txm, &quiet, nil)
+
+	strategies := []struct {
+		name string
+		move func(ctx context.Context, columnId column.Id, id task.Id, to int) (int64, error)
+	}{
+		{"shift", func(ctx context.Context, columnId column.Id, id task.Id, to int) (int64, error) {
+			return benchMoveShift(ctx, pool, columnId, id, to)
+		}},
+		{"sort_key", func(ctx context.Context, columnId column.Id, id task.Id, to int) (int64, error) {
+			return benchMoveSortKey(ctx, txm, repo, columnId, id, to)
+		}},
+	}
+
+	for _, n := range orderingSizes {
+		for _, p := range orderingPatterns {
+			for _, s := range strategies {
+				b.Run(fmt.Sprintf("%s/%s/tasks=%d", s.name, p.name, n), func(b *testing.B) {
+					columnId, err := column.IdFromUUID(uuid.New())
+					if err != nil {
+						b.Fatal(err)
+					}
+					ids, err := seedBenchColumn(ctx, pool, columnId, n)
+					if err != nil {
+						b.Fatal(err)
+					}
+
+					var rows, failed atomic.Int64
+					var seed atomic.Uint64
+					b.SetParallelism(parallelism)
+					b.ResetTimer()
+					b.RunParallel(func(pb *testing.PB) {
+						r := rand.New(rand.NewPCG(seed.Add(1), 2))
+						for pb.Next() {
+							touched, err := s.move(ctx, columnId, ids[r.IntN(n)], p.to(r, n))
+							if err != nil {
+								failed.Add(1)
+								continue
+							}
+							rows.Add(touched)
+						}
+					})
+					b.ReportMetric(float64(rows.Load())/float64(b.N), "rows/op")
+					b.ReportMetric(float64(failed.Load())/float64(b.N), "failed/op")
+				})
+			}
+		}
+	}
+}
+
+func newBenchPool(ctx context.Context, dsn string, parallelism int) (*pgxpool.Pool, error) {
+	pgCfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	pgCfg.MaxConns = int32(parallelism*runtime.GOMAXPROCS(0) + 1)
+	// TasksRepo пишет в tasks — подменяем таблицу копией из схемы бенчмарка
+	pgCfg.ConnConfig.RuntimeParams["search_path"] = benchOrderingSchema + ",public"
+	return pgxpool.NewWithConfig(ctx, pgCfg)
+}
+
+func setupBenchSchema(ctx context.Context, pool *pgxpool.Pool) error {
+	_, err := pool.Exec(ctx, `
+		DROP SCHEMA IF EXISTS `+benchOrderingSchema+` CASCADE;
+		CREATE SCHEMA `+benchOrderingSchema+`;
+		CREATE TABLE `+benchOrderingSchema+`.tasks (LIKE public.tasks INCLUDING ALL);
+		CREATE TABLE `+benchOrderingSchema+`.shift_tasks (
+			id UUID PRIMARY KEY,
+			column_id UUID NOT NULL,
+			position INT NOT NULL,
+			CONSTRAINT ux_shift_tasks_column_position UNIQUE (column_id, position) DEFERRABLE INITIALLY DEFERRED
+		);
+	`)
+	return err
+}
+
+func seedBenchColumn(ctx context.Context, pool *pgxpool.Pool, columnId column.Id, n int) ([]task.Id, error) {
+	ids := make([]task.Id, 0, n)
+	raw := make([]uuid.UUID, 0, n)
+	keys := make([]string, 0, n)
+	for _, k := range task.SpreadSortKeys(n) {
+		id := task.NewId()
+		ids = append(ids, id)
+		raw = append(raw, id.UUID())
+		keys = append(keys, k.String())
+	}
+
+	_, err := pool.Exec(ctx, `
+		INSERT INTO shift_tasks (id, column_id, position)
+		SELECT k.id, $2, k.n - 1
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS k(id, n)
+	`, raw, columnId.UUID())
+	if err != nil {
+		return nil, err
+	}
+	_, err = pool.Exec(ctx, `
+		INSERT INTO tasks (id, column_id, sort_key, title, assignee_id)
+		SELECT k.id, $2, k.sort_key, 'bench', $3
+		FROM unnest($1::uuid[], $4::text[]) AS k(id, sort_key)
+	`, raw, columnId.UUID(), uuid.New(), keys)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// benchMoveShift повторяет прежний алгоритм MoveTaskUseCase внутри одной колонки.
+func benchMoveShift(ctx context.Context, pool *pgxpool.Pool, columnId column.Id, id task.Id, to int) (int64, error) {
+	var touched int64
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		// блокируем колонку в одном порядке, иначе параллельные сдвиги взаимно блокируются
+		if _, err := tx.Exec(ctx, `SELECT id FROM shift_tasks WHERE column_id = $1 ORDER BY id FOR UPDATE`, columnId.UUID()); err != nil {
+			return err
+		}
+		var from, n int
+		if err := tx.QueryRow(ctx, `SELECT position FROM shift_tasks WHERE id = $1`, id.UUID()).Scan(&from); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM shift_tasks WHERE column_id = $1`, columnId.UUID()).Scan(&n); err != nil {
+			return err
+		}
+
+		to = common.Clamp(to, 0, n-1)
+		if shift, ok := common.CalcShift(from, to); ok {
+			ct, err := tx.Exec(ctx, `
+				UPDATE shift_tasks SET position = position + $4
+				WHERE column_id = $1 AND position BETWEEN $2 AND $3
+			`, columnId.UUID(), shift.FromPosition, shift.ToPosition, shift.Delta)
+			if err != nil {
+				return err
+			}
+			touched += ct.RowsAffected()
+		}
+		ct, err := tx.Exec(ctx, `UPDATE shift_tasks SET position = $2 WHERE id = $1`, id.UUID(), to)
+		if err != nil {
+			return err
+		}
+		touched += ct.RowsAffected()
+		return nil
+	})
+	return touched, err
+}
+
+// benchMoveSortKey повторяет текущий MoveTaskUseCase: ключ через TasksRepo.SortKeyAt и одна строка.
+// Строки, переписанные перебалансировкой, в rows/op не попадают.
+func benchMoveSortKey(ctx context.Context, txm *TxManager, repo *TasksRepo, columnId column.Id, id task.Id, to int) (int64, error) {
+	var touched int64
+	err := txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		n, err := repo.CountInColumn(ctx, columnId)
+		if err != nil {
+			return err
+		}
+		key, err := repo.SortKeyAt(ctx, columnId, common.Clamp(to, 0, n-1), id)
+		if err != nil {
+			return err
+		}
+		ct, err := tx.Exec(ctx, `UPDATE tasks SET sort_key = $2 WHERE id = $1`, id.UUID(), key.String())
+		if err != nil {
+			return err
+		}
+		touched = ct.RowsAffected()
+		return nil
+	})
+	return touched, err
+}
@@ -1,17 +1,21 @@
 package persistence
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog"
 	shboard "github.com/smarrog/task-board/shared/domain/board"
+	shchecklist "github.com/smarrog/task-board/shared/domain/checklist"
 	shcolumn "github.com/smarrog/task-board/shared/domain/column"
+	shcomment "github.com/smarrog/task-board/shared/domain/comment"
 	"github.com/smarrog/task-board/shared/domain/shared"
 	shtask "github.com/smarrog/task-board/shared/domain/task"
 )
@@ -22,6 +26,7 @@ type outboxEventRow struct {
 	AggregateType string
 	AggregateID   uuid.UUID
 	Payload       []byte
+	Watchers      []uuid.UUID
 	CreatedAt     pgtype.Timestamptz
 }
 
@@ -53,14 +58,20 @@ func (r *OutboxRepo) SaveEvents(ctx context.Context, events []shared.DomainEvent
 				return fmt.Errorf("marshal domain event: %w", err)
 			}
 
+			watchers, err := r.watchersForEvent(ctx, tx, ev)
+			if err != nil {
+				return fmt.Errorf("load event watchers: %w", err)
+			}
+
 			outboxID := uuid.New()
 			batch.Queue(
-				`INSERT INTO outbox_events (id, event_type, aggregate_type, aggregate_id, payload) VALUES ($1, $2, $3, $4, $5)`,
+				`INSERT INTO outbox_events (id, event_type, aggregate_type, aggregate_id, payload, watchers) VALUES ($1, $2, $3, $4, $5, $6)`,
 				outboxID,
 				ev.Name(),
 				aggType,
 				aggID,
 				payload,
+				watchers,
 			)
 		}
 
@@ -87,7 +98,7 @@ func (r *OutboxRepo) FetchUnpublishedForUpdate(ctx context.Context, tx pgx.Tx, l
 	}
 
 	rows, err := tx.Query(ctx, `
-        SELECT id, event_type, aggregate_type, aggregate_id, payload, created_at
+        SELECT id, event_type, aggregate_type, aggregate_id, payload, watchers, created_at
         FROM outbox_events
         WHERE published_at IS NULL
         ORDER BY created_at
@@ -102,7 +113,7 @@ func (r *OutboxRepo) FetchUnpublishedForUpdate(ctx context.Context, tx pgx.Tx, l
 	out := make([]outboxEventRow, 0, limit)
 	for rows.Next() {
 		var rrow outboxEventRow
-		if err := rows.Scan(&rrow.ID, &rrow.EventType, &rrow.AggregateType, &rrow.AggregateID, &rrow.Payload, &rrow.CreatedAt); err != nil {
+		if err := rows.Scan(&rrow.ID, &rrow.EventType, &rrow.AggregateType, &rrow.AggregateID, &rrow.Payload, &rrow.Watchers, &rrow.CreatedAt); err != nil {
 			return nil, err
 		}
 		out = append(out, rrow)
@@ -134,6 +145,15 @@ func (r *OutboxRepo) aggregateInfoFromEvent(ev shared.DomainEvent) (string, uuid
 	case shboard.DeletedEvent:
 		id, err := uuid.Parse(e.Id)
 		return "board", id, r.wrapAggregateIdErr("board", e.Id, err)
+	case shboard.RestoredEvent:
+		id, err := uuid.Parse(e.Id)
+		return "board", id, r.wrapAggregateIdErr("board", e.Id, err)
+	case shboard.ArchivedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "board", id, r.wrapAggregateIdErr("board", e.Id, err)
+	case shboard.UnarchivedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "board", id, r.wrapAggregateIdErr("board", e.Id, err)
 
 	case shcolumn.CreatedEvent:
 		id, err := uuid.Parse(e.Id)
@@ -144,6 +164,12 @@ func (r *OutboxRepo) aggregateInfoFromEvent(ev shared.DomainEvent) (string, uuid
 	case shcolumn.DeletedEvent:
 		id, err := uuid.Parse(e.Id)
 		return "column", id, r.wrapAggregateIdErr("column", e.Id, err)
+	case shcolumn.RestoredEvent:
+		id, err := uuid.Parse(e.Id)
+		return "column", id, r.wrapAggregateIdErr("column", e.Id, err)
+	case shcolumn.ReorderedEvent:
+		id, err := uuid.Parse(e.BoardId)
+		return "board", id, r.wrapAggregateIdErr("board", e.BoardId, err)
 
 	case shtask.CreatedEvent:
 		id, err := uuid.Parse(e.Id)
@@ -157,12 +183,157 @@ func (r *OutboxRepo) aggregateInfoFromEvent(ev shared.DomainEvent) (string, uuid
 	case shtask.DeletedEvent:
 		id, err := uuid.Parse(e.Id)
 		return "task", id, r.wrapAggregateIdErr("task", e.Id, err)
+	case shtask.RestoredEvent:
+		id, err := uuid.Parse(e.Id)
+		return "task", id, r.wrapAggregateIdErr("task", e.Id, err)
+	case shtask.ArchivedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "task", id, r.wrapAggregateIdErr("task", e.Id, err)
+	case shtask.UnarchivedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "task", id, r.wrapAggregateIdErr("task", e.Id, err)
+	case shtask.DueSoonEvent:
+		id, err := uuid.Parse(e.Id)
+		return "task", id, r.wrapAggregateIdErr("task", e.Id, err)
+	case shtask.OverdueEvent:
+		id, err := uuid.Parse(e.Id)
+		return "task", id, r.wrapAggregateIdErr("task", e.Id, err)
+
+	case shchecklist.ItemCompletedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "checklist_item", id, r.wrapAggregateIdErr("checklist_item", e.Id, err)
+	case shchecklist.ItemReopenedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "checklist_item", id, r.wrapAggregateIdErr("checklist_item", e.Id, err)
+
+	case shcomment.CreatedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "comment", id, r.wrapAggregateIdErr("comment", e.Id, err)
+	case shcomment.UpdatedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "comment", id, r.wrapAggregateIdErr("comment", e.Id, err)
+	case shcomment.DeletedEvent:
+		id, err := uuid.Parse(e.Id)
+		return "comment", id, r.wrapAggregateIdErr("comment", e.Id, err)
+	case shcomment.MentionedEvent:
+		id, err := uuid.Parse(e.CommentId)
+		return "comment", id, r.wrapAggregateIdErr("comment", e.CommentId, err)
 
 	default:
 		return "", uuid.Nil, errors.New("unknown domain event type")
 	}
 }
 
+// watchersForEvent фиксирует подписчиков на момент события, пока задача/доска ещё существуют.
+func (r *OutboxRepo) watchersForEvent(ctx context.Context, tx pgx.Tx, ev shared.DomainEvent) ([]uuid.UUID, error) {
+	var sql, raw string
+	// при переносе между досками о нём узнают и подписчики доски, которую покинули
+	var leftBoard string
+	switch e := ev.(type) {
+	case shboard.CreatedEvent:
+		sql, raw = boardWatchersSQL, e.Id
+	case shboard.UpdatedEvent:
+		sql, raw = boardWatchersSQL, e.Id
+	case shboard.DeletedEvent:
+		sql, raw = boardWatchersSQL, e.Id
+	case shboard.RestoredEvent:
+		sql, raw = boardWatchersSQL, e.Id
+	case shboard.ArchivedEvent:
+		sql, raw = boardWatchersSQL, e.Id
+	case shboard.UnarchivedEvent:
+		sql, raw = boardWatchersSQL, e.Id
+
+	case shcolumn.CreatedEvent:
+		sql, raw = boardWatchersSQL, e.BoardId
+	case shcolumn.MovedEvent:
+		sql, raw = columnWatchersSQL, e.Id
+		if e.FromBoardId != e.ToBoardId {
+			leftBoard = e.FromBoardId
+		}
+	case shcolumn.DeletedEvent:
+		sql, raw = columnWatchersSQL, e.Id
+	case shcolumn.RestoredEvent:
+		sql, raw = columnWatchersSQL, e.Id
+	case shcolumn.ReorderedEvent:
+		sql, raw = boardWatchersSQL, e.BoardId
+
+	case shtask.CreatedEvent:
+		sql, raw = taskWatchersSQL, e.Id
+	case shtask.UpdatedEvent:
+		sql, raw = taskWatchersSQL, e.Id
+	case shtask.MovedEvent:
+		sql, raw = taskWatchersSQL, e.Id
+		if e.FromBoardId != "" && e.FromBoardId != e.ToBoardId {
+			leftBoard = e.FromBoardId
+		}
+	case shtask.DeletedEvent:
+		sql, raw = taskWatchersSQL, e.Id
+	case shtask.RestoredEvent:
+		sql, raw = taskWatchersSQL, e.Id
+	case shtask.ArchivedEvent:
+		sql, raw = taskWatchersSQL, e.Id
+	case shtask.UnarchivedEvent:
+		sql, raw = taskWatchersSQL, e.Id
+	case shtask.DueSoonEvent:
+		sql, raw = taskWatchersSQL, e.Id
+	case shtask.OverdueEvent:
+		sql, raw = taskWatchersSQL, e.Id
+
+	case shchecklist.ItemCompletedEvent:
+		sql, raw = taskWatchersSQL, e.TaskId
+	case shchecklist.ItemReopenedEvent:
+		sql, raw = taskWatchersSQL, e.TaskId
+
+	case shcomment.CreatedEvent:
+		sql, raw = taskWatchersSQL, e.TaskId
+	case shcomment.UpdatedEvent:
+		sql, raw = taskWatchersSQL, e.TaskId
+	case shcomment.DeletedEvent:
+		sql, raw = taskWatchersSQL, e.TaskId
+	case shcomment.MentionedEvent:
+		sql, raw = taskWatchersSQL, e.TaskId
+
+	default:
+		return []uuid.UUID{}, nil
+	}
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	watchers, err := queryWatchers(ctx, tx, sql, id)
+	if err != nil || leftBoard == "" {
+		return watchers, err
+	}
+
+	boardId, err := uuid.Parse(leftBoard)
+	if err != nil {
+		return nil, err
+	}
+	left, err := queryWatchers(ctx, tx, boardWatchersSQL, boardId)
+	if err != nil {
+		return nil, err
+	}
+	return mergeWatchers(watchers, left), nil
+}
+
+// mergeWatchers объединяет списки подписчиков без повторов, сохраняя порядок по user_id.
+func mergeWatchers(a, b []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(a)+len(b))
+	out := make([]uuid.UUID, 0, len(a)+len(b))
+	for _, list := range [][]uuid.UUID{a, b} {
+		for _, id := range list {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			out = append(out, id)
+		}
+	}
+	slices.SortFunc(out, func(x, y uuid.UUID) int { return bytes.Compare(x[:], y[:]) })
+	return out
+}
+
 func (r *OutboxRepo) wrapAggregateIdErr(aggregateType, raw string, err error) error {
 	if err == nil {
 		return nil
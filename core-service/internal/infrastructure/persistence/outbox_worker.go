@@ -80,6 +80,10 @@ func (w *OutboxWorker) processOnce(ctx context.Context) error {
 				CreatedAt:     r.CreatedAt.Time.UTC(),
 				Payload:       json.RawMessage(r.Payload),
 				Version:       1,
+				Watchers:      make([]string, 0, len(r.Watchers)),
+			}
+			for _, uid := range r.Watchers {
+				oMsg.Watchers = append(oMsg.Watchers, uid.String())
 			}
 
 			b, err := json.Marshal(oMsg)
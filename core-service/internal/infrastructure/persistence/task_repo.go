@@ -3,14 +3,20 @@ package persistence
 import (
 	"github.com/google/uuid"
 	"github.com/smarrog/task-board/shared/domain/shared"
+	shtask "github.com/smarrog/task-board/shared/domain/task"
 
 	"context"
 	"errors"
+	"fmt"
+	"html"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 )
 
@@ -36,50 +42,125 @@ func (r *TasksRepo) InTx(ctx context.Context, fn func(ctx context.Context) error
 
 func (r *TasksRepo) Save(ctx context.Context, t *task.Task) error {
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `
-			INSERT INTO tasks (id, column_id, position, title, description, assignee_id, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		// обновление проходит, только если с момента чтения задачу никто не записал
+		var version int64
+		err := tx.QueryRow(ctx, `
+			INSERT INTO tasks (id, column_id, sort_key, title, description, assignee_id, start_at, due_at, priority, estimate, created_at, updated_at, archived_at, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14::bigint + 1)
 			ON CONFLICT (id) DO UPDATE
 			SET column_id   = EXCLUDED.column_id,
-				position    = EXCLUDED.position,
+				sort_key    = EXCLUDED.sort_key,
 				title       = EXCLUDED.title,
 				description = EXCLUDED.description,
 				assignee_id = EXCLUDED.assignee_id,
-				updated_at  = EXCLUDED.updated_at
+				start_at    = EXCLUDED.start_at,
+				due_at      = EXCLUDED.due_at,
+				priority    = EXCLUDED.priority,
+				estimate    = EXCLUDED.estimate,
+				updated_at  = EXCLUDED.updated_at,
+				archived_at = EXCLUDED.archived_at,
+				-- новый срок — напоминания отправляются заново
+				due_soon_notified_at = CASE WHEN tasks.due_at IS DISTINCT FROM EXCLUDED.due_at
+					THEN NULL ELSE tasks.due_soon_notified_at END,
+				overdue_notified_at  = CASE WHEN tasks.due_at IS DISTINCT FROM EXCLUDED.due_at
+					THEN NULL ELSE tasks.overdue_notified_at END,
+				version = tasks.version + 1
+			WHERE tasks.version = $14
+			RETURNING version
 		`,
 			t.Id().UUID(),
 			t.ColumnId().UUID(),
-			t.Position(),
+			t.SortKey().String(),
 			t.Title().String(),
 			t.Description().String(),
 			t.AssigneeId().UUID(),
+			zeroToNil(t.Dates().StartAt()),
+			zeroToNil(t.Dates().DueAt()),
+			priorityToNil(t.Priority()),
+			t.Estimate().Ptr(),
 			t.CreatedAt(),
 			t.UpdatedAt(),
-		)
+			zeroToNil(t.ArchivedAt()),
+			t.Version(),
+		).Scan(&version)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return task.ErrConcurrentUpdate
+		}
 		if err != nil {
 			return err
 		}
+		t.SetVersion(version)
+
+		// остальные сохранения task_labels не трогают: набор, прочитанный вместе
+		// с задачей, мог устареть или быть неполным
+		if t.LabelsChanged() {
+			if err := r.saveLabels(ctx, tx, t); err != nil {
+				return err
+			}
+		}
+
+		// исполнитель подписывается на задачу, только когда его назначили:
+		// иначе каждое сохранение отменяло бы его отписку
+		if t.AssigneeChanged() {
+			_, err = tx.Exec(ctx, `
+				INSERT INTO task_watchers (task_id, user_id) VALUES ($1, $2)
+				ON CONFLICT DO NOTHING
+			`, t.Id().UUID(), t.AssigneeId().UUID())
+			if err != nil {
+				return err
+			}
+		}
 
 		events := t.PullEvents()
 		return r.outbox.SaveEvents(ctx, events)
 	})
 }
 
+func (r *TasksRepo) saveLabels(ctx context.Context, tx pgx.Tx, t *task.Task) error {
+	ids := make([]uuid.UUID, 0, len(t.LabelIds()))
+	for _, id := range t.LabelIds() {
+		ids = append(ids, id.UUID())
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM task_labels WHERE task_id = $1 AND label_id <> ALL($2)
+	`, t.Id().UUID(), ids); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO task_labels (task_id, label_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING
+	`, t.Id().UUID(), ids)
+	return err
+}
+
 func (r *TasksRepo) Get(ctx context.Context, id task.Id) (*task.Task, error) {
 	db := r.txm.DB(ctx)
 
 	var columnIdRaw string
 	var positionRaw int
+	var sortKeyRaw string
 	var titleRaw string
 	var descRaw string
 	var assigneeIdRaw string
+	var startAt, dueAt *time.Time
+	var priorityRaw *string
+	var estimateRaw *float64
+	var labelIdsRaw []uuid.UUID
 	var createdAt, updatedAt time.Time
+	var archivedAt *time.Time
+	var version int64
 
 	err := db.QueryRow(ctx, `
-		SELECT column_id, position, title, description, assignee_id, created_at, updated_at
-		FROM tasks
-		WHERE id = $1
-	`, id.UUID()).Scan(&columnIdRaw, &positionRaw, &titleRaw, &descRaw, &assigneeIdRaw, &createdAt, &updatedAt)
+		SELECT column_id, `+taskPositionSelect+`, sort_key, title, description, assignee_id, start_at, due_at, priority, estimate,
+		       `+taskLabelIdsSelect+`, created_at, updated_at, archived_at, version
+		FROM tasks t
+		WHERE id = $1 AND deleted_at IS NULL
+	`, id.UUID()).Scan(&columnIdRaw, &positionRaw, &sortKeyRaw, &titleRaw, &descRaw, &assigneeIdRaw, &startAt, &dueAt, &priorityRaw, &estimateRaw, &labelIdsRaw, &createdAt, &updatedAt, &archivedAt, &version)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, task.ErrNotFound
 	}
@@ -95,6 +176,10 @@ func (r *TasksRepo) Get(ctx context.Context, id task.Id) (*task.Task, error) {
 	if err != nil {
 		return nil, err
 	}
+	sortKey, err := task.NewSortKey(sortKeyRaw)
+	if err != nil {
+		return nil, err
+	}
 	title, err := task.NewTitle(titleRaw)
 	if err != nil {
 		return nil, err
@@ -107,18 +192,31 @@ func (r *TasksRepo) Get(ctx context.Context, id task.Id) (*task.Task, error) {
 	if err != nil {
 		return nil, err
 	}
+	dates, err := task.NewDates(timeOrZero(startAt), timeOrZero(dueAt))
+	if err != nil {
+		return nil, err
+	}
+	priority, estimate, err := planningFromRow(priorityRaw, estimateRaw)
+	if err != nil {
+		return nil, err
+	}
+	labelIds, err := labelIdsFromUUIDs(labelIdsRaw)
+	if err != nil {
+		return nil, err
+	}
 
-	return task.Rehydrate(id, columnId, position, title, desc, assigneeId, createdAt, updatedAt), nil
+	return task.Rehydrate(id, columnId, position, sortKey, title, desc, assigneeId, dates, priority, estimate, labelIds, createdAt, updatedAt, timeOrZero(archivedAt), version), nil
 }
 
 func (r *TasksRepo) ListByColumn(ctx context.Context, columnId column.Id) ([]*task.Task, error) {
 	db := r.txm.DB(ctx)
 
 	rows, err := db.Query(ctx, `
-		SELECT id, position, title, description, assignee_id, created_at, updated_at
-		FROM tasks
-		WHERE column_id = $1
-		ORDER BY position ASC
+		SELECT id, `+taskRowNumberSelect+`, sort_key, title, description, assignee_id, start_at, due_at, priority, estimate,
+		       `+taskLabelIdsSelect+`, created_at, updated_at, version
+		FROM tasks t
+		WHERE column_id = $1 AND deleted_at IS NULL AND archived_at IS NULL
+		ORDER BY sort_key, id
 	`, columnId.UUID())
 	if err != nil {
 		return nil, err
@@ -129,11 +227,17 @@ func (r *TasksRepo) ListByColumn(ctx context.Context, columnId column.Id) ([]*ta
 	for rows.Next() {
 		var idRaw string
 		var positionRaw int
+		var sortKeyRaw string
 		var titleRaw string
 		var descRaw string
 		var assigneeIdRaw string
+		var startAt, dueAt *time.Time
+		var priorityRaw *string
+		var estimateRaw *float64
+		var labelIdsRaw []uuid.UUID
 		var createdAt, updatedAt time.Time
-		if err := rows.Scan(&idRaw, &positionRaw, &titleRaw, &descRaw, &assigneeIdRaw, &createdAt, &updatedAt); err != nil {
+		var version int64
+		if err := rows.Scan(&idRaw, &positionRaw, &sortKeyRaw, &titleRaw, &descRaw, &assigneeIdRaw, &startAt, &dueAt, &priorityRaw, &estimateRaw, &labelIdsRaw, &createdAt, &updatedAt, &version); err != nil {
 			return nil, err
 		}
 		id, err := task.IdFromString(idRaw)
@@ -144,6 +248,10 @@ func (r *TasksRepo) ListByColumn(ctx context.Context, columnId column.Id) ([]*ta
 		if err != nil {
 			return nil, err
 		}
+		sortKey, err := task.NewSortKey(sortKeyRaw)
+		if err != nil {
+			return nil, err
+		}
 		title, err := task.NewTitle(titleRaw)
 		if err != nil {
 			return nil, err
@@ -156,7 +264,19 @@ func (r *TasksRepo) ListByColumn(ctx context.Context, columnId column.Id) ([]*ta
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, task.Rehydrate(id, columnId, pos, title, desc, assigneeId, createdAt, updatedAt))
+		dates, err := task.NewDates(timeOrZero(startAt), timeOrZero(dueAt))
+		if err != nil {
+			return nil, err
+		}
+		priority, estimate, err := planningFromRow(priorityRaw, estimateRaw)
+		if err != nil {
+			return nil, err
+		}
+		labelIds, err := labelIdsFromUUIDs(labelIdsRaw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, task.Rehydrate(id, columnId, pos, sortKey, title, desc, assigneeId, dates, priority, estimate, labelIds, createdAt, updatedAt, time.Time{}, version))
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -169,19 +289,36 @@ func (r *TasksRepo) ListByColumns(ctx context.Context, columnIds []column.Id) ([
 	if len(columnIds) == 0 {
 		return []*task.Task{}, nil
 	}
-	db := r.txm.DB(ctx)
 
 	ids := make([]uuid.UUID, 0, len(columnIds))
 	for _, cid := range columnIds {
 		ids = append(ids, cid.UUID())
 	}
 
-	rows, err := db.Query(ctx, `
-		SELECT id, column_id, position, title, description, assignee_id, created_at, updated_at
-		FROM tasks
-		WHERE column_id = ANY($1)
-		ORDER BY column_id ASC, position ASC
+	return r.queryTasks(ctx, taskRowNumberSelect, `
+		WHERE column_id = ANY($1) AND deleted_at IS NULL AND archived_at IS NULL
+		ORDER BY column_id ASC, sort_key, id
 	`, ids)
+}
+
+func (r *TasksRepo) ListByLabel(ctx context.Context, labelId label.Id) ([]*task.Task, error) {
+	return r.queryTasks(ctx, taskPositionSelect, `
+		WHERE EXISTS (SELECT 1 FROM task_labels tl WHERE tl.task_id = t.id AND tl.label_id = $1)
+		  AND deleted_at IS NULL
+		ORDER BY column_id ASC, sort_key, id
+	`, labelId.UUID())
+}
+
+// queryTasks выбирает задачи целиком; filter — WHERE/ORDER BY по таблице tasks t,
+// position — taskRowNumberSelect, если filter берёт колонки целиком, иначе taskPositionSelect.
+func (r *TasksRepo) queryTasks(ctx context.Context, position string, filter string, args ...any) ([]*task.Task, error) {
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT id, column_id, `+position+`, sort_key, title, description, assignee_id, start_at, due_at, priority, estimate,
+		       `+taskLabelIdsSelect+`, created_at, updated_at, archived_at, version
+		FROM tasks t
+	`+filter, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -193,13 +330,21 @@ func (r *TasksRepo) ListByColumns(ctx context.Context, columnIds []column.Id) ([
 			idRaw         string
 			columnIdRaw   string
 			positionRaw   int
+			sortKeyRaw    string
 			titleRaw      string
 			descRaw       string
 			assigneeIdRaw string
+			startAt       *time.Time
+			dueAt         *time.Time
+			priorityRaw   *string
+			estimateRaw   *float64
+			labelIdsRaw   []uuid.UUID
 			createdAt     time.Time
 			updatedAt     time.Time
+			archivedAt    *time.Time
+			version       int64
 		)
-		if err := rows.Scan(&idRaw, &columnIdRaw, &positionRaw, &titleRaw, &descRaw, &assigneeIdRaw, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&idRaw, &columnIdRaw, &positionRaw, &sortKeyRaw, &titleRaw, &descRaw, &assigneeIdRaw, &startAt, &dueAt, &priorityRaw, &estimateRaw, &labelIdsRaw, &createdAt, &updatedAt, &archivedAt, &version); err != nil {
 			return nil, err
 		}
 		id, err := task.IdFromString(idRaw)
@@ -214,6 +359,10 @@ func (r *TasksRepo) ListByColumns(ctx context.Context, columnIds []column.Id) ([
 		if err != nil {
 			return nil, err
 		}
+		sortKey, err := task.NewSortKey(sortKeyRaw)
+		if err != nil {
+			return nil, err
+		}
 		title, err := task.NewTitle(titleRaw)
 		if err != nil {
 			return nil, err
@@ -226,7 +375,19 @@ func (r *TasksRepo) ListByColumns(ctx context.Context, columnIds []column.Id) ([
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, task.Rehydrate(id, cid, pos, title, desc, assigneeId, createdAt, updatedAt))
+		dates, err := task.NewDates(timeOrZero(startAt), timeOrZero(dueAt))
+		if err != nil {
+			return nil, err
+		}
+		priority, estimate, err := planningFromRow(priorityRaw, estimateRaw)
+		if err != nil {
+			return nil, err
+		}
+		labelIds, err := labelIdsFromUUIDs(labelIdsRaw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, task.Rehydrate(id, cid, pos, sortKey, title, desc, assigneeId, dates, priority, estimate, labelIds, createdAt, updatedAt, timeOrZero(archivedAt), version))
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -235,9 +396,12 @@ func (r *TasksRepo) ListByColumns(ctx context.Context, columnIds []column.Id) ([
 	return out, nil
 }
 
+// Delete переносит задачу в корзину; окончательно её удаляет TrashPurgeWorker.
 func (r *TasksRepo) Delete(ctx context.Context, id task.Id) error {
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		ct, err := tx.Exec(ctx, `DELETE FROM tasks WHERE id = $1`, id.UUID())
+		at := time.Now().UTC()
+
+		ct, err := tx.Exec(ctx, `UPDATE tasks SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL`, id.UUID(), at)
 		if err != nil {
 			return err
 		}
@@ -245,62 +409,582 @@ func (r *TasksRepo) Delete(ctx context.Context, id task.Id) error {
 			return task.ErrNotFound
 		}
 
-		events := []shared.DomainEvent{task.DeletedEvent{Id: id.String(), At: time.Now().UTC()}}
-		if err := r.outbox.SaveEvents(ctx, events); err != nil {
-			return err
+		events := []shared.DomainEvent{shtask.DeletedEvent{Id: id.String(), At: at}}
+		return r.outbox.SaveEvents(ctx, events)
+	})
+}
+
+func (r *TasksRepo) GetTrashed(ctx context.Context, id task.Id) (*task.Trashed, error) {
+	db := r.txm.DB(ctx)
+
+	var deletedAt time.Time
+	err := db.QueryRow(ctx, `
+		SELECT deleted_at FROM tasks WHERE id = $1 AND deleted_at IS NOT NULL
+	`, id.UUID()).Scan(&deletedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, task.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := r.queryTasks(ctx, taskPositionSelect, `WHERE id = $1`, id.UUID())
+	if err != nil {
+		return nil, err
+	}
+	if len(ts) == 0 {
+		return nil, task.ErrNotFound
+	}
+	return &task.Trashed{Task: ts[0], DeletedAt: deletedAt}, nil
+}
+
+// ListTrashed отдаёт задачи доски, удалённые сами по себе, а не вместе с колонкой.
+func (r *TasksRepo) ListTrashed(ctx context.Context, boardId board.Id) ([]task.Trashed, error) {
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT t.id, t.deleted_at
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		WHERE c.board_id = $1 AND t.deleted_at IS NOT NULL
+		  AND t.deleted_at IS DISTINCT FROM c.deleted_at
+		ORDER BY t.deleted_at DESC, t.id
+	`, boardId.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	deletedAt := make(map[uuid.UUID]time.Time)
+	for rows.Next() {
+		var id uuid.UUID
+		var at time.Time
+		if err := rows.Scan(&id, &at); err != nil {
+			return nil, err
 		}
+		ids = append(ids, id)
+		deletedAt[id] = at
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []task.Trashed{}, nil
+	}
 
-		return nil
-	})
+	ts, err := r.queryTasks(ctx, taskPositionSelect, `WHERE id = ANY($1)`, ids)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[uuid.UUID]*task.Task, len(ts))
+	for _, t := range ts {
+		byId[t.Id().UUID()] = t
+	}
+
+	out := make([]task.Trashed, 0, len(ids))
+	for _, id := range ids {
+		if t, ok := byId[id]; ok {
+			out = append(out, task.Trashed{Task: t, DeletedAt: deletedAt[id]})
+		}
+	}
+	return out, nil
 }
 
-func (r *TasksRepo) LockColumnTasks(ctx context.Context, columnId column.Id) error {
+// Restore достаёт задачу из корзины; по сохранённому sort_key она встаёт на прежнее место.
+func (r *TasksRepo) Restore(ctx context.Context, t *task.Task) error {
 	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `SELECT id FROM tasks WHERE column_id=$1 FOR UPDATE`, columnId.UUID())
-		return err
+		var version int64
+		err := tx.QueryRow(ctx, `
+			UPDATE tasks SET deleted_at = NULL, updated_at = $2, version = version + 1
+			WHERE id = $1 AND deleted_at IS NOT NULL
+			RETURNING version
+		`, t.Id().UUID(), t.UpdatedAt()).Scan(&version)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return task.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		t.SetVersion(version)
+
+		events := t.PullEvents()
+		return r.outbox.SaveEvents(ctx, events)
 	})
 }
 
 func (r *TasksRepo) CountInColumn(ctx context.Context, columnId column.Id) (int, error) {
 	var n int
 	err := r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		return tx.QueryRow(ctx, `SELECT COUNT(*) FROM tasks WHERE column_id=$1`, columnId.UUID()).Scan(&n)
+		return tx.QueryRow(ctx, `SELECT COUNT(*) FROM tasks WHERE column_id=$1 AND deleted_at IS NULL AND archived_at IS NULL`, columnId.UUID()).Scan(&n)
 	})
 	return n, err
 }
 
-func (r *TasksRepo) ShiftPositions(ctx context.Context, columnId column.Id, fromIncl, toIncl int, delta int) error {
-	if fromIncl > toIncl || delta == 0 {
-		return nil
-	}
-	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `
-			UPDATE tasks
-			SET position = position + $4
-			WHERE column_id=$1 AND position BETWEEN $2 AND $3
-		`, columnId.UUID(), fromIncl, toIncl, delta)
+func (r *TasksRepo) SortKeyAt(ctx context.Context, columnId column.Id, index int, skip task.Id) (task.SortKey, error) {
+	var key task.SortKey
+	err := r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		prev, next, err := r.neighbours(ctx, tx, columnId, index, skip)
+		if err != nil {
+			return err
+		}
+		key, err = task.SortKeyBetween(prev, next)
+		if !errors.Is(err, task.ErrSortKeyExhausted) {
+			return err
+		}
+
+		if err := r.rebalance(ctx, tx, columnId, skip); err != nil {
+			return err
+		}
+		prev, next, err = r.neighbours(ctx, tx, columnId, index, skip)
+		if err != nil {
+			return err
+		}
+		key, err = task.SortKeyBetween(prev, next)
 		return err
 	})
+	return key, err
 }
 
-func (r *TasksRepo) ShiftAfterRemove(ctx context.Context, columnId column.Id, fromPos int) error {
-	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `
-			UPDATE tasks
-			SET position = position - 1
-			WHERE column_id=$1 AND position > $2
-		`, columnId.UUID(), fromPos)
-		return err
-	})
+// neighbours отдаёт ключи задач порядка колонки, между которыми окажется место index. Блокируются
+// только соседи и сама задача skip, все в порядке ключей, чтобы встречные перемещения
+// не брали блокировки в разном порядке.
+func (r *TasksRepo) neighbours(ctx context.Context, tx pgx.Tx, columnId column.Id, index int, skip task.Id) (task.SortKey, task.SortKey, error) {
+	offset, limit := index-1, 2
+	if index == 0 {
+		offset, limit = 0, 1
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, sort_key FROM tasks
+		WHERE id = $2 OR id IN (
+			SELECT id FROM tasks
+			WHERE column_id = $1 AND deleted_at IS NULL AND archived_at IS NULL AND id <> $2
+			ORDER BY sort_key, id
+			OFFSET $3 LIMIT $4
+		)
+		ORDER BY sort_key, id
+		FOR UPDATE
+	`, columnId.UUID(), skip.UUID(), offset, limit)
+	if err != nil {
+		return "", "", err
+	}
+	defer rows.Close()
+
+	keys := make([]task.SortKey, 0, 2)
+	for rows.Next() {
+		var id uuid.UUID
+		var key string
+		if err := rows.Scan(&id, &key); err != nil {
+			return "", "", err
+		}
+		if id != skip.UUID() {
+			keys = append(keys, task.SortKey(key))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", "", err
+	}
+
+	var prev, next task.SortKey
+	switch {
+	case index == 0 && len(keys) > 0:
+		next = keys[0]
+	case index > 0 && len(keys) > 0:
+		prev = keys[0]
+		if len(keys) > 1 {
+			next = keys[1]
+		}
+	}
+	return prev, next, nil
 }
 
-func (r *TasksRepo) ShiftForInsert(ctx context.Context, columnId column.Id, toPos int) error {
-	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `
-			UPDATE tasks
-			SET position = position + 1
-			WHERE column_id=$1 AND position >= $2
-		`, columnId.UUID(), toPos)
+// rebalance раздаёт задачам колонки равномерные ключи, сохраняя порядок. Задачи в корзине
+// и архиве тоже получают новые ключи, чтобы после восстановления встать между прежними соседями.
+// Версия растёт у всех, кроме skip: иначе Save, прочитавший задачу до перебалансировки, вернул бы
+// ей старый ключ. Задачу skip вызывающий следом сохраняет сам, уже с новым ключом.
+func (r *TasksRepo) rebalance(ctx context.Context, tx pgx.Tx, columnId column.Id, skip task.Id) error {
+	rows, err := tx.Query(ctx, `
+		SELECT id FROM tasks WHERE column_id = $1 ORDER BY sort_key, id FOR UPDATE
+	`, columnId.UUID())
+	if err != nil {
 		return err
-	})
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, k := range task.SpreadSortKeys(len(ids)) {
+		keys = append(keys, k.String())
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE tasks t
+		SET sort_key = k.sort_key,
+			version = CASE WHEN t.id = $3 THEN t.version ELSE t.version + 1 END
+		FROM unnest($1::uuid[], $2::text[]) AS k(id, sort_key)
+		WHERE t.id = k.id
+	`, ids, keys, skip.UUID())
+	if err != nil {
+		return err
+	}
+
+	r.log.Debug().Str("column_id", columnId.String()).Int("tasks", len(ids)).Msg("task sort keys rebalanced")
+	return nil
+}
+
+// ts_headline не экранирует текст задачи, поэтому совпадения он помечает управляющими
+// символами, а <mark> подставляется уже после HTML-экранирования (см. highlightSnippet).
+const (
+	headlineStartSel = "\x01"
+	headlineStopSel  = "\x02"
+
+	searchHeadlineOptions = `StartSel="` + headlineStartSel + `", StopSel="` + headlineStopSel + `", MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" … "`
+)
+
+var headlineMarks = strings.NewReplacer(headlineStartSel, "<mark>", headlineStopSel, "</mark>")
+
+func highlightSnippet(raw string) string {
+	return headlineMarks.Replace(html.EscapeString(raw))
+}
+
+func (r *TasksRepo) Search(ctx context.Context, q task.SearchQuery) ([]task.SearchHit, error) {
+	db := r.txm.DB(ctx)
+
+	args := []any{q.RequesterId.UUID(), q.Text.String()}
+	var where strings.Builder
+	where.WriteString("b.owner_id = $1 AND t.deleted_at IS NULL AND t.search_vector @@ q.query")
+
+	if !q.IncludeArchived {
+		where.WriteString(" AND t.archived_at IS NULL")
+	}
+	if q.BoardId != nil {
+		args = append(args, q.BoardId.UUID())
+		fmt.Fprintf(&where, " AND c.board_id = $%d", len(args))
+	}
+	if q.ColumnId != nil {
+		args = append(args, q.ColumnId.UUID())
+		fmt.Fprintf(&where, " AND t.column_id = $%d", len(args))
+	}
+	if q.AssigneeId != nil {
+		args = append(args, q.AssigneeId.UUID())
+		fmt.Fprintf(&where, " AND t.assignee_id = $%d", len(args))
+	}
+	if !q.CreatedFrom.IsZero() {
+		args = append(args, q.CreatedFrom)
+		fmt.Fprintf(&where, " AND t.created_at >= $%d", len(args))
+	}
+	if !q.CreatedTo.IsZero() {
+		args = append(args, q.CreatedTo)
+		fmt.Fprintf(&where, " AND t.created_at < $%d", len(args))
+	}
+
+	after := "TRUE"
+	if q.After != nil {
+		args = append(args, q.After.Rank, q.After.Id.UUID())
+		after = fmt.Sprintf("(h.rank, h.id) < ($%d::real, $%d)", len(args)-1, len(args))
+	}
+
+	args = append(args, q.Limit)
+	limit := len(args)
+
+	// ts_headline дорогой, поэтому считаем его только для строк, прошедших LIMIT.
+	sql := fmt.Sprintf(`
+		WITH q AS (
+			SELECT websearch_to_tsquery('simple', $2) AS query
+		), hits AS (
+			SELECT t.id, t.column_id, `+taskPositionSelect+` AS position, t.sort_key, t.title, t.description, t.assignee_id,
+			       t.start_at, t.due_at, t.priority, t.estimate, `+taskLabelIdsSelect+` AS label_ids,
+			       t.created_at, t.updated_at, t.archived_at, t.version, c.board_id,
+			       ts_rank(t.search_vector, q.query) AS rank
+			FROM tasks t
+			JOIN columns c ON c.id = t.column_id
+			JOIN boards b ON b.id = c.board_id
+			CROSS JOIN q
+			WHERE %s
+		), page AS (
+			SELECT h.*
+			FROM hits h
+			WHERE %s
+			ORDER BY h.rank DESC, h.id DESC
+			LIMIT $%d
+		)
+		SELECT p.id, p.column_id, p.position, p.sort_key, p.title, p.description, p.assignee_id,
+		       p.start_at, p.due_at, p.priority, p.estimate, p.label_ids, p.created_at, p.updated_at, p.archived_at, p.version, p.board_id, p.rank,
+		       ts_headline('simple', translate(p.title || E'\n' || p.description, E'\x01\x02', ''), q.query, '%s')
+		FROM page p
+		CROSS JOIN q
+		ORDER BY p.rank DESC, p.id DESC
+	`, where.String(), after, limit, searchHeadlineOptions)
+
+	rows, err := db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]task.SearchHit, 0)
+	for rows.Next() {
+		var (
+			idRaw         uuid.UUID
+			columnIdRaw   uuid.UUID
+			positionRaw   int
+			sortKeyRaw    string
+			titleRaw      string
+			descRaw       string
+			assigneeIdRaw uuid.UUID
+			startAt       *time.Time
+			dueAt         *time.Time
+			priorityRaw   *string
+			estimateRaw   *float64
+			labelIdsRaw   []uuid.UUID
+			createdAt     time.Time
+			updatedAt     time.Time
+			archivedAt    *time.Time
+			version       int64
+			boardIdRaw    uuid.UUID
+			rank          float32
+			snippet       string
+		)
+		if err := rows.Scan(
+			&idRaw, &columnIdRaw, &positionRaw, &sortKeyRaw, &titleRaw, &descRaw, &assigneeIdRaw,
+			&startAt, &dueAt, &priorityRaw, &estimateRaw, &labelIdsRaw, &createdAt, &updatedAt, &archivedAt, &version, &boardIdRaw, &rank, &snippet,
+		); err != nil {
+			return nil, err
+		}
+		id, err := task.IdFromUUID(idRaw)
+		if err != nil {
+			return nil, err
+		}
+		columnId, err := column.IdFromUUID(columnIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		pos, err := task.NewPosition(positionRaw)
+		if err != nil {
+			return nil, err
+		}
+		sortKey, err := task.NewSortKey(sortKeyRaw)
+		if err != nil {
+			return nil, err
+		}
+		title, err := task.NewTitle(titleRaw)
+		if err != nil {
+			return nil, err
+		}
+		desc, err := task.NewDescription(descRaw)
+		if err != nil {
+			return nil, err
+		}
+		assigneeId, err := shared.UserIdFromUUID(assigneeIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		dates, err := task.NewDates(timeOrZero(startAt), timeOrZero(dueAt))
+		if err != nil {
+			return nil, err
+		}
+		priority, estimate, err := planningFromRow(priorityRaw, estimateRaw)
+		if err != nil {
+			return nil, err
+		}
+		labelIds, err := labelIdsFromUUIDs(labelIdsRaw)
+		if err != nil {
+			return nil, err
+		}
+		boardId, err := board.IdFromUUID(boardIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, task.SearchHit{
+			Task:    task.Rehydrate(id, columnId, pos, sortKey, title, desc, assigneeId, dates, priority, estimate, labelIds, createdAt, updatedAt, timeOrZero(archivedAt), version),
+			BoardId: boardId,
+			Rank:    rank,
+			Snippet: highlightSnippet(snippet),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *TasksRepo) ListTasksByAssignee(ctx context.Context, requesterId shared.UserId, assigneeId shared.UserId, sort task.AssignedSort, includeArchived bool) ([]task.AssignedTask, error) {
+	db := r.txm.DB(ctx)
+
+	var orderBy string
+	switch sort {
+	case task.AssignedSortDueAt:
+		orderBy = "t.due_at ASC NULLS LAST, t.updated_at DESC, t.id"
+	default:
+		orderBy = "t.updated_at DESC, t.id"
+	}
+
+	rows, err := db.Query(ctx, `
+		SELECT t.id, t.column_id, `+taskPositionSelect+`, t.sort_key, t.title, t.description, t.start_at, t.due_at, t.priority, t.estimate,
+		       `+taskLabelIdsSelect+`, t.created_at, t.updated_at, t.archived_at, t.version, c.position, b.id, b.title
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		JOIN boards b ON b.id = c.board_id
+		WHERE t.assignee_id = $1 AND t.deleted_at IS NULL AND ($2 OR t.archived_at IS NULL)
+		  AND (t.assignee_id = $3 OR b.owner_id = $3)
+		ORDER BY `+orderBy, assigneeId.UUID(), includeArchived, requesterId.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]task.AssignedTask, 0)
+	for rows.Next() {
+		var (
+			idRaw         uuid.UUID
+			columnIdRaw   uuid.UUID
+			positionRaw   int
+			sortKeyRaw    string
+			titleRaw      string
+			descRaw       string
+			startAt       *time.Time
+			dueAt         *time.Time
+			priorityRaw   *string
+			estimateRaw   *float64
+			labelIdsRaw   []uuid.UUID
+			createdAt     time.Time
+			updatedAt     time.Time
+			archivedAt    *time.Time
+			version       int64
+			columnPosRaw  int
+			boardIdRaw    uuid.UUID
+			boardTitleRaw string
+		)
+		if err := rows.Scan(
+			&idRaw, &columnIdRaw, &positionRaw, &sortKeyRaw, &titleRaw, &descRaw, &startAt, &dueAt, &priorityRaw, &estimateRaw,
+			&labelIdsRaw, &createdAt, &updatedAt, &archivedAt, &version, &columnPosRaw, &boardIdRaw, &boardTitleRaw,
+		); err != nil {
+			return nil, err
+		}
+		id, err := task.IdFromUUID(idRaw)
+		if err != nil {
+			return nil, err
+		}
+		columnId, err := column.IdFromUUID(columnIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		pos, err := task.NewPosition(positionRaw)
+		if err != nil {
+			return nil, err
+		}
+		sortKey, err := task.NewSortKey(sortKeyRaw)
+		if err != nil {
+			return nil, err
+		}
+		title, err := task.NewTitle(titleRaw)
+		if err != nil {
+			return nil, err
+		}
+		desc, err := task.NewDescription(descRaw)
+		if err != nil {
+			return nil, err
+		}
+		dates, err := task.NewDates(timeOrZero(startAt), timeOrZero(dueAt))
+		if err != nil {
+			return nil, err
+		}
+		priority, estimate, err := planningFromRow(priorityRaw, estimateRaw)
+		if err != nil {
+			return nil, err
+		}
+		labelIds, err := labelIdsFromUUIDs(labelIdsRaw)
+		if err != nil {
+			return nil, err
+		}
+		columnPos, err := column.NewPosition(columnPosRaw)
+		if err != nil {
+			return nil, err
+		}
+		boardId, err := board.IdFromUUID(boardIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		boardTitle, err := board.NewTitle(boardTitleRaw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, task.AssignedTask{
+			Task:           task.Rehydrate(id, columnId, pos, sortKey, title, desc, assigneeId, dates, priority, estimate, labelIds, createdAt, updatedAt, timeOrZero(archivedAt), version),
+			BoardId:        boardId,
+			BoardTitle:     boardTitle,
+			ColumnPosition: columnPos,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// taskPositionSelect выводит позицию задачи tasks t из sort_key: сколько задач порядка колонки
+// (не в корзине и не в архиве) стоят раньше. Для задачи в корзине это место, на которое она вернётся.
+const taskPositionSelect = `(SELECT COUNT(*) FROM tasks p
+	WHERE p.column_id = t.column_id AND p.deleted_at IS NULL AND p.archived_at IS NULL
+	  AND (p.sort_key, p.id) < (t.sort_key, t.id))`
+
+// taskRowNumberSelect — то же для выборок, где колонки взяты целиком: нумерация дешевле подсчётов.
+const taskRowNumberSelect = `ROW_NUMBER() OVER (PARTITION BY t.column_id ORDER BY t.sort_key, t.id) - 1`
+
+// taskLabelIdsSelect — метки задачи из tasks t одним массивом (пустой, если меток нет).
+const taskLabelIdsSelect = `ARRAY(SELECT tl.label_id FROM task_labels tl WHERE tl.task_id = t.id ORDER BY tl.label_id)`
+
+func labelIdsFromUUIDs(raw []uuid.UUID) ([]label.Id, error) {
+	out := make([]label.Id, 0, len(raw))
+	for _, u := range raw {
+		id, err := label.IdFromUUID(u)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+func timeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func zeroToNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func priorityToNil(p task.Priority) *string {
+	if p == task.PriorityNone {
+		return nil
+	}
+	v := p.String()
+	return &v
+}
+
+func planningFromRow(priorityRaw *string, estimateRaw *float64) (task.Priority, task.Estimate, error) {
+	priority := task.PriorityNone
+	if priorityRaw != nil {
+		p, err := task.NewPriority(*priorityRaw)
+		if err != nil {
+			return task.PriorityNone, task.Estimate{}, err
+		}
+		priority = p
+	}
+	estimate, err := task.EstimateFromPtr(estimateRaw)
+	if err != nil {
+		return task.PriorityNone, task.Estimate{}, err
+	}
+	return priority, estimate, nil
 }
@@ -0,0 +1,23 @@
+package persistence
+
+import "testing"
+
+func TestHighlightSnippetEscapesTaskText(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"plain", "fix \x01login\x02 page", "fix <mark>login</mark> page"},
+		{"markup in title", "<img src=x onerror=alert(1)> \x01login\x02", "&lt;img src=x onerror=alert(1)&gt; <mark>login</mark>"},
+		{"markup inside match", "\x01<b>\x02 & \"q\"", "<mark>&lt;b&gt;</mark> &amp; &#34;q&#34;"},
+		{"fake mark tag", "</mark><script>", "&lt;/mark&gt;&lt;script&gt;"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := highlightSnippet(c.raw); got != c.want {
+				t.Fatalf("highlightSnippet(%q) = %q, want %q", c.raw, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/domain/template"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type TemplatesRepo struct {
+	txm *TxManager
+	log *zerolog.Logger
+}
+
+func NewTemplatesRepo(txm *TxManager, log *zerolog.Logger) *TemplatesRepo {
+	return &TemplatesRepo{
+		txm: txm,
+		log: log,
+	}
+}
+
+func (r *TemplatesRepo) Save(ctx context.Context, t *template.Template) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var ownerId uuid.NullUUID
+		if !t.IsBuiltIn() {
+			ownerId = uuid.NullUUID{UUID: t.OwnerId().UUID(), Valid: true}
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO board_templates (id, owner_id, name, description, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO UPDATE
+			SET name        = EXCLUDED.name,
+				description = EXCLUDED.description,
+				updated_at  = EXCLUDED.updated_at
+		`,
+			t.Id().UUID(),
+			ownerId,
+			t.Name().String(),
+			t.Description().String(),
+			t.CreatedAt(),
+			t.UpdatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		// Колонки и метки шаблона перезаписываются целиком.
+		if _, err := tx.Exec(ctx, `DELETE FROM board_template_columns WHERE template_id = $1`, t.Id().UUID()); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM board_template_labels WHERE template_id = $1`, t.Id().UUID()); err != nil {
+			return err
+		}
+
+		batch := &pgx.Batch{}
+		for i, title := range t.Columns() {
+			batch.Queue(`
+				INSERT INTO board_template_columns (template_id, position, title) VALUES ($1, $2, $3)
+			`, t.Id().UUID(), i, title.String())
+		}
+		for _, l := range t.Labels() {
+			batch.Queue(`
+				INSERT INTO board_template_labels (template_id, name, color) VALUES ($1, $2, $3)
+			`, t.Id().UUID(), l.Name.String(), l.Color.String())
+		}
+		return tx.SendBatch(ctx, batch).Close()
+	})
+}
+
+func (r *TemplatesRepo) Get(ctx context.Context, id template.Id) (*template.Template, error) {
+	out, err := r.query(ctx, `WHERE id = $1`, id.UUID())
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, template.ErrNotFound
+	}
+	return out[0], nil
+}
+
+func (r *TemplatesRepo) ListVisible(ctx context.Context, ownerId shared.UserId) ([]*template.Template, error) {
+	return r.query(ctx, `WHERE owner_id IS NULL OR owner_id = $1`, ownerId.UUID())
+}
+
+func (r *TemplatesRepo) Delete(ctx context.Context, id template.Id) error {
+	db := r.txm.DB(ctx)
+
+	ct, err := db.Exec(ctx, `DELETE FROM board_templates WHERE id = $1`, id.UUID())
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return template.ErrNotFound
+	}
+	return nil
+}
+
+type templateRow struct {
+	id          uuid.UUID
+	ownerId     uuid.NullUUID
+	name        string
+	description string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// query читает шаблоны, а затем их колонки и метки двумя запросами.
+// Встроенные шаблоны идут первыми.
+func (r *TemplatesRepo) query(ctx context.Context, where string, args ...any) ([]*template.Template, error) {
+	db := r.txm.DB(ctx)
+
+	rows, err := db.Query(ctx, `
+		SELECT id, owner_id, name, description, created_at, updated_at
+		FROM board_templates
+		`+where+`
+		ORDER BY owner_id NULLS FIRST, lower(name), id
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var heads []templateRow
+	for rows.Next() {
+		var h templateRow
+		if err := rows.Scan(&h.id, &h.ownerId, &h.name, &h.description, &h.createdAt, &h.updatedAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		heads = append(heads, h)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(heads) == 0 {
+		return []*template.Template{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(heads))
+	for _, h := range heads {
+		ids = append(ids, h.id)
+	}
+
+	columns, err := r.columns(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := r.labels(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*template.Template, 0, len(heads))
+	for _, h := range heads {
+		t, err := rehydrateTemplate(h, columns[h.id], labels[h.id])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+func (r *TemplatesRepo) columns(ctx context.Context, db DB, ids []uuid.UUID) (map[uuid.UUID][]column.Title, error) {
+	rows, err := db.Query(ctx, `
+		SELECT template_id, title
+		FROM board_template_columns
+		WHERE template_id = ANY($1)
+		ORDER BY template_id, position
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[uuid.UUID][]column.Title, len(ids))
+	for rows.Next() {
+		var (
+			templateId uuid.UUID
+			titleRaw   string
+		)
+		if err := rows.Scan(&templateId, &titleRaw); err != nil {
+			return nil, err
+		}
+		title, err := column.NewTitle(titleRaw)
+		if err != nil {
+			return nil, err
+		}
+		out[templateId] = append(out[templateId], title)
+	}
+	return out, rows.Err()
+}
+
+func (r *TemplatesRepo) labels(ctx context.Context, db DB, ids []uuid.UUID) (map[uuid.UUID][]template.LabelSpec, error) {
+	rows, err := db.Query(ctx, `
+		SELECT template_id, name, color
+		FROM board_template_labels
+		WHERE template_id = ANY($1)
+		ORDER BY template_id, lower(name)
+	`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[uuid.UUID][]template.LabelSpec, len(ids))
+	for rows.Next() {
+		var (
+			templateId uuid.UUID
+			nameRaw    string
+			colorRaw   string
+		)
+		if err := rows.Scan(&templateId, &nameRaw, &colorRaw); err != nil {
+			return nil, err
+		}
+		name, err := label.NewName(nameRaw)
+		if err != nil {
+			return nil, err
+		}
+		color, err := label.NewColor(colorRaw)
+		if err != nil {
+			return nil, err
+		}
+		out[templateId] = append(out[templateId], template.LabelSpec{Name: name, Color: color})
+	}
+	return out, rows.Err()
+}
+
+func rehydrateTemplate(h templateRow, columns []column.Title, labels []template.LabelSpec) (*template.Template, error) {
+	id, err := template.IdFromUUID(h.id)
+	if err != nil {
+		return nil, err
+	}
+	// Встроенный шаблон остаётся с нулевым владельцем.
+	var ownerId shared.UserId
+	if h.ownerId.Valid {
+		ownerId, err = shared.UserIdFromUUID(h.ownerId.UUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	name, err := template.NewName(h.name)
+	if err != nil {
+		return nil, err
+	}
+	description, err := template.NewDescription(h.description)
+	if err != nil {
+		return nil, err
+	}
+	return template.Rehydrate(id, ownerId, name, description, columns, labels, h.createdAt, h.updatedAt), nil
+}
@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
+)
+
+// TrashPurgeWorker окончательно удаляет то, что пролежало в корзине дольше retention.
+// Строки уходят одним DELETE (дети — каскадом), файлы вложений чистятся после коммита.
+type TrashPurgeWorker struct {
+	txm          *TxManager
+	blobs        attachment.BlobStore
+	retention    time.Duration
+	pollInterval time.Duration
+	log          *zerolog.Logger
+}
+
+func NewTrashPurgeWorker(
+	txm *TxManager,
+	blobs attachment.BlobStore,
+	retention time.Duration,
+	pollInterval time.Duration,
+	log *zerolog.Logger,
+) *TrashPurgeWorker {
+	return &TrashPurgeWorker{
+		txm:          txm,
+		blobs:        blobs,
+		retention:    retention,
+		pollInterval: pollInterval,
+		log:          log,
+	}
+}
+
+func (w *TrashPurgeWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := w.processOnce(ctx); err != nil {
+			w.log.Err(err).Msg("trash purge worker iteration failed")
+		}
+	}
+}
+
+func (w *TrashPurgeWorker) processOnce(ctx context.Context) error {
+	cutoff := time.Now().UTC().Add(-w.retention)
+
+	var keys []string
+	var boards, columns, tasks int64
+	err := w.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// Дети удалённого родителя попадают в корзину не позже него,
+		// так что задачи старше cutoff покрывают все вложения, которые снесёт каскад.
+		rows, err := tx.Query(ctx, `
+			SELECT a.storage_key
+			FROM attachments a
+			JOIN tasks t ON t.id = a.task_id
+			WHERE t.deleted_at < $1
+		`, cutoff)
+		if err != nil {
+			return err
+		}
+		keys, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+
+		ct, err := tx.Exec(ctx, `DELETE FROM boards WHERE deleted_at < $1`, cutoff)
+		if err != nil {
+			return err
+		}
+		boards = ct.RowsAffected()
+		ct, err = tx.Exec(ctx, `DELETE FROM columns WHERE deleted_at < $1`, cutoff)
+		if err != nil {
+			return err
+		}
+		columns = ct.RowsAffected()
+		ct, err = tx.Exec(ctx, `DELETE FROM tasks WHERE deleted_at < $1`, cutoff)
+		if err != nil {
+			return err
+		}
+		tasks = ct.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// файл без строки в БД никому не нужен, поэтому ошибки только логируем
+	for _, key := range keys {
+		if err := w.blobs.Delete(ctx, key); err != nil {
+			w.log.Warn().Err(err).Str("key", key).Msg("purge attachment blob")
+		}
+	}
+
+	if boards+columns+tasks > 0 {
+		w.log.Info().Int64("boards", boards).Int64("columns", columns).Int64("tasks", tasks).Int("blobs", len(keys)).Msg("trash purged")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+// Запросы подписчиков используются и репозиторием, и outbox: набор адресатов фиксируется вместе с событием.
+const (
+	taskWatchersSQL = `
+		SELECT user_id FROM task_watchers WHERE task_id = $1
+		UNION
+		SELECT w.user_id
+		FROM board_watchers w
+		JOIN columns c ON c.board_id = w.board_id
+		JOIN tasks t ON t.column_id = c.id
+		WHERE t.id = $1
+		ORDER BY user_id
+	`
+	columnWatchersSQL = `
+		SELECT w.user_id
+		FROM board_watchers w
+		JOIN columns c ON c.board_id = w.board_id
+		WHERE c.id = $1
+		ORDER BY w.user_id
+	`
+	boardWatchersSQL = `
+		SELECT user_id FROM board_watchers WHERE board_id = $1 ORDER BY user_id
+	`
+)
+
+type WatchersRepo struct {
+	txm *TxManager
+	log *zerolog.Logger
+}
+
+func NewWatchersRepo(txm *TxManager, log *zerolog.Logger) *WatchersRepo {
+	return &WatchersRepo{txm: txm, log: log}
+}
+
+func (r *WatchersRepo) WatchTask(ctx context.Context, taskId task.Id, userId shared.UserId) error {
+	return r.exec(ctx, `
+		INSERT INTO task_watchers (task_id, user_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, taskId.UUID(), userId.UUID())
+}
+
+func (r *WatchersRepo) UnwatchTask(ctx context.Context, taskId task.Id, userId shared.UserId) error {
+	return r.exec(ctx, `DELETE FROM task_watchers WHERE task_id = $1 AND user_id = $2`, taskId.UUID(), userId.UUID())
+}
+
+func (r *WatchersRepo) WatchBoard(ctx context.Context, boardId board.Id, userId shared.UserId) error {
+	return r.exec(ctx, `
+		INSERT INTO board_watchers (board_id, user_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, boardId.UUID(), userId.UUID())
+}
+
+func (r *WatchersRepo) UnwatchBoard(ctx context.Context, boardId board.Id, userId shared.UserId) error {
+	return r.exec(ctx, `DELETE FROM board_watchers WHERE board_id = $1 AND user_id = $2`, boardId.UUID(), userId.UUID())
+}
+
+func (r *WatchersRepo) ListByTask(ctx context.Context, taskId task.Id) ([]shared.UserId, error) {
+	ids, err := queryWatchers(ctx, r.txm.DB(ctx), taskWatchersSQL, taskId.UUID())
+	if err != nil {
+		return nil, err
+	}
+	return toUserIds(ids)
+}
+
+func (r *WatchersRepo) ListByBoard(ctx context.Context, boardId board.Id) ([]shared.UserId, error) {
+	ids, err := queryWatchers(ctx, r.txm.DB(ctx), boardWatchersSQL, boardId.UUID())
+	if err != nil {
+		return nil, err
+	}
+	return toUserIds(ids)
+}
+
+func (r *WatchersRepo) exec(ctx context.Context, sql string, args ...any) error {
+	return r.txm.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, sql, args...)
+		return err
+	})
+}
+
+func queryWatchers(ctx context.Context, db DB, sql string, id uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := db.Query(ctx, sql, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var uid uuid.UUID
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		out = append(out, uid)
+	}
+	return out, rows.Err()
+}
+
+func toUserIds(ids []uuid.UUID) ([]shared.UserId, error) {
+	out := make([]shared.UserId, 0, len(ids))
+	for _, raw := range ids {
+		uid, err := shared.UserIdFromUUID(raw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, uid)
+	}
+	return out, nil
+}
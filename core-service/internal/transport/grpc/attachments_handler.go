@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	attachmentdo "github.com/smarrog/task-board/core-service/internal/domain/attachment"
+	attachmentuc "github.com/smarrog/task-board/core-service/internal/usecase/attachment"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AttachmentsHandler struct {
+	v1.UnimplementedAttachmentsServiceServer
+
+	log *zerolog.Logger
+
+	upload   *attachmentuc.UploadAttachmentUseCase
+	list     *attachmentuc.ListAttachmentsUseCase
+	download *attachmentuc.DownloadAttachmentUseCase
+	remove   *attachmentuc.DeleteAttachmentUseCase
+}
+
+func NewAttachmentsHandler(
+	log *zerolog.Logger,
+	upload *attachmentuc.UploadAttachmentUseCase,
+	list *attachmentuc.ListAttachmentsUseCase,
+	download *attachmentuc.DownloadAttachmentUseCase,
+	remove *attachmentuc.DeleteAttachmentUseCase,
+) *AttachmentsHandler {
+	return &AttachmentsHandler{
+		log:      log,
+		upload:   upload,
+		list:     list,
+		download: download,
+		remove:   remove,
+	}
+}
+
+func (h *AttachmentsHandler) UploadAttachment(ctx context.Context, req *v1.UploadAttachmentRequest) (*v1.UploadAttachmentResponse, error) {
+	input := attachmentuc.UploadAttachmentInput{
+		TaskId:      req.GetTaskId(),
+		UploaderId:  req.GetBase().GetRequesterId(),
+		FileName:    req.GetFileName(),
+		ContentType: req.GetContentType(),
+		Content:     req.GetContent(),
+	}
+
+	output, err := h.upload.Execute(ctx, input)
+	if err != nil {
+		return nil, mapAttachmentsErr(err)
+	}
+
+	return &v1.UploadAttachmentResponse{
+		Attachment: toProtoAttachment(output.Attachment),
+	}, nil
+}
+
+func (h *AttachmentsHandler) ListAttachments(ctx context.Context, req *v1.ListAttachmentsRequest) (*v1.ListAttachmentsResponse, error) {
+	input := attachmentuc.ListAttachmentsInput{
+		TaskId: req.GetTaskId(),
+	}
+
+	output, err := h.list.Execute(ctx, input)
+	if err != nil {
+		return nil, mapAttachmentsErr(err)
+	}
+
+	items := make([]*v1.Attachment, 0, len(output.Attachments))
+	for _, a := range output.Attachments {
+		items = append(items, toProtoAttachment(a))
+	}
+
+	return &v1.ListAttachmentsResponse{
+		Attachments: items,
+	}, nil
+}
+
+func (h *AttachmentsHandler) DownloadAttachment(ctx context.Context, req *v1.DownloadAttachmentRequest) (*v1.DownloadAttachmentResponse, error) {
+	input := attachmentuc.DownloadAttachmentInput{
+		AttachmentId: req.GetAttachmentId(),
+	}
+
+	output, err := h.download.Execute(ctx, input)
+	if err != nil {
+		return nil, mapAttachmentsErr(err)
+	}
+
+	return &v1.DownloadAttachmentResponse{
+		Attachment: toProtoAttachment(output.Attachment),
+		Content:    output.Content,
+	}, nil
+}
+
+func (h *AttachmentsHandler) DeleteAttachment(ctx context.Context, req *v1.DeleteAttachmentRequest) (*v1.DeleteAttachmentResponse, error) {
+	input := attachmentuc.DeleteAttachmentInput{
+		AttachmentId: req.GetAttachmentId(),
+		RequesterId:  req.GetBase().GetRequesterId(),
+	}
+
+	if _, err := h.remove.Execute(ctx, input); err != nil {
+		return nil, mapAttachmentsErr(err)
+	}
+
+	return &v1.DeleteAttachmentResponse{}, nil
+}
+
+func toProtoAttachment(a *attachmentdo.Attachment) *v1.Attachment {
+	return &v1.Attachment{
+		Id:          a.Id().String(),
+		TaskId:      a.TaskId().String(),
+		UploaderId:  a.UploaderId().String(),
+		FileName:    a.FileName().String(),
+		ContentType: a.ContentType().String(),
+		Size:        a.Size(),
+		CreatedAt:   timeToPb(a.CreatedAt()),
+	}
+}
+
+func mapAttachmentsErr(err error) error {
+	switch {
+	case errors.Is(err, attachmentdo.ErrUploaderMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return mapCommonErr(err)
+	}
+}
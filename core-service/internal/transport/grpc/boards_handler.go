@@ -6,8 +6,6 @@ import (
 
 	"github.com/rs/zerolog"
 	boarddo "github.com/smarrog/task-board/core-service/internal/domain/board"
-	"github.com/smarrog/task-board/core-service/internal/domain/column"
-	"github.com/smarrog/task-board/core-service/internal/domain/task"
 	boarduc "github.com/smarrog/task-board/core-service/internal/usecase/board"
 	"github.com/smarrog/task-board/shared/proto/base/v1"
 	"google.golang.org/grpc/codes"
@@ -19,11 +17,16 @@ type BoardsHandler struct {
 
 	log *zerolog.Logger
 
-	createBoard *boarduc.CreateBoardUseCase
-	getBoard    *boarduc.GetBoardUseCase
-	listBoards  *boarduc.ListBoardsUseCase
-	updateBoard *boarduc.UpdateBoardUseCase
-	deleteBoard *boarduc.DeleteBoardUseCase
+	createBoard  *boarduc.CreateBoardUseCase
+	getBoard     *boarduc.GetBoardUseCase
+	listBoards   *boarduc.ListBoardsUseCase
+	updateBoard  *boarduc.UpdateBoardUseCase
+	deleteBoard  *boarduc.DeleteBoardUseCase
+	restoreBoard *boarduc.RestoreBoardUseCase
+	listTrash    *boarduc.ListTrashUseCase
+	archive      *boarduc.ArchiveBoardUseCase
+	unarchive    *boarduc.UnarchiveBoardUseCase
+	duplicate    *boarduc.DuplicateBoardUseCase
 }
 
 func NewBoardsHandler(
@@ -33,14 +36,24 @@ func NewBoardsHandler(
 	listBoards *boarduc.ListBoardsUseCase,
 	updateBoard *boarduc.UpdateBoardUseCase,
 	deleteBoard *boarduc.DeleteBoardUseCase,
+	restoreBoard *boarduc.RestoreBoardUseCase,
+	listTrash *boarduc.ListTrashUseCase,
+	archive *boarduc.ArchiveBoardUseCase,
+	unarchive *boarduc.UnarchiveBoardUseCase,
+	duplicate *boarduc.DuplicateBoardUseCase,
 ) *BoardsHandler {
 	return &BoardsHandler{
-		log:         log,
-		createBoard: createBoard,
-		getBoard:    getBoard,
-		listBoards:  listBoards,
-		updateBoard: updateBoard,
-		deleteBoard: deleteBoard,
+		log:          log,
+		createBoard:  createBoard,
+		getBoard:     getBoard,
+		listBoards:   listBoards,
+		updateBoard:  updateBoard,
+		deleteBoard:  deleteBoard,
+		restoreBoard: restoreBoard,
+		listTrash:    listTrash,
+		archive:      archive,
+		unarchive:    unarchive,
+		duplicate:    duplicate,
 	}
 }
 
@@ -49,35 +62,48 @@ func (h *BoardsHandler) CreateBoard(ctx context.Context, req *v1.CreateBoardRequ
 		OwnerId:     req.OwnerId,
 		Title:       req.Title,
 		Description: req.Description,
+		TemplateId:  req.GetTemplateId(),
 	}
 	output, err := h.createBoard.Execute(ctx, input)
 	if err != nil {
 		return nil, mapBoardsErr(err)
 	}
 
+	columns := make([]*v1.ColumnFull, 0, len(output.Columns))
+	for _, c := range output.Columns {
+		columns = append(columns, &v1.ColumnFull{Column: toProtoColumn(c)})
+	}
+
 	return &v1.CreateBoardResponse{
 		Data: &v1.BoardFull{
 			Board:   toProtoBoard(output.Board),
-			Columns: []*v1.ColumnFull{},
+			Columns: columns,
+			Labels:  toProtoLabels(output.Labels),
 		},
 	}, nil
 }
 
 func (h *BoardsHandler) GetBoard(ctx context.Context, req *v1.GetBoardRequest) (*v1.GetBoardResponse, error) {
-	input := boarduc.GetBoardInput{BoardId: req.GetBoardId()}
+	input := boarduc.GetBoardInput{BoardId: req.GetBoardId(), LabelIds: req.GetLabelIds()}
 	output, err := h.getBoard.Execute(ctx, input)
 	if err != nil {
 		return nil, mapBoardsErr(err)
 	}
 
 	return &v1.GetBoardResponse{
-		Data: toProtoBoardFull(output.Board, output.Columns, output.Tasks),
+		Data: toProtoBoardFull(output),
 	}, nil
 }
 
 func (h *BoardsHandler) ListBoards(ctx context.Context, req *v1.ListBoardsRequest) (*v1.ListBoardsResponse, error) {
 	input := boarduc.ListBoardsInput{
-		OwnerId: req.GetOwnerId(),
+		OwnerId:         req.GetOwnerId(),
+		PageSize:        int(req.GetPageSize()),
+		Cursor:          req.GetCursor(),
+		Sort:            req.GetSort(),
+		Search:          req.GetSearch(),
+		Summary:         req.GetSummary(),
+		IncludeArchived: req.GetIncludeArchived(),
 	}
 	output, err := h.listBoards.Execute(ctx, input)
 	if err != nil {
@@ -86,18 +112,20 @@ func (h *BoardsHandler) ListBoards(ctx context.Context, req *v1.ListBoardsReques
 
 	full := make([]*v1.BoardFull, 0, len(output.Items))
 	for _, it := range output.Items {
-		full = append(full, toProtoBoardFull(it.Board, it.Columns, it.Tasks))
+		full = append(full, toProtoBoardFull(it))
 	}
 
-	return &v1.ListBoardsResponse{Boards: full}, nil
+	return &v1.ListBoardsResponse{Boards: full, NextCursor: output.NextCursor}, nil
 }
 
 func (h *BoardsHandler) UpdateBoard(ctx context.Context, req *v1.UpdateBoardRequest) (*v1.UpdateBoardResponse, error) {
 	input := boarduc.UpdateBoardInput{
-		OwnerId:     req.GetOwnerId(),
-		BoardId:     req.GetBoardId(),
-		Title:       req.GetTitle(),
-		Description: req.GetDescription(),
+		OwnerId:         req.GetOwnerId(),
+		BoardId:         req.GetBoardId(),
+		Title:           req.GetTitle(),
+		Description:     req.GetDescription(),
+		ExpectedVersion: req.GetExpectedVersion(),
+		UpdateMask:      req.GetUpdateMask().GetPaths(),
 	}
 	output, err := h.updateBoard.Execute(ctx, input)
 	if err != nil {
@@ -109,7 +137,7 @@ func (h *BoardsHandler) UpdateBoard(ctx context.Context, req *v1.UpdateBoardRequ
 	if e != nil {
 		return nil, mapBoardsErr(e)
 	}
-	return &v1.UpdateBoardResponse{Data: toProtoBoardFull(fo.Board, fo.Columns, fo.Tasks)}, nil
+	return &v1.UpdateBoardResponse{Data: toProtoBoardFull(fo)}, nil
 }
 
 func (h *BoardsHandler) DeleteBoard(ctx context.Context, req *v1.DeleteBoardRequest) (*v1.DeleteBoardResponse, error) {
@@ -124,27 +152,104 @@ func (h *BoardsHandler) DeleteBoard(ctx context.Context, req *v1.DeleteBoardRequ
 	return &v1.DeleteBoardResponse{}, nil
 }
 
-func toProtoBoardFull(b *boarddo.Board, cols []*column.Column, ts []*task.Task) *v1.BoardFull {
+func (h *BoardsHandler) RestoreBoard(ctx context.Context, req *v1.RestoreBoardRequest) (*v1.RestoreBoardResponse, error) {
+	output, err := h.restoreBoard.Execute(ctx, boarduc.RestoreBoardInput{BoardId: req.GetBoardId()})
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+
+	fo, err := h.getBoard.Execute(ctx, boarduc.GetBoardInput{BoardId: output.Board.Id().String()})
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+	return &v1.RestoreBoardResponse{Data: toProtoBoardFull(fo)}, nil
+}
+
+func (h *BoardsHandler) ListTrash(ctx context.Context, req *v1.ListTrashRequest) (*v1.ListTrashResponse, error) {
+	output, err := h.listTrash.Execute(ctx, boarduc.ListTrashInput{BoardId: req.GetBoardId()})
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+
+	resp := &v1.ListTrashResponse{
+		Columns: make([]*v1.TrashedColumn, 0, len(output.Columns)),
+		Tasks:   make([]*v1.TrashedTask, 0, len(output.Tasks)),
+	}
+	if output.Board != nil {
+		resp.BoardDeletedAt = timeToPb(output.Board.DeletedAt)
+	}
+	for _, c := range output.Columns {
+		resp.Columns = append(resp.Columns, &v1.TrashedColumn{Column: toProtoColumn(c.Column), DeletedAt: timeToPb(c.DeletedAt)})
+	}
+	for _, t := range output.Tasks {
+		resp.Tasks = append(resp.Tasks, &v1.TrashedTask{Task: toProtoTask(t.Task), DeletedAt: timeToPb(t.DeletedAt)})
+	}
+	return resp, nil
+}
+
+func (h *BoardsHandler) ArchiveBoard(ctx context.Context, req *v1.ArchiveBoardRequest) (*v1.ArchiveBoardResponse, error) {
+	output, err := h.archive.Execute(ctx, boarduc.ArchiveBoardInput{BoardId: req.GetBoardId()})
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+
+	fo, err := h.getBoard.Execute(ctx, boarduc.GetBoardInput{BoardId: output.Board.Id().String()})
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+	return &v1.ArchiveBoardResponse{Data: toProtoBoardFull(fo)}, nil
+}
+
+func (h *BoardsHandler) UnarchiveBoard(ctx context.Context, req *v1.UnarchiveBoardRequest) (*v1.UnarchiveBoardResponse, error) {
+	output, err := h.unarchive.Execute(ctx, boarduc.UnarchiveBoardInput{BoardId: req.GetBoardId()})
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+
+	fo, err := h.getBoard.Execute(ctx, boarduc.GetBoardInput{BoardId: output.Board.Id().String()})
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+	return &v1.UnarchiveBoardResponse{Data: toProtoBoardFull(fo)}, nil
+}
+
+func (h *BoardsHandler) DuplicateBoard(ctx context.Context, req *v1.DuplicateBoardRequest) (*v1.DuplicateBoardResponse, error) {
+	input := boarduc.DuplicateBoardInput{
+		OwnerId:      req.GetOwnerId(),
+		BoardId:      req.GetBoardId(),
+		Title:        req.GetTitle(),
+		IncludeTasks: req.GetIncludeTasks(),
+	}
+	output, err := h.duplicate.Execute(ctx, input)
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+
+	fo, err := h.getBoard.Execute(ctx, boarduc.GetBoardInput{BoardId: output.Board.Id().String()})
+	if err != nil {
+		return nil, mapBoardsErr(err)
+	}
+	return &v1.DuplicateBoardResponse{Data: toProtoBoardFull(fo)}, nil
+}
+
+func toProtoBoardFull(out *boarduc.GetBoardOutput) *v1.BoardFull {
 	tasksByColumn := make(map[string][]*v1.Task)
-	for _, t := range ts {
+	for _, t := range out.Tasks {
+		pt := toProtoTask(t)
+		if p, ok := out.Checklists[t.Id()]; ok {
+			pt.Checklist = &v1.ChecklistProgress{Done: int32(p.Done), Total: int32(p.Total)}
+		}
+		pt.AttachmentsCount = int32(out.Attachments[t.Id()])
 		colID := t.ColumnId().String()
-		tasksByColumn[colID] = append(tasksByColumn[colID], &v1.Task{
-			Id:          t.Id().String(),
-			ColumnId:    t.ColumnId().String(),
-			Position:    int32(t.Position()),
-			Title:       t.Title().String(),
-			Description: t.Description().String(),
-			AssigneeId:  t.AssigneeId().String(),
-		})
+		tasksByColumn[colID] = append(tasksByColumn[colID], pt)
 	}
 
-	colWithTasks := make([]*v1.ColumnFull, 0, len(cols))
-	for _, c := range cols {
-		pc := &v1.Column{Id: c.Id().String(), BoardId: c.BoardId().String(), Position: int32(c.Position())}
-		colWithTasks = append(colWithTasks, &v1.ColumnFull{Column: pc, Tasks: tasksByColumn[c.Id().String()]})
+	colWithTasks := make([]*v1.ColumnFull, 0, len(out.Columns))
+	for _, c := range out.Columns {
+		colWithTasks = append(colWithTasks, &v1.ColumnFull{Column: toProtoColumn(c), Tasks: tasksByColumn[c.Id().String()], Stats: toProtoColumnStats(out.Stats[c.Id()])})
 	}
 
-	return &v1.BoardFull{Board: toProtoBoard(b), Columns: colWithTasks}
+	return &v1.BoardFull{Board: toProtoBoard(out.Board), Columns: colWithTasks, Labels: toProtoLabels(out.Labels)}
 }
 
 func toProtoBoard(b *boarddo.Board) *v1.Board {
@@ -153,6 +258,8 @@ func toProtoBoard(b *boarddo.Board) *v1.Board {
 		OwnerId:     b.OwnerId().String(),
 		Title:       b.Title().String(),
 		Description: b.Description().String(),
+		ArchivedAt:  timeToPb(b.ArchivedAt()),
+		Version:     b.Version(),
 	}
 }
 
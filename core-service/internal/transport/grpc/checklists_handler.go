@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	checklistdo "github.com/smarrog/task-board/core-service/internal/domain/checklist"
+	checklistuc "github.com/smarrog/task-board/core-service/internal/usecase/checklist"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+)
+
+type ChecklistsHandler struct {
+	v1.UnimplementedChecklistsServiceServer
+
+	log *zerolog.Logger
+
+	createItem  *checklistuc.CreateItemUseCase
+	listItems   *checklistuc.ListItemsUseCase
+	updateItem  *checklistuc.UpdateItemUseCase
+	setItemDone *checklistuc.SetItemDoneUseCase
+	moveItem    *checklistuc.MoveItemUseCase
+	deleteItem  *checklistuc.DeleteItemUseCase
+}
+
+func NewChecklistsHandler(
+	log *zerolog.Logger,
+	createItem *checklistuc.CreateItemUseCase,
+	listItems *checklistuc.ListItemsUseCase,
+	updateItem *checklistuc.UpdateItemUseCase,
+	setItemDone *checklistuc.SetItemDoneUseCase,
+	moveItem *checklistuc.MoveItemUseCase,
+	deleteItem *checklistuc.DeleteItemUseCase,
+) *ChecklistsHandler {
+	return &ChecklistsHandler{
+		log:         log,
+		createItem:  createItem,
+		listItems:   listItems,
+		updateItem:  updateItem,
+		setItemDone: setItemDone,
+		moveItem:    moveItem,
+		deleteItem:  deleteItem,
+	}
+}
+
+func (h *ChecklistsHandler) CreateChecklistItem(ctx context.Context, req *v1.CreateChecklistItemRequest) (*v1.CreateChecklistItemResponse, error) {
+	input := checklistuc.CreateItemInput{
+		TaskId:   req.GetTaskId(),
+		Text:     req.GetText(),
+		Position: int(req.GetPosition()),
+	}
+
+	output, err := h.createItem.Execute(ctx, input)
+	if err != nil {
+		return nil, mapChecklistsErr(err)
+	}
+
+	return &v1.CreateChecklistItemResponse{
+		Item: toProtoChecklistItem(output.Item),
+	}, nil
+}
+
+func (h *ChecklistsHandler) ListChecklistItems(ctx context.Context, req *v1.ListChecklistItemsRequest) (*v1.ListChecklistItemsResponse, error) {
+	input := checklistuc.ListItemsInput{
+		TaskId: req.GetTaskId(),
+	}
+
+	output, err := h.listItems.Execute(ctx, input)
+	if err != nil {
+		return nil, mapChecklistsErr(err)
+	}
+
+	items := make([]*v1.ChecklistItem, 0, len(output.Items))
+	for _, i := range output.Items {
+		items = append(items, toProtoChecklistItem(i))
+	}
+
+	return &v1.ListChecklistItemsResponse{
+		Items: items,
+		Progress: &v1.ChecklistProgress{
+			Done:  int32(output.Progress.Done),
+			Total: int32(output.Progress.Total),
+		},
+	}, nil
+}
+
+func (h *ChecklistsHandler) UpdateChecklistItem(ctx context.Context, req *v1.UpdateChecklistItemRequest) (*v1.UpdateChecklistItemResponse, error) {
+	input := checklistuc.UpdateItemInput{
+		ItemId: req.GetItemId(),
+		Text:   req.GetText(),
+	}
+
+	output, err := h.updateItem.Execute(ctx, input)
+	if err != nil {
+		return nil, mapChecklistsErr(err)
+	}
+
+	return &v1.UpdateChecklistItemResponse{
+		Item: toProtoChecklistItem(output.Item),
+	}, nil
+}
+
+func (h *ChecklistsHandler) SetChecklistItemDone(ctx context.Context, req *v1.SetChecklistItemDoneRequest) (*v1.SetChecklistItemDoneResponse, error) {
+	input := checklistuc.SetItemDoneInput{
+		ItemId: req.GetItemId(),
+		Done:   req.GetDone(),
+	}
+
+	output, err := h.setItemDone.Execute(ctx, input)
+	if err != nil {
+		return nil, mapChecklistsErr(err)
+	}
+
+	return &v1.SetChecklistItemDoneResponse{
+		Item: toProtoChecklistItem(output.Item),
+	}, nil
+}
+
+func (h *ChecklistsHandler) MoveChecklistItem(ctx context.Context, req *v1.MoveChecklistItemRequest) (*v1.MoveChecklistItemResponse, error) {
+	input := checklistuc.MoveItemInput{
+		ItemId:     req.GetItemId(),
+		ToPosition: int(req.GetToPosition()),
+	}
+
+	output, err := h.moveItem.Execute(ctx, input)
+	if err != nil {
+		return nil, mapChecklistsErr(err)
+	}
+
+	return &v1.MoveChecklistItemResponse{
+		Item: toProtoChecklistItem(output.Item),
+	}, nil
+}
+
+func (h *ChecklistsHandler) DeleteChecklistItem(ctx context.Context, req *v1.DeleteChecklistItemRequest) (*v1.DeleteChecklistItemResponse, error) {
+	input := checklistuc.DeleteItemInput{
+		ItemId: req.GetItemId(),
+	}
+
+	_, err := h.deleteItem.Execute(ctx, input)
+	if err != nil {
+		return nil, mapChecklistsErr(err)
+	}
+
+	return &v1.DeleteChecklistItemResponse{}, nil
+}
+
+func toProtoChecklistItem(i *checklistdo.Item) *v1.ChecklistItem {
+	return &v1.ChecklistItem{
+		Id:       i.Id().String(),
+		TaskId:   i.TaskId().String(),
+		Position: int32(i.Position().Int()),
+		Text:     i.Text().String(),
+		Done:     i.Done(),
+	}
+}
+
+func mapChecklistsErr(err error) error {
+	switch {
+	default:
+		return mapCommonErr(err)
+	}
+}
@@ -2,11 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rs/zerolog"
+	boarddo "github.com/smarrog/task-board/core-service/internal/domain/board"
 	columdo "github.com/smarrog/task-board/core-service/internal/domain/column"
 	columnuc "github.com/smarrog/task-board/core-service/internal/usecase/column"
 	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ColumnsHandler struct {
@@ -14,10 +18,12 @@ type ColumnsHandler struct {
 
 	log *zerolog.Logger
 
-	createColumn *columnuc.CreateColumnUseCase
-	getColumn    *columnuc.GetColumnUseCase
-	moveColumn   *columnuc.MoveColumnUseCase
-	deleteColumn *columnuc.DeleteColumnUseCase
+	createColumn   *columnuc.CreateColumnUseCase
+	getColumn      *columnuc.GetColumnUseCase
+	moveColumn     *columnuc.MoveColumnUseCase
+	reorderColumns *columnuc.ReorderColumnsUseCase
+	deleteColumn   *columnuc.DeleteColumnUseCase
+	restoreColumn  *columnuc.RestoreColumnUseCase
 }
 
 func NewColumnsHandler(
@@ -25,14 +31,18 @@ func NewColumnsHandler(
 	createColumn *columnuc.CreateColumnUseCase,
 	getColumn *columnuc.GetColumnUseCase,
 	moveColumn *columnuc.MoveColumnUseCase,
+	reorderColumns *columnuc.ReorderColumnsUseCase,
 	deleteColumn *columnuc.DeleteColumnUseCase,
+	restoreColumn *columnuc.RestoreColumnUseCase,
 ) *ColumnsHandler {
 	return &ColumnsHandler{
-		log:          log,
-		createColumn: createColumn,
-		getColumn:    getColumn,
-		moveColumn:   moveColumn,
-		deleteColumn: deleteColumn,
+		log:            log,
+		createColumn:   createColumn,
+		getColumn:      getColumn,
+		moveColumn:     moveColumn,
+		reorderColumns: reorderColumns,
+		deleteColumn:   deleteColumn,
+		restoreColumn:  restoreColumn,
 	}
 }
 
@@ -40,6 +50,7 @@ func (h *ColumnsHandler) CreateColumn(ctx context.Context, req *v1.CreateColumnR
 	input := columnuc.CreateColumnInput{
 		BoardId:  req.BoardId,
 		Position: int(req.Position),
+		Title:    req.GetTitle(),
 	}
 
 	output, err := h.createColumn.Execute(ctx, input)
@@ -60,23 +71,18 @@ func (h *ColumnsHandler) GetColumnFull(ctx context.Context, req *v1.GetColumnFul
 		return nil, mapColumnsErr(err)
 	}
 
-	tasks := make([]*v1.Task, 0, len(output.Tasks))
-	for _, t := range output.Tasks {
-		tasks = append(tasks, toProtoTask(t))
-	}
-
 	return &v1.GetColumnFullResponse{
-		Data: &v1.ColumnFull{
-			Column: toProtoColumn(output.Column),
-			Tasks:  tasks,
-		},
+		Data: toProtoColumnFull(output),
 	}, nil
 }
 
 func (h *ColumnsHandler) MoveColumn(ctx context.Context, req *v1.MoveColumnRequest) (*v1.MoveColumnResponse, error) {
 	input := columnuc.MoveColumnInput{
-		ColumnId:   req.ColumnId,
-		ToPosition: int(req.ToPosition),
+		RequesterId:     req.GetBase().GetRequesterId(),
+		ColumnId:        req.ColumnId,
+		ToBoardId:       req.GetToBoardId(),
+		ToPosition:      int(req.ToPosition),
+		ExpectedVersion: req.GetExpectedVersion(),
 	}
 
 	output, err := h.moveColumn.Execute(ctx, input)
@@ -89,6 +95,24 @@ func (h *ColumnsHandler) MoveColumn(ctx context.Context, req *v1.MoveColumnReque
 	}, nil
 }
 
+func (h *ColumnsHandler) ReorderColumns(ctx context.Context, req *v1.ReorderColumnsRequest) (*v1.ReorderColumnsResponse, error) {
+	input := columnuc.ReorderColumnsInput{
+		BoardId:   req.GetBoardId(),
+		ColumnIds: req.GetColumnIds(),
+	}
+
+	output, err := h.reorderColumns.Execute(ctx, input)
+	if err != nil {
+		return nil, mapColumnsErr(err)
+	}
+
+	columns := make([]*v1.Column, 0, len(output.Columns))
+	for _, c := range output.Columns {
+		columns = append(columns, toProtoColumn(c))
+	}
+	return &v1.ReorderColumnsResponse{Columns: columns}, nil
+}
+
 func (h *ColumnsHandler) DeleteColumn(ctx context.Context, req *v1.DeleteColumnRequest) (*v1.DeleteColumnResponse, error) {
 	input := columnuc.DeleteColumnInput{
 		ColumnId: req.ColumnId,
@@ -102,16 +126,47 @@ func (h *ColumnsHandler) DeleteColumn(ctx context.Context, req *v1.DeleteColumnR
 	return &v1.DeleteColumnResponse{}, nil
 }
 
+func (h *ColumnsHandler) RestoreColumn(ctx context.Context, req *v1.RestoreColumnRequest) (*v1.RestoreColumnResponse, error) {
+	output, err := h.restoreColumn.Execute(ctx, columnuc.RestoreColumnInput{ColumnId: req.GetColumnId()})
+	if err != nil {
+		return nil, mapColumnsErr(err)
+	}
+
+	// Возвращаем колонку вместе с задачами, восстановленными с ней.
+	full, err := h.getColumn.Execute(ctx, columnuc.GetColumnInput{ColumnId: output.Column.Id().String()})
+	if err != nil {
+		return nil, mapColumnsErr(err)
+	}
+	return &v1.RestoreColumnResponse{Data: toProtoColumnFull(full)}, nil
+}
+
+func toProtoColumnFull(out *columnuc.GetColumnOutput) *v1.ColumnFull {
+	tasks := make([]*v1.Task, 0, len(out.Tasks))
+	for _, t := range out.Tasks {
+		tasks = append(tasks, toProtoTask(t))
+	}
+
+	return &v1.ColumnFull{
+		Column: toProtoColumn(out.Column),
+		Tasks:  tasks,
+		Stats:  toProtoColumnStats(out.Stats),
+	}
+}
+
 func toProtoColumn(c *columdo.Column) *v1.Column {
 	return &v1.Column{
 		Id:       c.Id().String(),
 		BoardId:  c.BoardId().String(),
 		Position: int32(c.Position().Int()),
+		Title:    c.Title().String(),
+		Version:  c.Version(),
 	}
 }
 
 func mapColumnsErr(err error) error {
 	switch {
+	case errors.Is(err, boarddo.ErrOwnerMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
 	default:
 		return mapCommonErr(err)
 	}
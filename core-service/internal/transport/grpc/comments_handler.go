@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	commentdo "github.com/smarrog/task-board/core-service/internal/domain/comment"
+	commentuc "github.com/smarrog/task-board/core-service/internal/usecase/comment"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CommentsHandler struct {
+	v1.UnimplementedCommentsServiceServer
+
+	log *zerolog.Logger
+
+	createComment *commentuc.CreateCommentUseCase
+	listComments  *commentuc.ListCommentsUseCase
+	updateComment *commentuc.UpdateCommentUseCase
+	deleteComment *commentuc.DeleteCommentUseCase
+}
+
+func NewCommentsHandler(
+	log *zerolog.Logger,
+	createComment *commentuc.CreateCommentUseCase,
+	listComments *commentuc.ListCommentsUseCase,
+	updateComment *commentuc.UpdateCommentUseCase,
+	deleteComment *commentuc.DeleteCommentUseCase,
+) *CommentsHandler {
+	return &CommentsHandler{
+		log:           log,
+		createComment: createComment,
+		listComments:  listComments,
+		updateComment: updateComment,
+		deleteComment: deleteComment,
+	}
+}
+
+func (h *CommentsHandler) CreateComment(ctx context.Context, req *v1.CreateCommentRequest) (*v1.CreateCommentResponse, error) {
+	input := commentuc.CreateCommentInput{
+		TaskId:   req.GetTaskId(),
+		AuthorId: req.GetBase().GetRequesterId(),
+		Body:     req.GetBody(),
+	}
+
+	output, err := h.createComment.Execute(ctx, input)
+	if err != nil {
+		return nil, mapCommentsErr(err)
+	}
+
+	return &v1.CreateCommentResponse{
+		Comment: toProtoComment(output.Comment),
+	}, nil
+}
+
+func (h *CommentsHandler) ListComments(ctx context.Context, req *v1.ListCommentsRequest) (*v1.ListCommentsResponse, error) {
+	input := commentuc.ListCommentsInput{
+		TaskId:   req.GetTaskId(),
+		PageSize: int(req.GetPageSize()),
+		Cursor:   req.GetCursor(),
+	}
+
+	output, err := h.listComments.Execute(ctx, input)
+	if err != nil {
+		return nil, mapCommentsErr(err)
+	}
+
+	comments := make([]*v1.Comment, 0, len(output.Comments))
+	for _, c := range output.Comments {
+		comments = append(comments, toProtoComment(c))
+	}
+
+	return &v1.ListCommentsResponse{
+		Comments:   comments,
+		NextCursor: output.NextCursor,
+	}, nil
+}
+
+func (h *CommentsHandler) UpdateComment(ctx context.Context, req *v1.UpdateCommentRequest) (*v1.UpdateCommentResponse, error) {
+	input := commentuc.UpdateCommentInput{
+		CommentId: req.GetCommentId(),
+		EditorId:  req.GetBase().GetRequesterId(),
+		Body:      req.GetBody(),
+	}
+
+	output, err := h.updateComment.Execute(ctx, input)
+	if err != nil {
+		return nil, mapCommentsErr(err)
+	}
+
+	return &v1.UpdateCommentResponse{
+		Comment: toProtoComment(output.Comment),
+	}, nil
+}
+
+func (h *CommentsHandler) DeleteComment(ctx context.Context, req *v1.DeleteCommentRequest) (*v1.DeleteCommentResponse, error) {
+	input := commentuc.DeleteCommentInput{
+		CommentId:   req.GetCommentId(),
+		RequesterId: req.GetBase().GetRequesterId(),
+	}
+
+	if _, err := h.deleteComment.Execute(ctx, input); err != nil {
+		return nil, mapCommentsErr(err)
+	}
+
+	return &v1.DeleteCommentResponse{}, nil
+}
+
+func toProtoComment(c *commentdo.Comment) *v1.Comment {
+	return &v1.Comment{
+		Id:         c.Id().String(),
+		TaskId:     c.TaskId().String(),
+		AuthorId:   c.AuthorId().String(),
+		Body:       c.Body().String(),
+		MentionIds: userIdsToStrings(c.Mentions()),
+		CreatedAt:  timeToPb(c.CreatedAt()),
+		UpdatedAt:  timeToPb(c.UpdatedAt()),
+	}
+}
+
+func mapCommentsErr(err error) error {
+	switch {
+	case errors.Is(err, commentdo.ErrAuthorMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return mapCommonErr(err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/smarrog/task-board/shared/domain/shared"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,7 +24,29 @@ func mapCommonErr(err error) error {
 		errors.Is(err, shared.ErrIsMismatch):
 		return status.Error(codes.InvalidArgument, err.Error())
 
+	case errors.Is(err, shared.ErrIsDeleted),
+		errors.Is(err, shared.ErrIsArchived),
+		errors.Is(err, shared.ErrIsNotArchived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case errors.Is(err, shared.ErrIsStale):
+		return staleVersionStatus(err)
+	case errors.Is(err, shared.ErrIsConflict):
+		return status.Error(codes.Aborted, err.Error())
+
 	default:
 		return status.Error(codes.Internal, "internal error")
 	}
 }
+
+// staleVersionStatus — конфликт версий, о котором клиент предупредил заранее (If-Match):
+// деталь PreconditionFailure отличает его для шлюза от гонки двух записей.
+func staleVersionStatus(err error) error {
+	st := status.New(codes.Aborted, err.Error())
+	if withDetails, e := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{Type: "VERSION", Description: err.Error()}},
+	}); e == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
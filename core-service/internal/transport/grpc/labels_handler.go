@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	boarddo "github.com/smarrog/task-board/core-service/internal/domain/board"
+	labeldo "github.com/smarrog/task-board/core-service/internal/domain/label"
+	labeluc "github.com/smarrog/task-board/core-service/internal/usecase/label"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LabelsHandler struct {
+	v1.UnimplementedLabelsServiceServer
+
+	log *zerolog.Logger
+
+	createLabel *labeluc.CreateLabelUseCase
+	listLabels  *labeluc.ListLabelsUseCase
+	updateLabel *labeluc.UpdateLabelUseCase
+	deleteLabel *labeluc.DeleteLabelUseCase
+}
+
+func NewLabelsHandler(
+	log *zerolog.Logger,
+	createLabel *labeluc.CreateLabelUseCase,
+	listLabels *labeluc.ListLabelsUseCase,
+	updateLabel *labeluc.UpdateLabelUseCase,
+	deleteLabel *labeluc.DeleteLabelUseCase,
+) *LabelsHandler {
+	return &LabelsHandler{
+		log:         log,
+		createLabel: createLabel,
+		listLabels:  listLabels,
+		updateLabel: updateLabel,
+		deleteLabel: deleteLabel,
+	}
+}
+
+func (h *LabelsHandler) CreateLabel(ctx context.Context, req *v1.CreateLabelRequest) (*v1.CreateLabelResponse, error) {
+	input := labeluc.CreateLabelInput{
+		RequesterId: req.GetBase().GetRequesterId(),
+		BoardId:     req.GetBoardId(),
+		Name:        req.GetName(),
+		Color:       req.GetColor(),
+	}
+
+	output, err := h.createLabel.Execute(ctx, input)
+	if err != nil {
+		return nil, mapLabelsErr(err)
+	}
+
+	return &v1.CreateLabelResponse{
+		Label: toProtoLabel(output.Label),
+	}, nil
+}
+
+func (h *LabelsHandler) ListLabels(ctx context.Context, req *v1.ListLabelsRequest) (*v1.ListLabelsResponse, error) {
+	input := labeluc.ListLabelsInput{
+		RequesterId: req.GetBase().GetRequesterId(),
+		BoardId:     req.GetBoardId(),
+	}
+
+	output, err := h.listLabels.Execute(ctx, input)
+	if err != nil {
+		return nil, mapLabelsErr(err)
+	}
+
+	return &v1.ListLabelsResponse{
+		Labels: toProtoLabels(output.Labels),
+	}, nil
+}
+
+func (h *LabelsHandler) UpdateLabel(ctx context.Context, req *v1.UpdateLabelRequest) (*v1.UpdateLabelResponse, error) {
+	input := labeluc.UpdateLabelInput{
+		RequesterId: req.GetBase().GetRequesterId(),
+		LabelId:     req.GetLabelId(),
+		Name:        req.GetName(),
+		Color:       req.GetColor(),
+	}
+
+	output, err := h.updateLabel.Execute(ctx, input)
+	if err != nil {
+		return nil, mapLabelsErr(err)
+	}
+
+	return &v1.UpdateLabelResponse{
+		Label: toProtoLabel(output.Label),
+	}, nil
+}
+
+func (h *LabelsHandler) DeleteLabel(ctx context.Context, req *v1.DeleteLabelRequest) (*v1.DeleteLabelResponse, error) {
+	input := labeluc.DeleteLabelInput{
+		RequesterId: req.GetBase().GetRequesterId(),
+		LabelId:     req.GetLabelId(),
+	}
+
+	_, err := h.deleteLabel.Execute(ctx, input)
+	if err != nil {
+		return nil, mapLabelsErr(err)
+	}
+
+	return &v1.DeleteLabelResponse{}, nil
+}
+
+func toProtoLabel(l *labeldo.Label) *v1.Label {
+	return &v1.Label{
+		Id:      l.Id().String(),
+		BoardId: l.BoardId().String(),
+		Name:    l.Name().String(),
+		Color:   l.Color().String(),
+	}
+}
+
+func toProtoLabels(ls []*labeldo.Label) []*v1.Label {
+	out := make([]*v1.Label, 0, len(ls))
+	for _, l := range ls {
+		out = append(out, toProtoLabel(l))
+	}
+	return out
+}
+
+func mapLabelsErr(err error) error {
+	switch {
+	case errors.Is(err, labeldo.ErrNameTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, boarddo.ErrOwnerMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return mapCommonErr(err)
+	}
+}
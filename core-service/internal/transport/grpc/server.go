@@ -18,14 +18,28 @@ func NewServer(
 	boardsHandler *BoardsHandler,
 	columnsHandler *ColumnsHandler,
 	tasksHandler *TasksHandler,
+	labelsHandler *LabelsHandler,
+	checklistsHandler *ChecklistsHandler,
+	commentsHandler *CommentsHandler,
+	watchersHandler *WatchersHandler,
+	attachmentsHandler *AttachmentsHandler,
+	templatesHandler *TemplatesHandler,
+	maxMsgSize int,
 ) *Server {
-	s := grpc.NewServer()
+	// вложения передаются одним сообщением, стандартных 4 МБ не хватает
+	s := grpc.NewServer(grpc.MaxRecvMsgSize(maxMsgSize), grpc.MaxSendMsgSize(maxMsgSize))
 
 	RegisterHealth(s)
 
 	v1.RegisterBoardsServiceServer(s, boardsHandler)
 	v1.RegisterColumnsServiceServer(s, columnsHandler)
 	v1.RegisterTasksServiceServer(s, tasksHandler)
+	v1.RegisterLabelsServiceServer(s, labelsHandler)
+	v1.RegisterChecklistsServiceServer(s, checklistsHandler)
+	v1.RegisterCommentsServiceServer(s, commentsHandler)
+	v1.RegisterWatchersServiceServer(s, watchersHandler)
+	v1.RegisterAttachmentsServiceServer(s, attachmentsHandler)
+	v1.RegisterTemplatesServiceServer(s, templatesHandler)
 
 	return &Server{
 		log: log,
@@ -2,11 +2,18 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/rs/zerolog"
+	boarddo "github.com/smarrog/task-board/core-service/internal/domain/board"
+	labeldo "github.com/smarrog/task-board/core-service/internal/domain/label"
 	taskdo "github.com/smarrog/task-board/core-service/internal/domain/task"
 	taskuc "github.com/smarrog/task-board/core-service/internal/usecase/task"
 	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TasksHandler struct {
@@ -19,6 +26,13 @@ type TasksHandler struct {
 	updateTask *taskuc.UpdateTaskUseCase
 	moveTask   *taskuc.MoveTaskUseCase
 	deleteTask *taskuc.DeleteTaskUseCase
+	restore    *taskuc.RestoreTaskUseCase
+	archive    *taskuc.ArchiveTaskUseCase
+	unarchive  *taskuc.UnarchiveTaskUseCase
+	search     *taskuc.SearchTasksUseCase
+	byAssignee *taskuc.ListTasksByAssigneeUseCase
+	setLabels  *taskuc.SetTaskLabelsUseCase
+	bulk       *taskuc.BulkTasksUseCase
 }
 
 func NewTasksHandler(
@@ -28,6 +42,13 @@ func NewTasksHandler(
 	updateTask *taskuc.UpdateTaskUseCase,
 	moveTask *taskuc.MoveTaskUseCase,
 	deleteTask *taskuc.DeleteTaskUseCase,
+	restore *taskuc.RestoreTaskUseCase,
+	archive *taskuc.ArchiveTaskUseCase,
+	unarchive *taskuc.UnarchiveTaskUseCase,
+	search *taskuc.SearchTasksUseCase,
+	byAssignee *taskuc.ListTasksByAssigneeUseCase,
+	setLabels *taskuc.SetTaskLabelsUseCase,
+	bulk *taskuc.BulkTasksUseCase,
 ) *TasksHandler {
 	return &TasksHandler{
 		log:        log,
@@ -36,6 +57,13 @@ func NewTasksHandler(
 		updateTask: updateTask,
 		moveTask:   moveTask,
 		deleteTask: deleteTask,
+		restore:    restore,
+		archive:    archive,
+		unarchive:  unarchive,
+		search:     search,
+		byAssignee: byAssignee,
+		setLabels:  setLabels,
+		bulk:       bulk,
 	}
 }
 
@@ -46,6 +74,10 @@ func (h *TasksHandler) CreateTask(ctx context.Context, req *v1.CreateTaskRequest
 		Title:       req.Title,
 		Description: req.Description,
 		AssigneeId:  req.AssigneeId,
+		StartAt:     timeFromPb(req.GetStartAt()),
+		DueAt:       timeFromPb(req.GetDueAt()),
+		Priority:    priorityFromPb(req.GetPriority()),
+		Estimate:    req.Estimate,
 	}
 
 	output, err := h.createTask.Execute(ctx, input)
@@ -68,20 +100,16 @@ func (h *TasksHandler) GetTask(ctx context.Context, req *v1.GetTaskRequest) (*v1
 		return nil, mapTasksErr(err)
 	}
 
+	pt := toProtoTask(output.Task)
+	pt.AttachmentsCount = int32(output.Attachments)
+
 	return &v1.GetTaskResponse{
-		Task: toProtoTask(output.Task),
+		Task: pt,
 	}, nil
 }
 
 func (h *TasksHandler) UpdateTask(ctx context.Context, req *v1.UpdateTaskRequest) (*v1.UpdateTaskResponse, error) {
-	input := taskuc.UpdateTaskInput{
-		TaskId:      req.TaskId,
-		Title:       req.Title,
-		Description: req.Description,
-		AssigneeId:  req.AssigneeId,
-	}
-
-	output, err := h.updateTask.Execute(ctx, input)
+	output, err := h.updateTask.Execute(ctx, updateTaskInputFromPb(req))
 	if err != nil {
 		return nil, mapTasksErr(err)
 	}
@@ -92,11 +120,8 @@ func (h *TasksHandler) UpdateTask(ctx context.Context, req *v1.UpdateTaskRequest
 }
 
 func (h *TasksHandler) MoveTask(ctx context.Context, req *v1.MoveTaskRequest) (*v1.MoveTaskResponse, error) {
-	input := taskuc.MoveTaskInput{
-		TaskId:     req.TaskId,
-		ToColumnId: req.ToColumnId,
-		ToPosition: int(req.ToPosition),
-	}
+	input := moveTaskInputFromPb(req)
+	input.RequesterId = req.GetBase().GetRequesterId()
 	output, err := h.moveTask.Execute(ctx, input)
 	if err != nil {
 		return nil, mapTasksErr(err)
@@ -120,6 +145,123 @@ func (h *TasksHandler) DeleteTask(ctx context.Context, req *v1.DeleteTaskRequest
 	return &v1.DeleteTaskResponse{}, nil
 }
 
+func (h *TasksHandler) RestoreTask(ctx context.Context, req *v1.RestoreTaskRequest) (*v1.RestoreTaskResponse, error) {
+	output, err := h.restore.Execute(ctx, taskuc.RestoreTaskInput{TaskId: req.GetTaskId()})
+	if err != nil {
+		return nil, mapTasksErr(err)
+	}
+
+	return &v1.RestoreTaskResponse{Task: toProtoTask(output.Task)}, nil
+}
+
+func (h *TasksHandler) ArchiveTask(ctx context.Context, req *v1.ArchiveTaskRequest) (*v1.ArchiveTaskResponse, error) {
+	output, err := h.archive.Execute(ctx, taskuc.ArchiveTaskInput{TaskId: req.GetTaskId()})
+	if err != nil {
+		return nil, mapTasksErr(err)
+	}
+
+	return &v1.ArchiveTaskResponse{Task: toProtoTask(output.Task)}, nil
+}
+
+func (h *TasksHandler) UnarchiveTask(ctx context.Context, req *v1.UnarchiveTaskRequest) (*v1.UnarchiveTaskResponse, error) {
+	input := taskuc.UnarchiveTaskInput{
+		TaskId:   req.GetTaskId(),
+		ColumnId: req.GetColumnId(),
+	}
+	output, err := h.unarchive.Execute(ctx, input)
+	if err != nil {
+		return nil, mapTasksErr(err)
+	}
+
+	return &v1.UnarchiveTaskResponse{Task: toProtoTask(output.Task)}, nil
+}
+
+func (h *TasksHandler) SetTaskLabels(ctx context.Context, req *v1.SetTaskLabelsRequest) (*v1.SetTaskLabelsResponse, error) {
+	input := taskuc.SetTaskLabelsInput{
+		TaskId:          req.GetTaskId(),
+		LabelIds:        req.GetLabelIds(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+
+	output, err := h.setLabels.Execute(ctx, input)
+	if err != nil {
+		return nil, mapTasksErr(err)
+	}
+
+	return &v1.SetTaskLabelsResponse{
+		Task: toProtoTask(output.Task),
+	}, nil
+}
+
+func (h *TasksHandler) SearchTasks(ctx context.Context, req *v1.SearchTasksRequest) (*v1.SearchTasksResponse, error) {
+	input := taskuc.SearchTasksInput{
+		RequesterId:     req.GetBase().GetRequesterId(),
+		Query:           req.GetQuery(),
+		BoardId:         req.GetBoardId(),
+		ColumnId:        req.GetColumnId(),
+		AssigneeId:      req.GetAssigneeId(),
+		PageSize:        int(req.GetPageSize()),
+		Cursor:          req.GetCursor(),
+		CreatedFrom:     timeFromPb(req.GetCreatedFrom()),
+		CreatedTo:       timeFromPb(req.GetCreatedTo()),
+		IncludeArchived: req.GetIncludeArchived(),
+	}
+
+	output, err := h.search.Execute(ctx, input)
+	if err != nil {
+		return nil, mapTasksErr(err)
+	}
+
+	hits := make([]*v1.TaskSearchHit, 0, len(output.Hits))
+	for _, hit := range output.Hits {
+		hits = append(hits, &v1.TaskSearchHit{
+			Task:    toProtoTask(hit.Task),
+			BoardId: hit.BoardId.String(),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		})
+	}
+
+	return &v1.SearchTasksResponse{Hits: hits, NextCursor: output.NextCursor}, nil
+}
+
+func (h *TasksHandler) ListTasksByAssignee(ctx context.Context, req *v1.ListTasksByAssigneeRequest) (*v1.ListTasksByAssigneeResponse, error) {
+	input := taskuc.ListTasksByAssigneeInput{
+		RequesterId:     req.GetBase().GetRequesterId(),
+		AssigneeId:      req.GetAssigneeId(),
+		Sort:            req.GetSort(),
+		GroupByBoard:    req.GetGroupByBoard(),
+		IncludeArchived: req.GetIncludeArchived(),
+	}
+
+	output, err := h.byAssignee.Execute(ctx, input)
+	if err != nil {
+		return nil, mapTasksErr(err)
+	}
+
+	resp := &v1.ListTasksByAssigneeResponse{}
+	for _, t := range output.Tasks {
+		resp.Tasks = append(resp.Tasks, toProtoAssignedTask(t))
+	}
+	for _, g := range output.Groups {
+		pg := &v1.AssignedTaskGroup{BoardId: g.BoardId.String(), BoardTitle: g.BoardTitle.String()}
+		for _, t := range g.Tasks {
+			pg.Tasks = append(pg.Tasks, toProtoAssignedTask(t))
+		}
+		resp.Groups = append(resp.Groups, pg)
+	}
+	return resp, nil
+}
+
+func toProtoAssignedTask(t taskdo.AssignedTask) *v1.AssignedTask {
+	return &v1.AssignedTask{
+		Task:           toProtoTask(t.Task),
+		BoardId:        t.BoardId.String(),
+		BoardTitle:     t.BoardTitle.String(),
+		ColumnPosition: int32(t.ColumnPosition),
+	}
+}
+
 func toProtoTask(b *taskdo.Task) *v1.Task {
 	return &v1.Task{
 		Id:          b.Id().String(),
@@ -128,11 +270,151 @@ func toProtoTask(b *taskdo.Task) *v1.Task {
 		Title:       b.Title().String(),
 		Description: b.Description().String(),
 		AssigneeId:  b.AssigneeId().String(),
+		StartAt:     timeToPb(b.Dates().StartAt()),
+		DueAt:       timeToPb(b.Dates().DueAt()),
+		LabelIds:    labelIdsToStrings(b.LabelIds()),
+		Priority:    priorityToPb(b.Priority()),
+		Estimate:    b.Estimate().Ptr(),
+		ArchivedAt:  timeToPb(b.ArchivedAt()),
+		Version:     b.Version(),
+	}
+}
+
+var priorityByPb = map[v1.TaskPriority]taskdo.Priority{
+	v1.TaskPriority_TASK_PRIORITY_UNSPECIFIED: taskdo.PriorityNone,
+	v1.TaskPriority_TASK_PRIORITY_LOW:         taskdo.PriorityLow,
+	v1.TaskPriority_TASK_PRIORITY_MEDIUM:      taskdo.PriorityMedium,
+	v1.TaskPriority_TASK_PRIORITY_HIGH:        taskdo.PriorityHigh,
+	v1.TaskPriority_TASK_PRIORITY_URGENT:      taskdo.PriorityUrgent,
+}
+
+// priorityFromPb отдаёт неизвестные значения как есть, чтобы их отклонила валидация домена.
+func priorityFromPb(p v1.TaskPriority) string {
+	if v, ok := priorityByPb[p]; ok {
+		return v.String()
+	}
+	return p.String()
+}
+
+func priorityToPb(p taskdo.Priority) v1.TaskPriority {
+	for pb, v := range priorityByPb {
+		if v == p {
+			return pb
+		}
+	}
+	return v1.TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func toProtoColumnStats(s taskdo.Stats) *v1.ColumnStats {
+	out := &v1.ColumnStats{
+		TaskCount:     int32(s.Count),
+		TotalEstimate: s.TotalEstimate,
+		ByPriority:    make([]*v1.PriorityCount, 0, len(s.ByPriority)),
+	}
+	for _, p := range taskdo.Priorities {
+		if n := s.ByPriority[p]; n > 0 {
+			out.ByPriority = append(out.ByPriority, &v1.PriorityCount{Priority: priorityToPb(p), Count: int32(n)})
+		}
+	}
+	return out
+}
+
+func labelIdsToStrings(ids []labeldo.Id) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return out
+}
+
+func timeFromPb(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func timeToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func (h *TasksHandler) BulkTasks(ctx context.Context, req *v1.BulkTasksRequest) (*v1.BulkTasksResponse, error) {
+	ops := make([]taskuc.BulkTaskOperation, 0, len(req.GetOperations()))
+	for _, op := range req.GetOperations() {
+		var in taskuc.BulkTaskOperation
+		switch o := op.GetOp().(type) {
+		case *v1.BulkTaskOperation_Move:
+			m := moveTaskInputFromPb(o.Move)
+			in.Move = &m
+		case *v1.BulkTaskOperation_Update:
+			u := updateTaskInputFromPb(o.Update)
+			in.Update = &u
+		case *v1.BulkTaskOperation_Delete:
+			in.Delete = &taskuc.DeleteTaskInput{TaskId: o.Delete.GetTaskId()}
+		case *v1.BulkTaskOperation_Assign:
+			in.Assign = &taskuc.AssignTaskInput{TaskId: o.Assign.GetTaskId(), AssigneeId: o.Assign.GetAssigneeId()}
+		}
+		// пустая операция остаётся пустой — сценарий вернёт по ней ошибку
+		ops = append(ops, in)
+	}
+
+	output, err := h.bulk.Execute(ctx, taskuc.BulkTasksInput{
+		RequesterId: req.GetBase().GetRequesterId(),
+		Operations:  ops,
+		BestEffort:  req.GetBestEffort(),
+	})
+	if err != nil {
+		return nil, mapTasksErr(err)
+	}
+
+	results := make([]*v1.BulkTaskResult, 0, len(output.Results))
+	for i, r := range output.Results {
+		res := &v1.BulkTaskResult{Index: int32(i)}
+		if r.Err != nil {
+			st := status.Convert(mapTasksErr(r.Err))
+			res.Code = int32(st.Code())
+			res.Error = st.Message()
+		} else if r.Task != nil {
+			res.Task = toProtoTask(r.Task)
+		}
+		results = append(results, res)
+	}
+	return &v1.BulkTasksResponse{Results: results}, nil
+}
+
+func moveTaskInputFromPb(req *v1.MoveTaskRequest) taskuc.MoveTaskInput {
+	return taskuc.MoveTaskInput{
+		TaskId:          req.GetTaskId(),
+		ToColumnId:      req.GetToColumnId(),
+		ToPosition:      int(req.GetToPosition()),
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+}
+
+func updateTaskInputFromPb(req *v1.UpdateTaskRequest) taskuc.UpdateTaskInput {
+	return taskuc.UpdateTaskInput{
+		TaskId:          req.GetTaskId(),
+		Title:           req.GetTitle(),
+		Description:     req.GetDescription(),
+		AssigneeId:      req.GetAssigneeId(),
+		StartAt:         timeFromPb(req.GetStartAt()),
+		DueAt:           timeFromPb(req.GetDueAt()),
+		Priority:        priorityFromPb(req.GetPriority()),
+		Estimate:        req.Estimate,
+		ExpectedVersion: req.GetExpectedVersion(),
+		UpdateMask:      req.GetUpdateMask().GetPaths(),
 	}
 }
 
 func mapTasksErr(err error) error {
 	switch {
+	case errors.Is(err, boarddo.ErrOwnerMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, taskuc.ErrOperationNotApplied):
+		return status.Error(codes.Aborted, err.Error())
 	default:
 		return mapCommonErr(err)
 	}
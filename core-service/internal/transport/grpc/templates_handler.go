@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	boarddo "github.com/smarrog/task-board/core-service/internal/domain/board"
+	templatedo "github.com/smarrog/task-board/core-service/internal/domain/template"
+	templateuc "github.com/smarrog/task-board/core-service/internal/usecase/template"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TemplatesHandler struct {
+	v1.UnimplementedTemplatesServiceServer
+
+	log *zerolog.Logger
+
+	createTemplate *templateuc.CreateTemplateUseCase
+	listTemplates  *templateuc.ListTemplatesUseCase
+	deleteTemplate *templateuc.DeleteTemplateUseCase
+}
+
+func NewTemplatesHandler(
+	log *zerolog.Logger,
+	createTemplate *templateuc.CreateTemplateUseCase,
+	listTemplates *templateuc.ListTemplatesUseCase,
+	deleteTemplate *templateuc.DeleteTemplateUseCase,
+) *TemplatesHandler {
+	return &TemplatesHandler{
+		log:            log,
+		createTemplate: createTemplate,
+		listTemplates:  listTemplates,
+		deleteTemplate: deleteTemplate,
+	}
+}
+
+func (h *TemplatesHandler) CreateTemplate(ctx context.Context, req *v1.CreateTemplateRequest) (*v1.CreateTemplateResponse, error) {
+	input := templateuc.CreateTemplateInput{
+		OwnerId:     req.GetOwnerId(),
+		BoardId:     req.GetBoardId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+
+	output, err := h.createTemplate.Execute(ctx, input)
+	if err != nil {
+		return nil, mapTemplatesErr(err)
+	}
+
+	return &v1.CreateTemplateResponse{
+		Template: toProtoTemplate(output.Template),
+	}, nil
+}
+
+func (h *TemplatesHandler) ListTemplates(ctx context.Context, req *v1.ListTemplatesRequest) (*v1.ListTemplatesResponse, error) {
+	output, err := h.listTemplates.Execute(ctx, templateuc.ListTemplatesInput{OwnerId: req.GetOwnerId()})
+	if err != nil {
+		return nil, mapTemplatesErr(err)
+	}
+
+	out := make([]*v1.BoardTemplate, 0, len(output.Templates))
+	for _, t := range output.Templates {
+		out = append(out, toProtoTemplate(t))
+	}
+	return &v1.ListTemplatesResponse{Templates: out}, nil
+}
+
+func (h *TemplatesHandler) DeleteTemplate(ctx context.Context, req *v1.DeleteTemplateRequest) (*v1.DeleteTemplateResponse, error) {
+	input := templateuc.DeleteTemplateInput{
+		OwnerId:    req.GetOwnerId(),
+		TemplateId: req.GetTemplateId(),
+	}
+
+	if _, err := h.deleteTemplate.Execute(ctx, input); err != nil {
+		return nil, mapTemplatesErr(err)
+	}
+	return &v1.DeleteTemplateResponse{}, nil
+}
+
+func toProtoTemplate(t *templatedo.Template) *v1.BoardTemplate {
+	columns := make([]string, 0, len(t.Columns()))
+	for _, c := range t.Columns() {
+		columns = append(columns, c.String())
+	}
+	labels := make([]*v1.TemplateLabel, 0, len(t.Labels()))
+	for _, l := range t.Labels() {
+		labels = append(labels, &v1.TemplateLabel{Name: l.Name.String(), Color: l.Color.String()})
+	}
+
+	var ownerId string
+	if !t.IsBuiltIn() {
+		ownerId = t.OwnerId().String()
+	}
+
+	return &v1.BoardTemplate{
+		Id:          t.Id().String(),
+		OwnerId:     ownerId,
+		Name:        t.Name().String(),
+		Description: t.Description().String(),
+		Columns:     columns,
+		Labels:      labels,
+	}
+}
+
+func mapTemplatesErr(err error) error {
+	switch {
+	case errors.Is(err, templatedo.ErrOwnerMismatch),
+		errors.Is(err, boarddo.ErrOwnerMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return mapCommonErr(err)
+	}
+}
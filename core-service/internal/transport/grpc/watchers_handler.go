@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	boarddo "github.com/smarrog/task-board/core-service/internal/domain/board"
+	watcheruc "github.com/smarrog/task-board/core-service/internal/usecase/watcher"
+	"github.com/smarrog/task-board/shared/domain/shared"
+	v1 "github.com/smarrog/task-board/shared/proto/base/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WatchersHandler struct {
+	v1.UnimplementedWatchersServiceServer
+
+	log *zerolog.Logger
+
+	setTaskWatching   *watcheruc.SetTaskWatchingUseCase
+	listTaskWatchers  *watcheruc.ListTaskWatchersUseCase
+	setBoardWatching  *watcheruc.SetBoardWatchingUseCase
+	listBoardWatchers *watcheruc.ListBoardWatchersUseCase
+}
+
+func NewWatchersHandler(
+	log *zerolog.Logger,
+	setTaskWatching *watcheruc.SetTaskWatchingUseCase,
+	listTaskWatchers *watcheruc.ListTaskWatchersUseCase,
+	setBoardWatching *watcheruc.SetBoardWatchingUseCase,
+	listBoardWatchers *watcheruc.ListBoardWatchersUseCase,
+) *WatchersHandler {
+	return &WatchersHandler{
+		log:               log,
+		setTaskWatching:   setTaskWatching,
+		listTaskWatchers:  listTaskWatchers,
+		setBoardWatching:  setBoardWatching,
+		listBoardWatchers: listBoardWatchers,
+	}
+}
+
+func (h *WatchersHandler) WatchTask(ctx context.Context, req *v1.WatchTaskRequest) (*v1.WatchTaskResponse, error) {
+	input := watcheruc.SetTaskWatchingInput{
+		TaskId:   req.GetTaskId(),
+		UserId:   req.GetBase().GetRequesterId(),
+		Watching: true,
+	}
+
+	if _, err := h.setTaskWatching.Execute(ctx, input); err != nil {
+		return nil, mapWatchersErr(err)
+	}
+
+	return &v1.WatchTaskResponse{}, nil
+}
+
+func (h *WatchersHandler) UnwatchTask(ctx context.Context, req *v1.UnwatchTaskRequest) (*v1.UnwatchTaskResponse, error) {
+	input := watcheruc.SetTaskWatchingInput{
+		TaskId:   req.GetTaskId(),
+		UserId:   req.GetBase().GetRequesterId(),
+		Watching: false,
+	}
+
+	if _, err := h.setTaskWatching.Execute(ctx, input); err != nil {
+		return nil, mapWatchersErr(err)
+	}
+
+	return &v1.UnwatchTaskResponse{}, nil
+}
+
+func (h *WatchersHandler) ListTaskWatchers(ctx context.Context, req *v1.ListTaskWatchersRequest) (*v1.ListTaskWatchersResponse, error) {
+	input := watcheruc.ListTaskWatchersInput{
+		TaskId: req.GetTaskId(),
+	}
+
+	output, err := h.listTaskWatchers.Execute(ctx, input)
+	if err != nil {
+		return nil, mapWatchersErr(err)
+	}
+
+	return &v1.ListTaskWatchersResponse{
+		UserIds: userIdsToStrings(output.UserIds),
+	}, nil
+}
+
+func (h *WatchersHandler) WatchBoard(ctx context.Context, req *v1.WatchBoardRequest) (*v1.WatchBoardResponse, error) {
+	input := watcheruc.SetBoardWatchingInput{
+		BoardId:  req.GetBoardId(),
+		UserId:   req.GetBase().GetRequesterId(),
+		Watching: true,
+	}
+
+	if _, err := h.setBoardWatching.Execute(ctx, input); err != nil {
+		return nil, mapWatchersErr(err)
+	}
+
+	return &v1.WatchBoardResponse{}, nil
+}
+
+func (h *WatchersHandler) UnwatchBoard(ctx context.Context, req *v1.UnwatchBoardRequest) (*v1.UnwatchBoardResponse, error) {
+	input := watcheruc.SetBoardWatchingInput{
+		BoardId:  req.GetBoardId(),
+		UserId:   req.GetBase().GetRequesterId(),
+		Watching: false,
+	}
+
+	if _, err := h.setBoardWatching.Execute(ctx, input); err != nil {
+		return nil, mapWatchersErr(err)
+	}
+
+	return &v1.UnwatchBoardResponse{}, nil
+}
+
+func (h *WatchersHandler) ListBoardWatchers(ctx context.Context, req *v1.ListBoardWatchersRequest) (*v1.ListBoardWatchersResponse, error) {
+	input := watcheruc.ListBoardWatchersInput{
+		BoardId: req.GetBoardId(),
+	}
+
+	output, err := h.listBoardWatchers.Execute(ctx, input)
+	if err != nil {
+		return nil, mapWatchersErr(err)
+	}
+
+	return &v1.ListBoardWatchersResponse{
+		UserIds: userIdsToStrings(output.UserIds),
+	}, nil
+}
+
+func userIdsToStrings(ids []shared.UserId) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return out
+}
+
+func mapWatchersErr(err error) error {
+	switch {
+	case errors.Is(err, boarddo.ErrOwnerMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return mapCommonErr(err)
+	}
+}
@@ -0,0 +1,383 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+// memAttachments хранит метаданные в памяти; saveErr имитирует сбой базы.
+type memAttachments struct {
+	mu      sync.Mutex
+	items   map[attachment.Id]*attachment.Attachment
+	saveErr error
+}
+
+func newMemAttachments() *memAttachments {
+	return &memAttachments{items: map[attachment.Id]*attachment.Attachment{}}
+}
+
+func (r *memAttachments) Save(_ context.Context, a *attachment.Attachment) error {
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items[a.Id()] = a
+	return nil
+}
+
+func (r *memAttachments) Get(_ context.Context, id attachment.Id) (*attachment.Attachment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, ok := r.items[id]
+	if !ok {
+		return nil, attachment.ErrNotFound
+	}
+	return a, nil
+}
+
+func (r *memAttachments) ListByTask(_ context.Context, taskId task.Id) ([]*attachment.Attachment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]*attachment.Attachment, 0)
+	for _, a := range r.items {
+		if a.TaskId() == taskId {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (r *memAttachments) CountByTasks(_ context.Context, taskIds []task.Id) (map[task.Id]int, error) {
+	out := map[task.Id]int{}
+	for _, id := range taskIds {
+		items, _ := r.ListByTask(context.Background(), id)
+		if len(items) > 0 {
+			out[id] = len(items)
+		}
+	}
+	return out, nil
+}
+
+func (r *memAttachments) Delete(_ context.Context, id attachment.Id) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.items[id]; !ok {
+		return attachment.ErrNotFound
+	}
+	delete(r.items, id)
+	return nil
+}
+
+// memBlobs — BlobStore в памяти; putErr и deleteErr имитируют сбои хранилища.
+type memBlobs struct {
+	mu        sync.Mutex
+	files     map[string][]byte
+	putErr    error
+	deleteErr error
+}
+
+func newMemBlobs() *memBlobs { return &memBlobs{files: map[string][]byte{}} }
+
+func (s *memBlobs) Put(_ context.Context, key string, body io.Reader, size int64, _ string) error {
+	if s.putErr != nil {
+		return s.putErr
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != size {
+		return errors.New("size mismatch")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[key] = data
+	return nil
+}
+
+func (s *memBlobs) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[key]
+	if !ok {
+		return nil, attachment.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *memBlobs) Delete(_ context.Context, key string) error {
+	if s.deleteErr != nil {
+		return s.deleteErr
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.files, key)
+	return nil
+}
+
+// stubTasks и stubColumns реализуют только Get; остальные методы сценариям не нужны.
+type stubTasks struct {
+	task.Repository
+	tasks map[task.Id]*task.Task
+}
+
+func (r stubTasks) Get(_ context.Context, id task.Id) (*task.Task, error) {
+	t, ok := r.tasks[id]
+	if !ok {
+		return nil, task.ErrNotFound
+	}
+	return t, nil
+}
+
+type stubColumns struct {
+	column.Repository
+	columns map[column.Id]*column.Column
+}
+
+func (r stubColumns) Get(_ context.Context, id column.Id) (*column.Column, error) {
+	c, ok := r.columns[id]
+	if !ok {
+		return nil, column.ErrNotFound
+	}
+	return c, nil
+}
+
+type recordingInvalidator struct{ boards []board.Id }
+
+func (i *recordingInvalidator) InvalidateBoard(_ context.Context, id board.Id) error {
+	i.boards = append(i.boards, id)
+	return nil
+}
+
+func newUserId(t *testing.T) shared.UserId {
+	t.Helper()
+	id, err := shared.UserIdFromUUID(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+type attachmentEnv struct {
+	repo    *memAttachments
+	blobs   *memBlobs
+	tasks   stubTasks
+	columns stubColumns
+	cache   *recordingInvalidator
+	policy  attachment.Policy
+
+	boardId board.Id
+	task    *task.Task
+	user    shared.UserId
+}
+
+func newAttachmentEnv(t *testing.T) *attachmentEnv {
+	t.Helper()
+	title, err := column.NewTitle("todo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	boardId := board.NewId()
+	col := column.New(boardId, column.Position(0), title)
+
+	taskTitle, err := task.NewTitle("task")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk := task.New(col.Id(), task.Position(0), task.SortKey("a"), taskTitle, task.Description{}, shared.UserId{}, task.Dates{}, task.Priority(""), task.Estimate{})
+
+	return &attachmentEnv{
+		repo:    newMemAttachments(),
+		blobs:   newMemBlobs(),
+		tasks:   stubTasks{tasks: map[task.Id]*task.Task{tk.Id(): tk}},
+		columns: stubColumns{columns: map[column.Id]*column.Column{col.Id(): col}},
+		cache:   &recordingInvalidator{},
+		policy:  attachment.Policy{MaxSize: 16, AllowedTypes: []string{"image/*", "text/plain"}},
+		boardId: boardId,
+		task:    tk,
+		user:    newUserId(t),
+	}
+}
+
+func (e *attachmentEnv) upload() *UploadAttachmentUseCase {
+	return NewUploadAttachmentUseCase(e.repo, e.blobs, e.tasks, e.columns, e.cache, e.policy)
+}
+
+func (e *attachmentEnv) delete() *DeleteAttachmentUseCase {
+	return NewDeleteAttachmentUseCase(e.repo, e.blobs, e.tasks, e.columns, e.cache)
+}
+
+func (e *attachmentEnv) uploadInput(content string) UploadAttachmentInput {
+	return UploadAttachmentInput{
+		TaskId:      e.task.Id().String(),
+		UploaderId:  e.user.String(),
+		FileName:    `C:\Users\me\notes.txt`,
+		ContentType: "text/plain; charset=utf-8",
+		Content:     []byte(content),
+	}
+}
+
+func TestUploadDownloadAndList(t *testing.T) {
+	env := newAttachmentEnv(t)
+	ctx := context.Background()
+
+	out, err := env.upload().Execute(ctx, env.uploadInput("hello"))
+	if err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+	a := out.Attachment
+	if a.FileName().String() != "notes.txt" || a.ContentType().String() != "text/plain" || a.Size() != 5 {
+		t.Fatalf("unexpected metadata: %s %s %d", a.FileName(), a.ContentType(), a.Size())
+	}
+	if len(env.cache.boards) != 1 || env.cache.boards[0] != env.boardId {
+		t.Fatalf("board cache must be invalidated, got %v", env.cache.boards)
+	}
+
+	got, err := NewDownloadAttachmentUseCase(env.repo, env.blobs).Execute(ctx, DownloadAttachmentInput{AttachmentId: a.Id().String()})
+	if err != nil {
+		t.Fatalf("download: %v", err)
+	}
+	if string(got.Content) != "hello" {
+		t.Fatalf("content = %q", got.Content)
+	}
+
+	list, err := NewListAttachmentsUseCase(env.repo, env.tasks).Execute(ctx, ListAttachmentsInput{TaskId: env.task.Id().String()})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list.Attachments) != 1 || list.Attachments[0].Id() != a.Id() {
+		t.Fatalf("list = %v", list.Attachments)
+	}
+}
+
+func TestUploadRejectsByPolicy(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		content     string
+		want        error
+	}{
+		{"empty", "text/plain", "", attachment.ErrContentEmpty},
+		{"too large", "text/plain", "0123456789abcdefg", attachment.ErrTooLarge},
+		{"type not allowed", "application/zip", "PK", attachment.ErrTypeNotAllowed},
+		{"malformed type", "text/", "x", attachment.ErrTypeNotAllowed},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			env := newAttachmentEnv(t)
+			in := env.uploadInput(c.content)
+			in.ContentType = c.contentType
+
+			if _, err := env.upload().Execute(context.Background(), in); !errors.Is(err, c.want) {
+				t.Fatalf("want %v, got %v", c.want, err)
+			}
+			if len(env.blobs.files) != 0 {
+				t.Fatal("rejected file must not reach the store")
+			}
+		})
+	}
+}
+
+func TestUploadAllowsWildcardType(t *testing.T) {
+	env := newAttachmentEnv(t)
+	in := env.uploadInput("png")
+	in.ContentType = "image/png"
+
+	if _, err := env.upload().Execute(context.Background(), in); err != nil {
+		t.Fatalf("image/* must allow image/png: %v", err)
+	}
+}
+
+func TestUploadToMissingTask(t *testing.T) {
+	env := newAttachmentEnv(t)
+	in := env.uploadInput("hello")
+	in.TaskId = task.NewId().String()
+
+	if _, err := env.upload().Execute(context.Background(), in); !errors.Is(err, task.ErrNotFound) {
+		t.Fatalf("want task.ErrNotFound, got %v", err)
+	}
+	if len(env.blobs.files) != 0 {
+		t.Fatal("file must not be stored for a missing task")
+	}
+}
+
+func TestUploadRemovesBlobWhenMetadataFails(t *testing.T) {
+	env := newAttachmentEnv(t)
+	env.repo.saveErr = errors.New("db is down")
+
+	if _, err := env.upload().Execute(context.Background(), env.uploadInput("hello")); err == nil {
+		t.Fatal("want error")
+	}
+	if len(env.blobs.files) != 0 {
+		t.Fatal("orphan blob must be removed")
+	}
+}
+
+func TestUploadBlobFailureSavesNothing(t *testing.T) {
+	env := newAttachmentEnv(t)
+	env.blobs.putErr = errors.New("s3 is down")
+
+	if _, err := env.upload().Execute(context.Background(), env.uploadInput("hello")); err == nil {
+		t.Fatal("want error")
+	}
+	if len(env.repo.items) != 0 {
+		t.Fatal("metadata without a file must not be saved")
+	}
+}
+
+func TestDownloadMissingBlob(t *testing.T) {
+	env := newAttachmentEnv(t)
+	ctx := context.Background()
+	out, err := env.upload().Execute(ctx, env.uploadInput("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(env.blobs.files, out.Attachment.StorageKey())
+
+	_, err = NewDownloadAttachmentUseCase(env.repo, env.blobs).Execute(ctx, DownloadAttachmentInput{AttachmentId: out.Attachment.Id().String()})
+	if !errors.Is(err, attachment.ErrNotFound) {
+		t.Fatalf("want ErrNotFound, got %v", err)
+	}
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	env := newAttachmentEnv(t)
+	ctx := context.Background()
+	out, err := env.upload().Execute(ctx, env.uploadInput("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := out.Attachment.Id().String()
+
+	_, err = env.delete().Execute(ctx, DeleteAttachmentInput{AttachmentId: id, RequesterId: newUserId(t).String()})
+	if !errors.Is(err, attachment.ErrUploaderMismatch) {
+		t.Fatalf("foreign delete: %v", err)
+	}
+
+	// сбой хранилища не мешает удалению: запись уже удалена, файл уберёт очистка
+	env.blobs.deleteErr = errors.New("s3 is down")
+	if _, err := env.delete().Execute(ctx, DeleteAttachmentInput{AttachmentId: id, RequesterId: env.user.String()}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := env.repo.Get(ctx, out.Attachment.Id()); !errors.Is(err, attachment.ErrNotFound) {
+		t.Fatalf("metadata must be removed: %v", err)
+	}
+
+	env.blobs.deleteErr = nil
+	if _, err := env.delete().Execute(ctx, DeleteAttachmentInput{AttachmentId: id, RequesterId: env.user.String()}); !errors.Is(err, attachment.ErrNotFound) {
+		t.Fatalf("second delete: %v", err)
+	}
+}
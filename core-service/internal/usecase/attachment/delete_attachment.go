@@ -0,0 +1,68 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type DeleteAttachmentUseCase struct {
+	repo    attachment.Repository
+	blobs   attachment.BlobStore
+	tasks   task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+}
+
+type DeleteAttachmentInput struct {
+	AttachmentId string
+	RequesterId  string
+}
+
+type DeleteAttachmentOutput struct{}
+
+func NewDeleteAttachmentUseCase(
+	repo attachment.Repository,
+	blobs attachment.BlobStore,
+	tasks task.Repository,
+	columns column.Repository,
+	cache cache.Invalidator,
+) *DeleteAttachmentUseCase {
+	return &DeleteAttachmentUseCase{repo: repo, blobs: blobs, tasks: tasks, columns: columns, cache: cache}
+}
+
+func (uc *DeleteAttachmentUseCase) Execute(ctx context.Context, input DeleteAttachmentInput) (*DeleteAttachmentOutput, error) {
+	id, err := attachment.IdFromString(input.AttachmentId)
+	if err != nil {
+		return nil, err
+	}
+	requesterId, err := shared.UserIdFromString(input.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+
+	a, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.UploaderId() != requesterId {
+		return nil, attachment.ErrUploaderMismatch
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return nil, fmt.Errorf("delete attachment: %w", err)
+	}
+	// вложения уже нет; оставшийся файл ни на что не влияет, поэтому ошибку не возвращаем
+	_ = uc.blobs.Delete(ctx, a.StorageKey())
+
+	if t, err := uc.tasks.Get(ctx, a.TaskId()); err == nil {
+		invalidateColumnBoard(ctx, uc.columns, uc.cache, t.ColumnId())
+	}
+
+	return &DeleteAttachmentOutput{}, nil
+}
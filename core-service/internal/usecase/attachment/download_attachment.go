@@ -0,0 +1,53 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
+)
+
+type DownloadAttachmentUseCase struct {
+	repo  attachment.Repository
+	blobs attachment.BlobStore
+}
+
+type DownloadAttachmentInput struct {
+	AttachmentId string
+}
+
+type DownloadAttachmentOutput struct {
+	Attachment *attachment.Attachment
+	Content    []byte
+}
+
+func NewDownloadAttachmentUseCase(repo attachment.Repository, blobs attachment.BlobStore) *DownloadAttachmentUseCase {
+	return &DownloadAttachmentUseCase{repo: repo, blobs: blobs}
+}
+
+func (uc *DownloadAttachmentUseCase) Execute(ctx context.Context, input DownloadAttachmentInput) (*DownloadAttachmentOutput, error) {
+	id, err := attachment.IdFromString(input.AttachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := uc.blobs.Get(ctx, a.StorageKey())
+	if err != nil {
+		return nil, fmt.Errorf("get blob: %w", err)
+	}
+	defer rc.Close()
+
+	// размер известен из метаданных, больше не читаем
+	content, err := io.ReadAll(io.LimitReader(rc, a.Size()))
+	if err != nil {
+		return nil, fmt.Errorf("read blob: %w", err)
+	}
+
+	return &DownloadAttachmentOutput{Attachment: a, Content: content}, nil
+}
@@ -0,0 +1,18 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+// invalidateColumnBoard сбрасывает кэш доски: число вложений показывается на карточках.
+func invalidateColumnBoard(ctx context.Context, columns column.Repository, inv cache.Invalidator, columnId column.Id) {
+	if inv == nil {
+		return
+	}
+	if c, err := columns.Get(ctx, columnId); err == nil {
+		_ = inv.InvalidateBoard(ctx, c.BoardId())
+	}
+}
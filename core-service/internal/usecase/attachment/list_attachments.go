@@ -0,0 +1,44 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+)
+
+type ListAttachmentsUseCase struct {
+	repo  attachment.Repository
+	tasks task.Repository
+}
+
+type ListAttachmentsInput struct {
+	TaskId string
+}
+
+type ListAttachmentsOutput struct {
+	Attachments []*attachment.Attachment
+}
+
+func NewListAttachmentsUseCase(repo attachment.Repository, tasks task.Repository) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{repo: repo, tasks: tasks}
+}
+
+func (uc *ListAttachmentsUseCase) Execute(ctx context.Context, input ListAttachmentsInput) (*ListAttachmentsOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.tasks.Get(ctx, tid); err != nil {
+		return nil, err
+	}
+
+	items, err := uc.repo.ListByTask(ctx, tid)
+	if err != nil {
+		return nil, fmt.Errorf("list attachments: %w", err)
+	}
+
+	return &ListAttachmentsOutput{Attachments: items}, nil
+}
@@ -0,0 +1,88 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type UploadAttachmentUseCase struct {
+	repo    attachment.Repository
+	blobs   attachment.BlobStore
+	tasks   task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+	policy  attachment.Policy
+}
+
+type UploadAttachmentInput struct {
+	TaskId      string
+	UploaderId  string
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+type UploadAttachmentOutput struct {
+	Attachment *attachment.Attachment
+}
+
+func NewUploadAttachmentUseCase(
+	repo attachment.Repository,
+	blobs attachment.BlobStore,
+	tasks task.Repository,
+	columns column.Repository,
+	cache cache.Invalidator,
+	policy attachment.Policy,
+) *UploadAttachmentUseCase {
+	return &UploadAttachmentUseCase{repo: repo, blobs: blobs, tasks: tasks, columns: columns, cache: cache, policy: policy}
+}
+
+func (uc *UploadAttachmentUseCase) Execute(ctx context.Context, input UploadAttachmentInput) (*UploadAttachmentOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	uploaderId, err := shared.UserIdFromString(input.UploaderId)
+	if err != nil {
+		return nil, fmt.Errorf("uploader_id: %w", err)
+	}
+	fileName, err := attachment.NewFileName(input.FileName)
+	if err != nil {
+		return nil, err
+	}
+	contentType, err := attachment.NewContentType(input.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(len(input.Content))
+	if err := uc.policy.Check(contentType, size); err != nil {
+		return nil, err
+	}
+
+	t, err := uc.tasks.Get(ctx, tid)
+	if err != nil {
+		return nil, err
+	}
+
+	a := attachment.New(tid, uploaderId, fileName, contentType, size)
+
+	// Сначала файл, потом метаданные: запись без файла хуже, чем файл без записи.
+	if err := uc.blobs.Put(ctx, a.StorageKey(), bytes.NewReader(input.Content), size, contentType.String()); err != nil {
+		return nil, fmt.Errorf("put blob: %w", err)
+	}
+	if err := uc.repo.Save(ctx, a); err != nil {
+		_ = uc.blobs.Delete(ctx, a.StorageKey())
+		return nil, fmt.Errorf("save attachment: %w", err)
+	}
+
+	invalidateColumnBoard(ctx, uc.columns, uc.cache, t.ColumnId())
+
+	return &UploadAttachmentOutput{Attachment: a}, nil
+}
@@ -0,0 +1,49 @@
+package board
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type ArchiveBoardUseCase struct {
+	repo  board.Repository
+	cache cache.Invalidator
+}
+
+type ArchiveBoardInput struct {
+	BoardId string
+}
+
+type ArchiveBoardOutput struct {
+	Board *board.Board
+}
+
+func NewArchiveBoardUseCase(repo board.Repository, cache cache.Invalidator) *ArchiveBoardUseCase {
+	return &ArchiveBoardUseCase{repo: repo, cache: cache}
+}
+
+func (uc *ArchiveBoardUseCase) Execute(ctx context.Context, input ArchiveBoardInput) (*ArchiveBoardOutput, error) {
+	id, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.Archive(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Save(ctx, b); err != nil {
+		return nil, err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, id)
+	}
+
+	return &ArchiveBoardOutput{Board: b}, nil
+}
@@ -5,25 +5,40 @@ import (
 	"fmt"
 
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/domain/template"
 	"github.com/smarrog/task-board/shared/domain/shared"
 )
 
 type CreateBoardUseCase struct {
-	repo board.Repository
+	repo      board.Repository
+	columns   column.Repository
+	labels    label.Repository
+	templates template.Repository
 }
 
 type CreateBoardInput struct {
 	OwnerId     string
 	Title       string
 	Description string
+	// TemplateId — необязательный шаблон; пустое название берётся из шаблона.
+	TemplateId string
 }
 
 type CreateBoardOutput struct {
-	Board *board.Board
+	Board   *board.Board
+	Columns []*column.Column
+	Labels  []*label.Label
 }
 
-func NewCreateBoardUseCase(repo board.Repository) *CreateBoardUseCase {
-	return &CreateBoardUseCase{repo: repo}
+func NewCreateBoardUseCase(
+	repo board.Repository,
+	columns column.Repository,
+	labels label.Repository,
+	templates template.Repository,
+) *CreateBoardUseCase {
+	return &CreateBoardUseCase{repo: repo, columns: columns, labels: labels, templates: templates}
 }
 
 func (uc *CreateBoardUseCase) Execute(ctx context.Context, input CreateBoardInput) (*CreateBoardOutput, error) {
@@ -32,6 +47,25 @@ func (uc *CreateBoardUseCase) Execute(ctx context.Context, input CreateBoardInpu
 		return nil, fmt.Errorf("board owner_id: %w", err)
 	}
 
+	var tpl *template.Template
+	if input.TemplateId != "" {
+		tid, err := template.IdFromString(input.TemplateId)
+		if err != nil {
+			return nil, err
+		}
+		tpl, err = uc.templates.Get(ctx, tid)
+		if err != nil {
+			return nil, err
+		}
+		// чужой шаблон для пользователя не существует
+		if !tpl.VisibleTo(userId) {
+			return nil, template.ErrNotFound
+		}
+		if input.Title == "" {
+			input.Title = tpl.Name().String()
+		}
+	}
+
 	t, err := board.NewTitle(input.Title)
 	if err != nil {
 		return nil, err
@@ -47,13 +81,38 @@ func (uc *CreateBoardUseCase) Execute(ctx context.Context, input CreateBoardInpu
 		return nil, err
 	}
 
-	err = uc.repo.Save(ctx, b)
-	if err != nil {
-		return nil, fmt.Errorf("save board: %w", err)
+	output := &CreateBoardOutput{
+		Board:   b,
+		Columns: []*column.Column{},
+		Labels:  []*label.Label{},
 	}
 
-	output := &CreateBoardOutput{
-		Board: b,
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Save(ctx, b); err != nil {
+			return fmt.Errorf("save board: %w", err)
+		}
+		if tpl == nil {
+			return nil
+		}
+
+		for i, title := range tpl.Columns() {
+			c := column.New(b.Id(), column.Position(i), title)
+			if err := uc.columns.Save(ctx, c); err != nil {
+				return fmt.Errorf("save column: %w", err)
+			}
+			output.Columns = append(output.Columns, c)
+		}
+		for _, spec := range tpl.Labels() {
+			l := label.New(b.Id(), spec.Name, spec.Color)
+			if err := uc.labels.Save(ctx, l); err != nil {
+				return fmt.Errorf("save label: %w", err)
+			}
+			output.Labels = append(output.Labels, l)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return output, nil
@@ -0,0 +1,148 @@
+package board
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+const copySuffix = " (copy)"
+
+type DuplicateBoardUseCase struct {
+	boards  board.Repository
+	columns column.Repository
+	tasks   task.Repository
+	labels  label.Repository
+}
+
+type DuplicateBoardInput struct {
+	OwnerId string
+	BoardId string
+	// Title — название копии; по умолчанию «<название> (copy)».
+	Title        string
+	IncludeTasks bool
+}
+
+type DuplicateBoardOutput struct {
+	Board *board.Board
+}
+
+func NewDuplicateBoardUseCase(
+	boards board.Repository,
+	columns column.Repository,
+	tasks task.Repository,
+	labels label.Repository,
+) *DuplicateBoardUseCase {
+	return &DuplicateBoardUseCase{boards: boards, columns: columns, tasks: tasks, labels: labels}
+}
+
+// Execute копирует доску с колонками и метками, а при IncludeTasks — и живые задачи
+// без архива. Чек-листы, комментарии и вложения не копируются.
+func (uc *DuplicateBoardUseCase) Execute(ctx context.Context, input DuplicateBoardInput) (*DuplicateBoardOutput, error) {
+	bid, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	oid, err := shared.UserIdFromString(input.OwnerId)
+	if err != nil {
+		return nil, fmt.Errorf("board owner_id: %w", err)
+	}
+
+	var copied *board.Board
+	err = uc.boards.InTx(ctx, func(ctx context.Context) error {
+		src, err := uc.boards.Get(ctx, bid)
+		if err != nil {
+			return err
+		}
+		if src.OwnerId().UUID() != oid.UUID() {
+			return board.ErrOwnerMismatch
+		}
+
+		raw := input.Title
+		if raw == "" {
+			raw = copyTitle(src.Title().String())
+		}
+		title, err := board.NewTitle(raw)
+		if err != nil {
+			return err
+		}
+
+		copied, err = board.New(oid, title, src.Description())
+		if err != nil {
+			return err
+		}
+		if err := uc.boards.Save(ctx, copied); err != nil {
+			return fmt.Errorf("save board: %w", err)
+		}
+
+		srcLabels, err := uc.labels.ListByBoard(ctx, bid)
+		if err != nil {
+			return err
+		}
+		labelMap := make(map[label.Id]label.Id, len(srcLabels))
+		for _, l := range srcLabels {
+			nl := label.New(copied.Id(), l.Name(), l.Color())
+			if err := uc.labels.Save(ctx, nl); err != nil {
+				return fmt.Errorf("save label: %w", err)
+			}
+			labelMap[l.Id()] = nl.Id()
+		}
+
+		srcColumns, err := uc.columns.ListByBoard(ctx, bid)
+		if err != nil {
+			return err
+		}
+		columnMap := make(map[column.Id]column.Id, len(srcColumns))
+		columnIds := make([]column.Id, 0, len(srcColumns))
+		for _, c := range srcColumns {
+			nc := column.New(copied.Id(), c.Position(), c.Title())
+			if err := uc.columns.Save(ctx, nc); err != nil {
+				return fmt.Errorf("save column: %w", err)
+			}
+			columnMap[c.Id()] = nc.Id()
+			columnIds = append(columnIds, c.Id())
+		}
+
+		if !input.IncludeTasks {
+			return nil
+		}
+
+		srcTasks, err := uc.tasks.ListByColumns(ctx, columnIds)
+		if err != nil {
+			return err
+		}
+		for _, t := range srcTasks {
+			labelIds := make([]label.Id, 0, len(t.LabelIds()))
+			for _, id := range t.LabelIds() {
+				if nid, ok := labelMap[id]; ok {
+					labelIds = append(labelIds, nid)
+				}
+			}
+			nt := t.CopyTo(columnMap[t.ColumnId()], labelIds)
+			if err := uc.tasks.Save(ctx, nt); err != nil {
+				return fmt.Errorf("save task: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DuplicateBoardOutput{Board: copied}, nil
+}
+
+// copyTitle дописывает суффикс копии, при необходимости обрезая исходное название.
+func copyTitle(title string) string {
+	if limit := board.MaxTitleLength - len(copySuffix); len(title) > limit {
+		title = strings.ToValidUTF8(title[:limit], "")
+	}
+	return title + copySuffix
+}
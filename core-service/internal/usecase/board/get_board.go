@@ -5,38 +5,54 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
 )
 
 type GetBoardUseCase struct {
-	boards  board.Repository
-	columns column.Repository
-	tasks   task.Repository
-	cache   cache.Cacher
-	ttl     time.Duration
+	boards     board.Repository
+	columns    column.Repository
+	tasks      task.Repository
+	labels     label.Repository
+	checklists checklist.Repository
+	files      attachment.Repository
+	cache      cache.Cacher
+	ttl        time.Duration
 }
 
 type GetBoardInput struct {
-	BoardId string
+	BoardId  string
+	LabelIds []string
 }
 
 type GetBoardOutput struct {
 	Board   *board.Board
 	Columns []*column.Column
 	Tasks   []*task.Task
+	Labels  []*label.Label
+
+	Checklists  map[task.Id]checklist.Progress
+	Attachments map[task.Id]int
+	// Stats — сводка по задачам колонок после фильтра по меткам; в кэш не попадает.
+	Stats map[column.Id]task.Stats
 }
 
 func NewGetBoardUseCase(
 	boards board.Repository,
 	columns column.Repository,
 	tasks task.Repository,
+	labels label.Repository,
+	checklists checklist.Repository,
+	files attachment.Repository,
 	cache cache.Cacher,
 	ttl time.Duration,
 ) *GetBoardUseCase {
-	return &GetBoardUseCase{boards: boards, columns: columns, tasks: tasks, cache: cache, ttl: ttl}
+	return &GetBoardUseCase{boards: boards, columns: columns, tasks: tasks, labels: labels, checklists: checklists, files: files, cache: cache, ttl: ttl}
 }
 
 func (uc *GetBoardUseCase) Execute(ctx context.Context, input GetBoardInput) (*GetBoardOutput, error) {
@@ -44,10 +60,19 @@ func (uc *GetBoardUseCase) Execute(ctx context.Context, input GetBoardInput) (*G
 	if err != nil {
 		return nil, err
 	}
+	filter := make(map[label.Id]struct{}, len(input.LabelIds))
+	for _, raw := range input.LabelIds {
+		lid, err := label.IdFromString(raw)
+		if err != nil {
+			return nil, err
+		}
+		filter[lid] = struct{}{}
+	}
 
 	if uc.cache != nil {
 		if cached, hit, err := uc.cache.GetBoard(ctx, id); err == nil && hit && cached != nil {
-			return &GetBoardOutput{Board: cached.Board, Columns: cached.Columns, Tasks: cached.Tasks}, nil
+			out := &GetBoardOutput{Board: cached.Board, Columns: cached.Columns, Tasks: cached.Tasks, Labels: cached.Labels, Checklists: cached.Checklists, Attachments: cached.Attachments}
+			return withStats(filterByLabels(out, filter)), nil
 		} else if err != nil {
 			// cache errors must not break the request path
 		}
@@ -73,11 +98,63 @@ func (uc *GetBoardUseCase) Execute(ctx context.Context, input GetBoardInput) (*G
 		return nil, fmt.Errorf("list tasks: %w", err)
 	}
 
-	out := &GetBoardOutput{Board: b, Columns: cols, Tasks: tasksOut}
+	labelsOut, err := uc.labels.ListByBoard(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list labels: %w", err)
+	}
+
+	taskIds := make([]task.Id, 0, len(tasksOut))
+	for _, t := range tasksOut {
+		taskIds = append(taskIds, t.Id())
+	}
+	progress, err := uc.checklists.ProgressByTasks(ctx, taskIds)
+	if err != nil {
+		return nil, fmt.Errorf("checklist progress: %w", err)
+	}
+	files, err := uc.files.CountByTasks(ctx, taskIds)
+	if err != nil {
+		return nil, fmt.Errorf("count attachments: %w", err)
+	}
+
+	out := &GetBoardOutput{Board: b, Columns: cols, Tasks: tasksOut, Labels: labelsOut, Checklists: progress, Attachments: files}
 
+	// В кэш кладём доску целиком, фильтр применяется поверх.
 	if uc.cache != nil {
-		_ = uc.cache.SetBoard(ctx, id, &cache.BoardData{Board: out.Board, Columns: out.Columns, Tasks: out.Tasks}, uc.ttl)
+		_ = uc.cache.SetBoard(ctx, id, &cache.BoardData{
+			Board:       out.Board,
+			Columns:     out.Columns,
+			Tasks:       out.Tasks,
+			Labels:      out.Labels,
+			Checklists:  out.Checklists,
+			Attachments: out.Attachments,
+		}, uc.ttl)
+	}
+
+	return withStats(filterByLabels(out, filter)), nil
+}
+
+func filterByLabels(out *GetBoardOutput, filter map[label.Id]struct{}) *GetBoardOutput {
+	if len(filter) == 0 {
+		return out
 	}
 
-	return out, nil
+	tasksOut := make([]*task.Task, 0, len(out.Tasks))
+	for _, t := range out.Tasks {
+		if t.HasAnyLabel(filter) {
+			tasksOut = append(tasksOut, t)
+		}
+	}
+	return &GetBoardOutput{Board: out.Board, Columns: out.Columns, Tasks: tasksOut, Labels: out.Labels, Checklists: out.Checklists, Attachments: out.Attachments}
+}
+
+func withStats(out *GetBoardOutput) *GetBoardOutput {
+	byColumn := make(map[column.Id][]*task.Task, len(out.Columns))
+	for _, t := range out.Tasks {
+		byColumn[t.ColumnId()] = append(byColumn[t.ColumnId()], t)
+	}
+	out.Stats = make(map[column.Id]task.Stats, len(out.Columns))
+	for _, c := range out.Columns {
+		out.Stats[c.Id()] = task.StatsOf(byColumn[c.Id()])
+	}
+	return out
 }
@@ -3,39 +3,62 @@ package board
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
 	"github.com/smarrog/task-board/shared/domain/shared"
 )
 
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
 type ListBoardsUseCase struct {
-	boards  board.Repository
-	columns column.Repository
-	tasks   task.Repository
-	cache   cache.Cacher
-	ttl     time.Duration
+	boards     board.Repository
+	columns    column.Repository
+	tasks      task.Repository
+	labels     label.Repository
+	checklists checklist.Repository
+	files      attachment.Repository
+	cache      cache.Cacher
+	ttl        time.Duration
 }
 
 type ListBoardsInput struct {
-	OwnerId string
+	OwnerId         string
+	PageSize        int
+	Cursor          string
+	Sort            string
+	Search          string
+	Summary         bool
+	IncludeArchived bool
 }
 
 type ListBoardsOutput struct {
-	Items []*GetBoardOutput
+	Items      []*GetBoardOutput
+	NextCursor string
 }
 
 func NewListBoardsUseCase(
 	boards board.Repository,
 	columns column.Repository,
 	tasks task.Repository,
+	labels label.Repository,
+	checklists checklist.Repository,
+	files attachment.Repository,
 	cache cache.Cacher,
 	ttl time.Duration,
 ) *ListBoardsUseCase {
-	return &ListBoardsUseCase{boards: boards, columns: columns, tasks: tasks, cache: cache, ttl: ttl}
+	return &ListBoardsUseCase{boards: boards, columns: columns, tasks: tasks, labels: labels, checklists: checklists, files: files, cache: cache, ttl: ttl}
 }
 
 func (uc *ListBoardsUseCase) Execute(ctx context.Context, input ListBoardsInput) (*ListBoardsOutput, error) {
@@ -44,13 +67,55 @@ func (uc *ListBoardsUseCase) Execute(ctx context.Context, input ListBoardsInput)
 		return nil, fmt.Errorf("owner_id: %w", err)
 	}
 
-	boardsList, err := uc.boards.ListByOwner(ctx, oid)
+	sort, err := board.NewSort(input.Sort)
 	if err != nil {
 		return nil, err
 	}
+	search := strings.TrimSpace(input.Search)
+	if len(search) > board.MaxSearchLength {
+		return nil, board.ErrSearchTooLong
+	}
+
+	query := board.ListQuery{Search: search, Sort: sort, IncludeArchived: input.IncludeArchived}
+	if input.Cursor != "" {
+		after, err := board.DecodeCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if after.Sort != sort {
+			return nil, board.ErrInvalidCursor
+		}
+		query.After = &after
+	}
+
+	pageSize := DefaultPageSize
+	if input.PageSize > 0 {
+		pageSize = common.Clamp(input.PageSize, 1, MaxPageSize)
+	}
+	// Берём на одну доску больше, чтобы понять, есть ли следующая страница.
+	query.Limit = pageSize + 1
+
+	boardsList, err := uc.boards.ListByOwner(ctx, oid, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if len(boardsList) > pageSize {
+		boardsList = boardsList[:pageSize]
+		nextCursor = board.CursorAfter(boardsList[pageSize-1], sort).Encode()
+	}
+
 	if len(boardsList) == 0 {
 		return &ListBoardsOutput{Items: []*GetBoardOutput{}}, nil
 	}
+	if input.Summary {
+		items := make([]*GetBoardOutput, 0, len(boardsList))
+		for _, b := range boardsList {
+			items = append(items, &GetBoardOutput{Board: b})
+		}
+		return &ListBoardsOutput{Items: items, NextCursor: nextCursor}, nil
+	}
 
 	hitByBoard := make(map[string]*cache.BoardData, len(boardsList))
 	missIDs := make([]board.Id, 0)
@@ -99,24 +164,63 @@ func (uc *ListBoardsUseCase) Execute(ctx context.Context, input ListBoardsInput)
 		}
 
 		tasksByColumn := make(map[string][]*task.Task)
+		taskIds := make([]task.Id, 0, len(ts))
 		for _, t := range ts {
 			cid := t.ColumnId().String()
 			tasksByColumn[cid] = append(tasksByColumn[cid], t)
+			taskIds = append(taskIds, t.Id())
+		}
+
+		progress, err := uc.checklists.ProgressByTasks(ctx, taskIds)
+		if err != nil {
+			return nil, fmt.Errorf("checklist progress: %w", err)
+		}
+		files, err := uc.files.CountByTasks(ctx, taskIds)
+		if err != nil {
+			return nil, fmt.Errorf("count attachments: %w", err)
+		}
+
+		ls, err := uc.labels.ListByBoards(ctx, missIDs)
+		if err != nil {
+			return nil, fmt.Errorf("list labels: %w", err)
+		}
+
+		labelsByBoard := make(map[string][]*label.Label)
+		for _, l := range ls {
+			bid := l.BoardId().String()
+			labelsByBoard[bid] = append(labelsByBoard[bid], l)
 		}
 
 		for _, bid := range missIDs {
 			b := boardByID[bid.String()]
 			bcols := colsByBoard[bid.String()]
 			flatTasks := make([]*task.Task, 0)
+			boardProgress := make(map[task.Id]checklist.Progress)
+			boardFiles := make(map[task.Id]int)
 			for _, c := range bcols {
-				flatTasks = append(flatTasks, tasksByColumn[c.Id().String()]...)
+				for _, t := range tasksByColumn[c.Id().String()] {
+					flatTasks = append(flatTasks, t)
+					if p, ok := progress[t.Id()]; ok {
+						boardProgress[t.Id()] = p
+					}
+					if n, ok := files[t.Id()]; ok {
+						boardFiles[t.Id()] = n
+					}
+				}
 			}
 
-			out := &GetBoardOutput{Board: b, Columns: bcols, Tasks: flatTasks}
+			out := &GetBoardOutput{Board: b, Columns: bcols, Tasks: flatTasks, Labels: labelsByBoard[bid.String()], Checklists: boardProgress, Attachments: boardFiles}
 			missOut[bid.String()] = out
 
 			if uc.cache != nil {
-				_ = uc.cache.SetBoard(ctx, bid, &cache.BoardData{Board: out.Board, Columns: out.Columns, Tasks: out.Tasks}, uc.ttl)
+				_ = uc.cache.SetBoard(ctx, bid, &cache.BoardData{
+					Board:       out.Board,
+					Columns:     out.Columns,
+					Tasks:       out.Tasks,
+					Labels:      out.Labels,
+					Checklists:  out.Checklists,
+					Attachments: out.Attachments,
+				}, uc.ttl)
 			}
 		}
 	}
@@ -125,14 +229,14 @@ func (uc *ListBoardsUseCase) Execute(ctx context.Context, input ListBoardsInput)
 	for _, b := range boardsList {
 		bid := b.Id().String()
 		if cached, ok := hitByBoard[bid]; ok {
-			items = append(items, &GetBoardOutput{Board: cached.Board, Columns: cached.Columns, Tasks: cached.Tasks})
+			items = append(items, withStats(&GetBoardOutput{Board: cached.Board, Columns: cached.Columns, Tasks: cached.Tasks, Labels: cached.Labels, Checklists: cached.Checklists, Attachments: cached.Attachments}))
 			continue
 		}
 		if out, ok := missOut[bid]; ok {
-			items = append(items, out)
+			items = append(items, withStats(out))
 			continue
 		}
 	}
 
-	return &ListBoardsOutput{Items: items}, nil
+	return &ListBoardsOutput{Items: items, NextCursor: nextCursor}, nil
 }
@@ -0,0 +1,63 @@
+package board
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+)
+
+// ListTrashUseCase показывает корзину доски: саму доску, если она удалена,
+// и колонки/задачи, удалённые по отдельности.
+type ListTrashUseCase struct {
+	boards  board.Repository
+	columns column.Repository
+	tasks   task.Repository
+}
+
+type ListTrashInput struct {
+	BoardId string
+}
+
+type ListTrashOutput struct {
+	// Board заполнен, только если в корзине лежит вся доска.
+	Board   *board.Trashed
+	Columns []column.Trashed
+	Tasks   []task.Trashed
+}
+
+func NewListTrashUseCase(boards board.Repository, columns column.Repository, tasks task.Repository) *ListTrashUseCase {
+	return &ListTrashUseCase{boards: boards, columns: columns, tasks: tasks}
+}
+
+func (uc *ListTrashUseCase) Execute(ctx context.Context, input ListTrashInput) (*ListTrashOutput, error) {
+	id, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ListTrashOutput{}
+	if _, err := uc.boards.Get(ctx, id); errors.Is(err, board.ErrNotFound) {
+		trashed, err := uc.boards.GetTrashed(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		out.Board = trashed
+	} else if err != nil {
+		return nil, fmt.Errorf("get board: %w", err)
+	}
+
+	out.Columns, err = uc.columns.ListTrashed(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list trashed columns: %w", err)
+	}
+	out.Tasks, err = uc.tasks.ListTrashed(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list trashed tasks: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,49 @@
+package board
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type RestoreBoardUseCase struct {
+	repo  board.Repository
+	cache cache.Invalidator
+}
+
+type RestoreBoardInput struct {
+	BoardId string
+}
+
+type RestoreBoardOutput struct {
+	Board *board.Board
+}
+
+func NewRestoreBoardUseCase(repo board.Repository, cache cache.Invalidator) *RestoreBoardUseCase {
+	return &RestoreBoardUseCase{repo: repo, cache: cache}
+}
+
+func (uc *RestoreBoardUseCase) Execute(ctx context.Context, input RestoreBoardInput) (*RestoreBoardOutput, error) {
+	id, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	trashed, err := uc.repo.GetTrashed(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	b := trashed.Board
+
+	b.Restore()
+	if err := uc.repo.Restore(ctx, b); err != nil {
+		return nil, err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, id)
+	}
+
+	return &RestoreBoardOutput{Board: b}, nil
+}
@@ -0,0 +1,49 @@
+package board
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type UnarchiveBoardUseCase struct {
+	repo  board.Repository
+	cache cache.Invalidator
+}
+
+type UnarchiveBoardInput struct {
+	BoardId string
+}
+
+type UnarchiveBoardOutput struct {
+	Board *board.Board
+}
+
+func NewUnarchiveBoardUseCase(repo board.Repository, cache cache.Invalidator) *UnarchiveBoardUseCase {
+	return &UnarchiveBoardUseCase{repo: repo, cache: cache}
+}
+
+func (uc *UnarchiveBoardUseCase) Execute(ctx context.Context, input UnarchiveBoardInput) (*UnarchiveBoardOutput, error) {
+	id, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.Unarchive(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Save(ctx, b); err != nil {
+		return nil, err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, id)
+	}
+
+	return &UnarchiveBoardOutput{Board: b}, nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
 	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	shboard "github.com/smarrog/task-board/shared/domain/board"
 	"github.com/smarrog/task-board/shared/domain/shared"
 )
 
@@ -19,6 +21,10 @@ type UpdateBoardInput struct {
 	OwnerId     string
 	Title       string
 	Description string
+	// ExpectedVersion — версия из If-Match; 0 — без проверки.
+	ExpectedVersion int64
+	// UpdateMask — пути изменяемых полей; пустая маска заменяет все поля.
+	UpdateMask []string
 }
 
 type UpdateBoardOutput struct {
@@ -40,12 +46,7 @@ func (uc *UpdateBoardUseCase) Execute(ctx context.Context, input UpdateBoardInpu
 		return nil, fmt.Errorf("board owner_id: %w", err)
 	}
 
-	t, err := board.NewTitle(input.Title)
-	if err != nil {
-		return nil, err
-	}
-
-	d, err := board.NewDescription(input.Description)
+	mask, err := common.NewUpdateMask(input.UpdateMask, board.UpdatableFields, board.ErrInvalidUpdateMask)
 	if err != nil {
 		return nil, err
 	}
@@ -58,8 +59,25 @@ func (uc *UpdateBoardUseCase) Execute(ctx context.Context, input UpdateBoardInpu
 	if b.OwnerId().UUID() != oid.UUID() {
 		return nil, board.ErrOwnerMismatch
 	}
+	if err := b.CheckVersion(input.ExpectedVersion); err != nil {
+		return nil, err
+	}
 
-	b.Update(t, d)
+	// поля вне маски остаются как есть, их значения в запросе не разбираем
+	title, desc := b.Title(), b.Description()
+	if mask.Has(shboard.FieldTitle) {
+		if title, err = board.NewTitle(input.Title); err != nil {
+			return nil, err
+		}
+	}
+	if mask.Has(shboard.FieldDescription) {
+		if desc, err = board.NewDescription(input.Description); err != nil {
+			return nil, err
+		}
+	}
+	if !b.Update(title, desc) {
+		return &UpdateBoardOutput{Board: b}, nil
+	}
 
 	err = uc.repo.Save(ctx, b)
 	if err != nil {
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 )
 
@@ -13,6 +15,11 @@ type BoardData struct {
 	Board   *board.Board
 	Columns []*column.Column
 	Tasks   []*task.Task
+	Labels  []*label.Label
+	// Checklists — прогресс чек-листов по задачам; задачи без пунктов отсутствуют.
+	Checklists map[task.Id]checklist.Progress
+	// Attachments — число вложений по задачам; задачи без вложений отсутствуют.
+	Attachments map[task.Id]int
 }
 
 type Cacher interface {
@@ -0,0 +1,86 @@
+package checklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+)
+
+type CreateItemUseCase struct {
+	repo    checklist.Repository
+	tasks   task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+}
+
+type CreateItemInput struct {
+	TaskId string
+	Text   string
+	// Position < 0 — добавить в конец.
+	Position int
+}
+
+type CreateItemOutput struct {
+	Item *checklist.Item
+}
+
+func NewCreateItemUseCase(
+	repo checklist.Repository,
+	tasks task.Repository,
+	columns column.Repository,
+	cache cache.Invalidator,
+) *CreateItemUseCase {
+	return &CreateItemUseCase{repo: repo, tasks: tasks, columns: columns, cache: cache}
+}
+
+func (uc *CreateItemUseCase) Execute(ctx context.Context, input CreateItemInput) (*CreateItemOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	text, err := checklist.NewText(input.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	var item *checklist.Item
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.tasks.Get(ctx, tid); err != nil {
+			return err
+		}
+		if err := uc.repo.LockTaskItems(ctx, tid); err != nil {
+			return err
+		}
+
+		n, err := uc.repo.CountInTask(ctx, tid)
+		if err != nil {
+			return err
+		}
+		pos := n
+		if input.Position >= 0 {
+			pos = common.Clamp(input.Position, 0, n)
+		}
+
+		if err := uc.repo.ShiftForInsert(ctx, tid, pos); err != nil {
+			return err
+		}
+
+		item = checklist.New(tid, checklist.Position(pos), text)
+		if err := uc.repo.Save(ctx, item); err != nil {
+			return fmt.Errorf("save checklist item: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateTaskBoard(ctx, uc.tasks, uc.columns, uc.cache, tid)
+
+	return &CreateItemOutput{Item: item}, nil
+}
@@ -0,0 +1,66 @@
+package checklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type DeleteItemUseCase struct {
+	repo    checklist.Repository
+	tasks   task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+}
+
+type DeleteItemInput struct {
+	ItemId string
+}
+
+type DeleteItemOutput struct {
+}
+
+func NewDeleteItemUseCase(
+	repo checklist.Repository,
+	tasks task.Repository,
+	columns column.Repository,
+	cache cache.Invalidator,
+) *DeleteItemUseCase {
+	return &DeleteItemUseCase{repo: repo, tasks: tasks, columns: columns, cache: cache}
+}
+
+func (uc *DeleteItemUseCase) Execute(ctx context.Context, input DeleteItemInput) (*DeleteItemOutput, error) {
+	id, err := checklist.IdFromString(input.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	var tid task.Id
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		item, err := uc.repo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		tid = item.TaskId()
+
+		if err := uc.repo.LockTaskItems(ctx, tid); err != nil {
+			return err
+		}
+		if err := uc.repo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("delete checklist item: %w", err)
+		}
+		// закрываем дыру в нумерации
+		return uc.repo.ShiftAfterRemove(ctx, tid, item.Position().Int())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invalidateTaskBoard(ctx, uc.tasks, uc.columns, uc.cache, tid)
+
+	return &DeleteItemOutput{}, nil
+}
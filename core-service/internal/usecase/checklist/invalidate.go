@@ -0,0 +1,23 @@
+package checklist
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+// invalidateTaskBoard сбрасывает кэш доски, в которой лежит задача: прогресс чек-листа входит в её состав.
+func invalidateTaskBoard(ctx context.Context, tasks task.Repository, columns column.Repository, inv cache.Invalidator, taskId task.Id) {
+	if inv == nil {
+		return
+	}
+	t, err := tasks.Get(ctx, taskId)
+	if err != nil {
+		return
+	}
+	if c, err := columns.Get(ctx, t.ColumnId()); err == nil {
+		_ = inv.InvalidateBoard(ctx, c.BoardId())
+	}
+}
@@ -0,0 +1,52 @@
+package checklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+)
+
+type ListItemsUseCase struct {
+	repo  checklist.Repository
+	tasks task.Repository
+}
+
+type ListItemsInput struct {
+	TaskId string
+}
+
+type ListItemsOutput struct {
+	Items    []*checklist.Item
+	Progress checklist.Progress
+}
+
+func NewListItemsUseCase(repo checklist.Repository, tasks task.Repository) *ListItemsUseCase {
+	return &ListItemsUseCase{repo: repo, tasks: tasks}
+}
+
+func (uc *ListItemsUseCase) Execute(ctx context.Context, input ListItemsInput) (*ListItemsOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.tasks.Get(ctx, tid); err != nil {
+		return nil, err
+	}
+
+	items, err := uc.repo.ListByTask(ctx, tid)
+	if err != nil {
+		return nil, fmt.Errorf("list checklist items: %w", err)
+	}
+
+	progress := checklist.Progress{Total: len(items)}
+	for _, i := range items {
+		if i.Done() {
+			progress.Done++
+		}
+	}
+
+	return &ListItemsOutput{Items: items, Progress: progress}, nil
+}
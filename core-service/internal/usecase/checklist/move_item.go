@@ -0,0 +1,77 @@
+package checklist
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+)
+
+type MoveItemUseCase struct {
+	repo checklist.Repository
+}
+
+type MoveItemInput struct {
+	ItemId     string
+	ToPosition int
+}
+
+type MoveItemOutput struct {
+	Item *checklist.Item
+}
+
+func NewMoveItemUseCase(repo checklist.Repository) *MoveItemUseCase {
+	return &MoveItemUseCase{repo: repo}
+}
+
+func (uc *MoveItemUseCase) Execute(ctx context.Context, input MoveItemInput) (*MoveItemOutput, error) {
+	id, err := checklist.IdFromString(input.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	toPos, err := checklist.NewPosition(input.ToPosition)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *MoveItemOutput
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		item, err := uc.repo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		tid := item.TaskId()
+
+		if err := uc.repo.LockTaskItems(ctx, tid); err != nil {
+			return err
+		}
+		n, err := uc.repo.CountInTask(ctx, tid)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return checklist.ErrEmpty
+		}
+
+		clampedToPos := common.Clamp(int(toPos), 0, n-1)
+		shift, needShift := common.CalcShift(item.Position().Int(), clampedToPos)
+		if needShift {
+			if err := uc.repo.ShiftPositions(ctx, tid, shift.FromPosition, shift.ToPosition, shift.Delta); err != nil {
+				return err
+			}
+		}
+
+		item.Move(checklist.Position(clampedToPos))
+		if err := uc.repo.Save(ctx, item); err != nil {
+			return err
+		}
+
+		out = &MoveItemOutput{Item: item}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,62 @@
+package checklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type SetItemDoneUseCase struct {
+	repo    checklist.Repository
+	tasks   task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+}
+
+type SetItemDoneInput struct {
+	ItemId string
+	Done   bool
+}
+
+type SetItemDoneOutput struct {
+	Item *checklist.Item
+}
+
+func NewSetItemDoneUseCase(
+	repo checklist.Repository,
+	tasks task.Repository,
+	columns column.Repository,
+	cache cache.Invalidator,
+) *SetItemDoneUseCase {
+	return &SetItemDoneUseCase{repo: repo, tasks: tasks, columns: columns, cache: cache}
+}
+
+func (uc *SetItemDoneUseCase) Execute(ctx context.Context, input SetItemDoneInput) (*SetItemDoneOutput, error) {
+	id, err := checklist.IdFromString(input.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Done() == input.Done {
+		return &SetItemDoneOutput{Item: item}, nil
+	}
+
+	item.SetDone(input.Done)
+
+	err = uc.repo.Save(ctx, item)
+	if err != nil {
+		return nil, fmt.Errorf("save checklist item: %w", err)
+	}
+
+	invalidateTaskBoard(ctx, uc.tasks, uc.columns, uc.cache, item.TaskId())
+
+	return &SetItemDoneOutput{Item: item}, nil
+}
@@ -0,0 +1,50 @@
+package checklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/checklist"
+)
+
+type UpdateItemUseCase struct {
+	repo checklist.Repository
+}
+
+type UpdateItemInput struct {
+	ItemId string
+	Text   string
+}
+
+type UpdateItemOutput struct {
+	Item *checklist.Item
+}
+
+func NewUpdateItemUseCase(repo checklist.Repository) *UpdateItemUseCase {
+	return &UpdateItemUseCase{repo: repo}
+}
+
+func (uc *UpdateItemUseCase) Execute(ctx context.Context, input UpdateItemInput) (*UpdateItemOutput, error) {
+	id, err := checklist.IdFromString(input.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	text, err := checklist.NewText(input.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	item.SetText(text)
+
+	err = uc.repo.Save(ctx, item)
+	if err != nil {
+		return nil, fmt.Errorf("save checklist item: %w", err)
+	}
+
+	return &UpdateItemOutput{Item: item}, nil
+}
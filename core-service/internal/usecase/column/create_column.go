@@ -10,21 +10,23 @@ import (
 )
 
 type CreateColumnUseCase struct {
-	repo  column.Repository
-	cache cache.Invalidator
+	repo   column.Repository
+	boards board.Repository
+	cache  cache.Invalidator
 }
 
 type CreateColumnInput struct {
 	BoardId  string
 	Position int
+	Title    string
 }
 
 type CreateColumnOutput struct {
 	Column *column.Column
 }
 
-func NewCreateColumnUseCase(repo column.Repository, cache cache.Invalidator) *CreateColumnUseCase {
-	return &CreateColumnUseCase{repo: repo, cache: cache}
+func NewCreateColumnUseCase(repo column.Repository, boards board.Repository, cache cache.Invalidator) *CreateColumnUseCase {
+	return &CreateColumnUseCase{repo: repo, boards: boards, cache: cache}
 }
 
 func (uc *CreateColumnUseCase) Execute(ctx context.Context, input CreateColumnInput) (*CreateColumnOutput, error) {
@@ -36,8 +38,17 @@ func (uc *CreateColumnUseCase) Execute(ctx context.Context, input CreateColumnIn
 	if err != nil {
 		return nil, err
 	}
+	title, err := column.NewTitle(input.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	// доска из корзины колонок не принимает
+	if _, err := uc.boards.Get(ctx, bid); err != nil {
+		return nil, err
+	}
 
-	c := column.New(bid, position)
+	c := column.New(bid, position, title)
 
 	err = uc.repo.Save(ctx, c)
 	if err != nil {
@@ -30,14 +30,32 @@ func (uc *DeleteColumnUseCase) Execute(ctx context.Context, input DeleteColumnIn
 		return nil, err
 	}
 
-	c, err := uc.repo.Get(ctx, cid)
-	if err != nil {
-		return nil, err
-	}
+	var c *column.Column
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		c, err = uc.repo.Get(ctx, cid)
+		if err != nil {
+			return err
+		}
 
-	err = uc.repo.Delete(ctx, cid)
+		if err := uc.repo.LockBoardColumns(ctx, c.BoardId()); err != nil {
+			return err
+		}
+		c, err = reloadLocked(ctx, uc.repo, cid, c.BoardId())
+		if err != nil {
+			return err
+		}
+		n, err := uc.repo.CountInBoard(ctx, c.BoardId())
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.Delete(ctx, cid); err != nil {
+			return fmt.Errorf("delete column: %w", err)
+		}
+		// сдвигаем следующие колонки на освободившееся место
+		return uc.repo.ShiftPositions(ctx, c.BoardId(), int(c.Position())+1, n-1, -1)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("delete column: %w", err)
+		return nil, err
 	}
 
 	if uc.cache != nil {
@@ -20,6 +20,7 @@ type GetColumnInput struct {
 type GetColumnOutput struct {
 	Column *columndo.Column
 	Tasks  []*taskdo.Task
+	Stats  taskdo.Stats
 }
 
 func NewGetColumnUseCase(columns columndo.Repository, tasks taskdo.Repository) *GetColumnUseCase {
@@ -42,5 +43,5 @@ func (uc *GetColumnUseCase) Execute(ctx context.Context, input GetColumnInput) (
 		return nil, fmt.Errorf("list tasks by column: %w", err)
 	}
 
-	return &GetColumnOutput{Column: c, Tasks: ts}, nil
+	return &GetColumnOutput{Column: c, Tasks: ts, Stats: taskdo.StatsOf(ts)}, nil
 }
@@ -0,0 +1,22 @@
+package column
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+)
+
+// reloadLocked перечитывает колонку после LockBoardColumns: позиция, прочитанная
+// до блокировки, могла устареть из-за параллельного переноса. Если колонку за это
+// время увели на другую доску, блокировка её не защищает — возвращаем конфликт.
+func reloadLocked(ctx context.Context, repo column.Repository, id column.Id, lockedBoard board.Id) (*column.Column, error) {
+	c, err := repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.BoardId() != lockedBoard {
+		return nil, column.ErrConcurrentUpdate
+	}
+	return c, nil
+}
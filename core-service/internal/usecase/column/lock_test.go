@@ -0,0 +1,173 @@
+package column
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+)
+
+type columnRow struct {
+	boardId  board.Id
+	position int
+	version  int64
+}
+
+// memColumns хранит строки колонок как база: Get каждый раз отдаёт свежую копию.
+// onLock вызывается внутри LockBoardColumns и имитирует перенос, завершившийся
+// в другой транзакции, пока эта ждала блокировку.
+type memColumns struct {
+	column.Repository
+	rows   map[column.Id]*columnRow
+	onLock func()
+}
+
+func (r *memColumns) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (r *memColumns) Get(_ context.Context, id column.Id) (*column.Column, error) {
+	row, ok := r.rows[id]
+	if !ok {
+		return nil, column.ErrNotFound
+	}
+	title, _ := column.NewTitle("col")
+	return column.Rehydrate(id, row.boardId, column.Position(row.position), title, time.Time{}, time.Time{}, row.version), nil
+}
+
+func (r *memColumns) Save(_ context.Context, c *column.Column) error {
+	r.rows[c.Id()] = &columnRow{boardId: c.BoardId(), position: c.Position().Int(), version: c.Version() + 1}
+	return nil
+}
+
+func (r *memColumns) Delete(_ context.Context, id column.Id) error {
+	delete(r.rows, id)
+	return nil
+}
+
+func (r *memColumns) LockBoardColumns(context.Context, board.Id) error {
+	if r.onLock != nil {
+		r.onLock()
+		r.onLock = nil
+	}
+	return nil
+}
+
+func (r *memColumns) CountInBoard(_ context.Context, boardId board.Id) (int, error) {
+	n := 0
+	for _, row := range r.rows {
+		if row.boardId == boardId {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memColumns) ShiftPositions(_ context.Context, boardId board.Id, fromIncl, toIncl int, delta int) error {
+	for _, row := range r.rows {
+		if row.boardId == boardId && row.position >= fromIncl && row.position <= toIncl {
+			row.position += delta
+		}
+	}
+	return nil
+}
+
+// newMemBoard создаёт доску с колонками на позициях 0..n-1.
+func newMemBoard(n int) (*memColumns, board.Id, []column.Id) {
+	bid := board.NewId()
+	repo := &memColumns{rows: map[column.Id]*columnRow{}}
+	ids := make([]column.Id, n)
+	for i := range ids {
+		ids[i] = column.NewId()
+		repo.rows[ids[i]] = &columnRow{boardId: bid, position: i}
+	}
+	return repo, bid, ids
+}
+
+func (r *memColumns) positions(ids []column.Id) []int {
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if row, ok := r.rows[id]; ok {
+			out = append(out, row.position)
+		}
+	}
+	return out
+}
+
+func assertPositions(t *testing.T, got []int, want ...int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("positions = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("positions = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestDeleteColumnUsesPositionReadUnderLock(t *testing.T) {
+	repo, _, ids := newMemBoard(4)
+	a, b, c, d := ids[0], ids[1], ids[2], ids[3]
+	// пока ждём блокировку, c переносят в начало: c=0, a=1, b=2
+	repo.onLock = func() {
+		repo.rows[c].position = 0
+		repo.rows[a].position = 1
+		repo.rows[b].position = 2
+	}
+
+	if _, err := NewDeleteColumnUseCase(repo, nil).Execute(context.Background(), DeleteColumnInput{ColumnId: c.String()}); err != nil {
+		t.Fatal(err)
+	}
+	assertPositions(t, repo.positions([]column.Id{a, b, d}), 0, 1, 2)
+}
+
+func TestDeleteColumnMovedToAnotherBoard(t *testing.T) {
+	repo, _, ids := newMemBoard(2)
+	repo.onLock = func() { repo.rows[ids[0]].boardId = board.NewId() }
+
+	_, err := NewDeleteColumnUseCase(repo, nil).Execute(context.Background(), DeleteColumnInput{ColumnId: ids[0].String()})
+	if !errors.Is(err, column.ErrConcurrentUpdate) {
+		t.Fatalf("want ErrConcurrentUpdate, got %v", err)
+	}
+	if _, ok := repo.rows[ids[0]]; !ok {
+		t.Fatal("column must not be deleted")
+	}
+}
+
+func TestMoveColumnUsesPositionReadUnderLock(t *testing.T) {
+	repo, _, ids := newMemBoard(3)
+	a, b, c := ids[0], ids[1], ids[2]
+	repo.onLock = func() {
+		repo.rows[c].position = 0
+		repo.rows[a].position = 1
+		repo.rows[b].position = 2
+	}
+
+	uc := NewMoveColumnUseCase(repo, nil, nil, nil)
+	if _, err := uc.Execute(context.Background(), MoveColumnInput{ColumnId: c.String(), ToPosition: 1}); err != nil {
+		t.Fatal(err)
+	}
+	assertPositions(t, repo.positions([]column.Id{a, c, b}), 0, 1, 2)
+}
+
+func TestMoveColumnChecksVersionUnderLock(t *testing.T) {
+	repo, _, ids := newMemBoard(2)
+	repo.onLock = func() { repo.rows[ids[1]].version++ }
+
+	uc := NewMoveColumnUseCase(repo, nil, nil, nil)
+	_, err := uc.Execute(context.Background(), MoveColumnInput{ColumnId: ids[1].String(), ToPosition: 0, ExpectedVersion: 0})
+	if err != nil {
+		t.Fatalf("version 0 skips the check: %v", err)
+	}
+
+	repo.onLock = func() { repo.rows[ids[1]].version++ }
+	v := repo.rows[ids[1]].version
+	_, err = uc.Execute(context.Background(), MoveColumnInput{ColumnId: ids[1].String(), ToPosition: 1, ExpectedVersion: v})
+	if !errors.Is(err, column.ErrVersionMismatch) {
+		t.Fatalf("want ErrVersionMismatch, got %v", err)
+	}
+}
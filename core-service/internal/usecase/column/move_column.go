@@ -6,26 +6,36 @@ import (
 
 	"github.com/smarrog/task-board/core-service/internal/domain/board"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
 	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
 	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	"github.com/smarrog/task-board/shared/domain/shared"
 )
 
 type MoveColumnUseCase struct {
-	repo  column.Repository
-	cache cache.Invalidator
+	repo   column.Repository
+	boards board.Repository
+	labels label.Repository
+	cache  cache.Invalidator
 }
 
 type MoveColumnInput struct {
-	ColumnId   string
+	// RequesterId нужен только при переносе между досками: обе доски должны быть его.
+	RequesterId string
+	ColumnId    string
+	// ToBoardId — необязательная доска назначения; пустая — перенос внутри доски.
+	ToBoardId  string
 	ToPosition int
+	// ExpectedVersion — версия из If-Match; 0 — без проверки.
+	ExpectedVersion int64
 }
 
 type MoveColumnOutput struct {
 	Column *column.Column
 }
 
-func NewMoveColumnUseCase(repo column.Repository, cache cache.Invalidator) *MoveColumnUseCase {
-	return &MoveColumnUseCase{repo: repo, cache: cache}
+func NewMoveColumnUseCase(repo column.Repository, boards board.Repository, labels label.Repository, cache cache.Invalidator) *MoveColumnUseCase {
+	return &MoveColumnUseCase{repo: repo, boards: boards, labels: labels, cache: cache}
 }
 
 func (uc *MoveColumnUseCase) Execute(ctx context.Context, input MoveColumnInput) (*MoveColumnOutput, error) {
@@ -39,8 +49,17 @@ func (uc *MoveColumnUseCase) Execute(ctx context.Context, input MoveColumnInput)
 		return nil, err
 	}
 
+	var toBoard *board.Id
+	if input.ToBoardId != "" {
+		bid, err := board.IdFromString(input.ToBoardId)
+		if err != nil {
+			return nil, err
+		}
+		toBoard = &bid
+	}
+
 	var out *MoveColumnOutput
-	var bidToInvalidate *board.Id
+	var bidsToInvalidate []board.Id
 
 	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
 		c, err := uc.repo.Get(ctx, cid)
@@ -49,12 +68,29 @@ func (uc *MoveColumnUseCase) Execute(ctx context.Context, input MoveColumnInput)
 		}
 
 		bid := c.BoardId()
-		bidToInvalidate = &bid
-		fromPos := int(c.Position())
+		bidsToInvalidate = append(bidsToInvalidate, bid)
+
+		if toBoard != nil && *toBoard != bid {
+			bidsToInvalidate = append(bidsToInvalidate, *toBoard)
+			c, err = uc.moveToBoard(ctx, cid, bid, *toBoard, int(toPos), input.RequesterId, input.ExpectedVersion)
+			if err != nil {
+				return err
+			}
+			out = &MoveColumnOutput{Column: c}
+			return nil
+		}
 
 		if err := uc.repo.LockBoardColumns(ctx, bid); err != nil {
 			return err
 		}
+		c, err = reloadLocked(ctx, uc.repo, cid, bid)
+		if err != nil {
+			return err
+		}
+		if err := c.CheckVersion(input.ExpectedVersion); err != nil {
+			return err
+		}
+		fromPos := int(c.Position())
 
 		n, err := uc.repo.CountInBoard(ctx, bid)
 		if err != nil {
@@ -85,9 +121,70 @@ func (uc *MoveColumnUseCase) Execute(ctx context.Context, input MoveColumnInput)
 		return nil, err
 	}
 
-	if uc.cache != nil && bidToInvalidate != nil {
-		_ = uc.cache.InvalidateBoard(ctx, *bidToInvalidate)
+	if uc.cache != nil {
+		for _, bid := range bidsToInvalidate {
+			_ = uc.cache.InvalidateBoard(ctx, bid)
+		}
 	}
 
 	return out, nil
 }
+
+// moveToBoard переносит колонку со всеми задачами на другую доску: на исходной доске
+// следующие колонки сдвигаются на её место, на новой — освобождают место под неё.
+func (uc *MoveColumnUseCase) moveToBoard(ctx context.Context, cid column.Id, fromBoard, toBoard board.Id, toPos int, requesterId string, expectedVersion int64) (*column.Column, error) {
+	rid, err := shared.UserIdFromString(requesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+	if err := common.CheckCrossBoard(ctx, uc.boards, rid, fromBoard, toBoard); err != nil {
+		return nil, err
+	}
+
+	// блокируем доски в одном порядке, чтобы встречные переносы не ждали друг друга
+	first, second := fromBoard, toBoard
+	if second.String() < first.String() {
+		first, second = second, first
+	}
+	if err := uc.repo.LockBoardColumns(ctx, first); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.LockBoardColumns(ctx, second); err != nil {
+		return nil, err
+	}
+	c, err := reloadLocked(ctx, uc.repo, cid, fromBoard)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.CheckVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
+	nFrom, err := uc.repo.CountInBoard(ctx, fromBoard)
+	if err != nil {
+		return nil, err
+	}
+	nTo, err := uc.repo.CountInBoard(ctx, toBoard)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.ShiftPositions(ctx, fromBoard, int(c.Position())+1, nFrom-1, -1); err != nil {
+		return nil, err
+	}
+	pos := common.Clamp(toPos, 0, nTo)
+	if err := uc.repo.ShiftPositions(ctx, toBoard, pos, nTo-1, 1); err != nil {
+		return nil, err
+	}
+
+	c.MoveToBoard(toBoard, column.Position(pos))
+	if err := uc.repo.Save(ctx, c); err != nil {
+		return nil, err
+	}
+
+	// метки принадлежат доске: задачи колонки получают одноимённые метки новой доски
+	if err := uc.labels.RetargetColumn(ctx, c.Id(), toBoard); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,85 @@
+package column
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type ReorderColumnsUseCase struct {
+	repo   column.Repository
+	boards board.Repository
+	cache  cache.Invalidator
+}
+
+type ReorderColumnsInput struct {
+	BoardId string
+	// ColumnIds — все живые колонки доски в новом порядке.
+	ColumnIds []string
+}
+
+type ReorderColumnsOutput struct {
+	Columns []*column.Column
+}
+
+func NewReorderColumnsUseCase(repo column.Repository, boards board.Repository, cache cache.Invalidator) *ReorderColumnsUseCase {
+	return &ReorderColumnsUseCase{repo: repo, boards: boards, cache: cache}
+}
+
+func (uc *ReorderColumnsUseCase) Execute(ctx context.Context, input ReorderColumnsInput) (*ReorderColumnsOutput, error) {
+	bid, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]column.Id, 0, len(input.ColumnIds))
+	for _, raw := range input.ColumnIds {
+		id, err := column.IdFromString(raw)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	var out *ReorderColumnsOutput
+	changed := false
+
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.boards.Get(ctx, bid); err != nil {
+			return err
+		}
+
+		// набор колонок сверяем уже под блокировкой, иначе параллельное создание
+		// или удаление колонки проскочит между проверкой и записью
+		if err := uc.repo.LockBoardColumns(ctx, bid); err != nil {
+			return err
+		}
+		cols, err := uc.repo.ListByBoard(ctx, bid)
+		if err != nil {
+			return err
+		}
+
+		o, err := column.NewOrder(bid, cols, ids)
+		if err != nil {
+			return err
+		}
+		out = &ReorderColumnsOutput{Columns: o.Columns()}
+		if !o.Changed() {
+			return nil
+		}
+		changed = true
+
+		return uc.repo.SaveOrder(ctx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if changed && uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, bid)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,81 @@
+package column
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+)
+
+type RestoreColumnUseCase struct {
+	repo   column.Repository
+	boards board.Repository
+	cache  cache.Invalidator
+}
+
+type RestoreColumnInput struct {
+	ColumnId string
+}
+
+type RestoreColumnOutput struct {
+	Column *column.Column
+}
+
+func NewRestoreColumnUseCase(repo column.Repository, boards board.Repository, cache cache.Invalidator) *RestoreColumnUseCase {
+	return &RestoreColumnUseCase{repo: repo, boards: boards, cache: cache}
+}
+
+func (uc *RestoreColumnUseCase) Execute(ctx context.Context, input RestoreColumnInput) (*RestoreColumnOutput, error) {
+	cid, err := column.IdFromString(input.ColumnId)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *RestoreColumnOutput
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		trashed, err := uc.repo.GetTrashed(ctx, cid)
+		if err != nil {
+			return err
+		}
+		c := trashed.Column
+
+		if _, err := uc.boards.Get(ctx, c.BoardId()); errors.Is(err, board.ErrNotFound) {
+			return column.ErrBoardDeleted
+		} else if err != nil {
+			return err
+		}
+
+		if err := uc.repo.LockBoardColumns(ctx, c.BoardId()); err != nil {
+			return err
+		}
+		n, err := uc.repo.CountInBoard(ctx, c.BoardId())
+		if err != nil {
+			return err
+		}
+
+		pos := common.Clamp(c.Position().Int(), 0, n)
+		if err := uc.repo.ShiftPositions(ctx, c.BoardId(), pos, n-1, 1); err != nil {
+			return err
+		}
+
+		c.Restore(column.Position(pos))
+		if err := uc.repo.Restore(ctx, c); err != nil {
+			return err
+		}
+
+		out = &RestoreColumnOutput{Column: c}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, out.Column.BoardId())
+	}
+
+	return out, nil
+}
@@ -0,0 +1,61 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/comment"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type CreateCommentUseCase struct {
+	repo     comment.Repository
+	tasks    task.Repository
+	resolver comment.MentionResolver
+	log      *zerolog.Logger
+}
+
+type CreateCommentInput struct {
+	TaskId   string
+	AuthorId string
+	Body     string
+}
+
+type CreateCommentOutput struct {
+	Comment *comment.Comment
+}
+
+func NewCreateCommentUseCase(repo comment.Repository, tasks task.Repository, resolver comment.MentionResolver, log *zerolog.Logger) *CreateCommentUseCase {
+	return &CreateCommentUseCase{repo: repo, tasks: tasks, resolver: resolver, log: log}
+}
+
+func (uc *CreateCommentUseCase) Execute(ctx context.Context, input CreateCommentInput) (*CreateCommentOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	authorId, err := shared.UserIdFromString(input.AuthorId)
+	if err != nil {
+		return nil, fmt.Errorf("author_id: %w", err)
+	}
+	body, err := comment.NewBody(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.tasks.Get(ctx, tid); err != nil {
+		return nil, err
+	}
+
+	mentions, _ := resolveMentions(ctx, uc.resolver, uc.log, body)
+
+	c := comment.New(tid, authorId, body, mentions)
+	if err := uc.repo.Save(ctx, c); err != nil {
+		return nil, fmt.Errorf("save comment: %w", err)
+	}
+
+	return &CreateCommentOutput{Comment: c}, nil
+}
@@ -0,0 +1,49 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/comment"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type DeleteCommentUseCase struct {
+	repo comment.Repository
+}
+
+type DeleteCommentInput struct {
+	CommentId   string
+	RequesterId string
+}
+
+type DeleteCommentOutput struct{}
+
+func NewDeleteCommentUseCase(repo comment.Repository) *DeleteCommentUseCase {
+	return &DeleteCommentUseCase{repo: repo}
+}
+
+func (uc *DeleteCommentUseCase) Execute(ctx context.Context, input DeleteCommentInput) (*DeleteCommentOutput, error) {
+	id, err := comment.IdFromString(input.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	requesterId, err := shared.UserIdFromString(input.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+
+	c, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.AuthorId() != requesterId {
+		return nil, comment.ErrAuthorMismatch
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return nil, fmt.Errorf("delete comment: %w", err)
+	}
+
+	return &DeleteCommentOutput{}, nil
+}
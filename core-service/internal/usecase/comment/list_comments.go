@@ -0,0 +1,74 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/comment"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+type ListCommentsUseCase struct {
+	repo  comment.Repository
+	tasks task.Repository
+}
+
+type ListCommentsInput struct {
+	TaskId   string
+	PageSize int
+	Cursor   string
+}
+
+type ListCommentsOutput struct {
+	Comments   []*comment.Comment
+	NextCursor string
+}
+
+func NewListCommentsUseCase(repo comment.Repository, tasks task.Repository) *ListCommentsUseCase {
+	return &ListCommentsUseCase{repo: repo, tasks: tasks}
+}
+
+func (uc *ListCommentsUseCase) Execute(ctx context.Context, input ListCommentsInput) (*ListCommentsOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	q := comment.ListQuery{TaskId: tid}
+	if input.Cursor != "" {
+		cur, err := comment.DecodeCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		q.After = &cur
+	}
+
+	limit := defaultPageSize
+	if input.PageSize > 0 {
+		limit = common.Clamp(input.PageSize, 1, maxPageSize)
+	}
+	// Берём на одну запись больше, чтобы понять, есть ли следующая страница.
+	q.Limit = limit + 1
+
+	if _, err := uc.tasks.Get(ctx, tid); err != nil {
+		return nil, err
+	}
+
+	comments, err := uc.repo.ListByTask(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("list comments: %w", err)
+	}
+
+	out := &ListCommentsOutput{Comments: comments}
+	if len(comments) > limit {
+		out.Comments = comments[:limit]
+		out.NextCursor = comment.CursorAfter(out.Comments[limit-1]).Encode()
+	}
+	return out, nil
+}
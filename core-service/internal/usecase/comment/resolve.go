@@ -0,0 +1,25 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/comment"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+// resolveMentions без резолвера (auth-service не настроен) просто не распознаёт упоминания.
+// Сбой auth-service не мешает сохранить комментарий, но ok = false: упоминаний этот вызов не знает.
+func resolveMentions(ctx context.Context, resolver comment.MentionResolver, log *zerolog.Logger, body comment.Body) ([]shared.UserId, bool) {
+	names := body.Mentions()
+	if resolver == nil || len(names) == 0 {
+		return nil, true
+	}
+	ids, err := resolver.Resolve(ctx, names)
+	if err != nil {
+		log.Warn().Err(err).Strs("usernames", names).Msg("failed to resolve comment mentions")
+		return nil, false
+	}
+	return ids, true
+}
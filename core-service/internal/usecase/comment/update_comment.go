@@ -0,0 +1,69 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/comment"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type UpdateCommentUseCase struct {
+	repo     comment.Repository
+	resolver comment.MentionResolver
+	log      *zerolog.Logger
+}
+
+type UpdateCommentInput struct {
+	CommentId string
+	EditorId  string
+	Body      string
+}
+
+type UpdateCommentOutput struct {
+	Comment *comment.Comment
+}
+
+func NewUpdateCommentUseCase(repo comment.Repository, resolver comment.MentionResolver, log *zerolog.Logger) *UpdateCommentUseCase {
+	return &UpdateCommentUseCase{repo: repo, resolver: resolver, log: log}
+}
+
+func (uc *UpdateCommentUseCase) Execute(ctx context.Context, input UpdateCommentInput) (*UpdateCommentOutput, error) {
+	id, err := comment.IdFromString(input.CommentId)
+	if err != nil {
+		return nil, err
+	}
+	editorId, err := shared.UserIdFromString(input.EditorId)
+	if err != nil {
+		return nil, fmt.Errorf("editor_id: %w", err)
+	}
+	body, err := comment.NewBody(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c.AuthorId() != editorId {
+		return nil, comment.ErrAuthorMismatch
+	}
+
+	mentions, ok := resolveMentions(ctx, uc.resolver, uc.log, body)
+	if !ok {
+		// прежние упоминания оставляем: иначе следующая правка уведомила бы всех заново
+		mentions = c.Mentions()
+	}
+
+	if err := c.Edit(editorId, body, mentions); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Save(ctx, c); err != nil {
+		return nil, fmt.Errorf("save comment: %w", err)
+	}
+
+	return &UpdateCommentOutput{Comment: c}, nil
+}
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+// CheckBoardOwner проверяет, что доска boardId принадлежит requesterId.
+func CheckBoardOwner(ctx context.Context, boards board.Repository, requesterId shared.UserId, boardId board.Id) error {
+	b, err := boards.Get(ctx, boardId)
+	if err != nil {
+		return err
+	}
+	if b.OwnerId() != requesterId {
+		return board.ErrOwnerMismatch
+	}
+	return nil
+}
+
+// CheckCrossBoard проверяет перенос между досками: обе доски должны принадлежать
+// requesterId, а доска назначения не должна лежать в архиве.
+func CheckCrossBoard(ctx context.Context, boards board.Repository, requesterId shared.UserId, fromId, toId board.Id) error {
+	from, err := boards.Get(ctx, fromId)
+	if err != nil {
+		return err
+	}
+	to, err := boards.Get(ctx, toId)
+	if err != nil {
+		return err
+	}
+	if from.OwnerId() != requesterId || to.OwnerId() != requesterId {
+		return board.ErrOwnerMismatch
+	}
+	if to.IsArchived() {
+		return board.ErrArchived
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package common
+
+import "slices"
+
+// UpdateMask — поля, которые меняет частичное обновление; пустая маска означает все поля.
+type UpdateMask struct {
+	fields map[string]struct{}
+}
+
+// NewUpdateMask проверяет пути маски по списку allowed; на неизвестный путь возвращает invalid.
+func NewUpdateMask(paths, allowed []string, invalid error) (UpdateMask, error) {
+	if len(paths) == 0 {
+		return UpdateMask{}, nil
+	}
+	fields := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		if !slices.Contains(allowed, p) {
+			return UpdateMask{}, invalid
+		}
+		fields[p] = struct{}{}
+	}
+	return UpdateMask{fields: fields}, nil
+}
+
+func (m UpdateMask) Has(field string) bool {
+	if m.fields == nil {
+		return true
+	}
+	_, ok := m.fields[field]
+	return ok
+}
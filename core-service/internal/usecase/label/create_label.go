@@ -0,0 +1,73 @@
+package label
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type CreateLabelUseCase struct {
+	repo   label.Repository
+	boards board.Repository
+	cache  cache.Invalidator
+}
+
+type CreateLabelInput struct {
+	RequesterId string
+	BoardId     string
+	Name        string
+	Color       string
+}
+
+type CreateLabelOutput struct {
+	Label *label.Label
+}
+
+func NewCreateLabelUseCase(repo label.Repository, boards board.Repository, cache cache.Invalidator) *CreateLabelUseCase {
+	return &CreateLabelUseCase{repo: repo, boards: boards, cache: cache}
+}
+
+func (uc *CreateLabelUseCase) Execute(ctx context.Context, input CreateLabelInput) (*CreateLabelOutput, error) {
+	bid, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+	requesterId, err := shared.UserIdFromString(input.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+	name, err := label.NewName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	color, err := label.NewColor(input.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common.CheckBoardOwner(ctx, uc.boards, requesterId, bid); err != nil {
+		return nil, err
+	}
+
+	l := label.New(bid, name, color)
+
+	err = uc.repo.Save(ctx, l)
+	if err != nil {
+		return nil, fmt.Errorf("save label: %w", err)
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, bid)
+	}
+
+	output := &CreateLabelOutput{
+		Label: l,
+	}
+
+	return output, nil
+}
@@ -0,0 +1,89 @@
+package label
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type DeleteLabelUseCase struct {
+	repo   label.Repository
+	boards board.Repository
+	tasks  task.Repository
+	cache  cache.Invalidator
+}
+
+type DeleteLabelInput struct {
+	RequesterId string
+	LabelId     string
+}
+
+type DeleteLabelOutput struct {
+}
+
+func NewDeleteLabelUseCase(repo label.Repository, boards board.Repository, tasks task.Repository, cache cache.Invalidator) *DeleteLabelUseCase {
+	return &DeleteLabelUseCase{repo: repo, boards: boards, tasks: tasks, cache: cache}
+}
+
+func (uc *DeleteLabelUseCase) Execute(ctx context.Context, input DeleteLabelInput) (*DeleteLabelOutput, error) {
+	lid, err := label.IdFromString(input.LabelId)
+	if err != nil {
+		return nil, err
+	}
+	requesterId, err := shared.UserIdFromString(input.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+
+	l, err := uc.repo.Get(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+	if err := common.CheckBoardOwner(ctx, uc.boards, requesterId, l.BoardId()); err != nil {
+		return nil, err
+	}
+
+	err = uc.tasks.InTx(ctx, func(ctx context.Context) error {
+		// Снимаем метку с задач через агрегат, чтобы ушли TaskUpdated с диффом.
+		ts, err := uc.tasks.ListByLabel(ctx, lid)
+		if err != nil {
+			return fmt.Errorf("list tasks: %w", err)
+		}
+		for _, t := range ts {
+			rest := make([]label.Id, 0, len(t.LabelIds()))
+			for _, id := range t.LabelIds() {
+				if id != lid {
+					rest = append(rest, id)
+				}
+			}
+			if !t.SetLabels(rest) {
+				continue
+			}
+			if err := uc.tasks.Save(ctx, t); err != nil {
+				return fmt.Errorf("save task: %w", err)
+			}
+		}
+
+		if err := uc.repo.Delete(ctx, lid); err != nil {
+			return fmt.Errorf("delete label: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, l.BoardId())
+	}
+
+	output := &DeleteLabelOutput{}
+
+	return output, nil
+}
@@ -0,0 +1,55 @@
+package label
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type ListLabelsUseCase struct {
+	repo   label.Repository
+	boards board.Repository
+}
+
+type ListLabelsInput struct {
+	RequesterId string
+	BoardId     string
+}
+
+type ListLabelsOutput struct {
+	Labels []*label.Label
+}
+
+func NewListLabelsUseCase(repo label.Repository, boards board.Repository) *ListLabelsUseCase {
+	return &ListLabelsUseCase{repo: repo, boards: boards}
+}
+
+func (uc *ListLabelsUseCase) Execute(ctx context.Context, input ListLabelsInput) (*ListLabelsOutput, error) {
+	bid, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+	requesterId, err := shared.UserIdFromString(input.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+
+	if err := common.CheckBoardOwner(ctx, uc.boards, requesterId, bid); err != nil {
+		return nil, err
+	}
+
+	labels, err := uc.repo.ListByBoard(ctx, bid)
+	if err != nil {
+		return nil, fmt.Errorf("list labels: %w", err)
+	}
+
+	output := &ListLabelsOutput{
+		Labels: labels,
+	}
+
+	return output, nil
+}
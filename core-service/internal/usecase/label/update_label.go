@@ -0,0 +1,78 @@
+package label
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type UpdateLabelUseCase struct {
+	repo   label.Repository
+	boards board.Repository
+	cache  cache.Invalidator
+}
+
+type UpdateLabelInput struct {
+	RequesterId string
+	LabelId     string
+	Name        string
+	Color       string
+}
+
+type UpdateLabelOutput struct {
+	Label *label.Label
+}
+
+func NewUpdateLabelUseCase(repo label.Repository, boards board.Repository, cache cache.Invalidator) *UpdateLabelUseCase {
+	return &UpdateLabelUseCase{repo: repo, boards: boards, cache: cache}
+}
+
+func (uc *UpdateLabelUseCase) Execute(ctx context.Context, input UpdateLabelInput) (*UpdateLabelOutput, error) {
+	lid, err := label.IdFromString(input.LabelId)
+	if err != nil {
+		return nil, err
+	}
+	requesterId, err := shared.UserIdFromString(input.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+	name, err := label.NewName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	color, err := label.NewColor(input.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := uc.repo.Get(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common.CheckBoardOwner(ctx, uc.boards, requesterId, l.BoardId()); err != nil {
+		return nil, err
+	}
+
+	l.Update(name, color)
+
+	err = uc.repo.Save(ctx, l)
+	if err != nil {
+		return nil, fmt.Errorf("save label: %w", err)
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, l.BoardId())
+	}
+
+	output := &UpdateLabelOutput{
+		Label: l,
+	}
+
+	return output, nil
+}
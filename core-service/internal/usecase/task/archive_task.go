@@ -0,0 +1,54 @@
+package task
+
+import (
+	"context"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type ArchiveTaskUseCase struct {
+	repo    task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+}
+
+type ArchiveTaskInput struct {
+	TaskId string
+}
+
+type ArchiveTaskOutput struct {
+	Task *task.Task
+}
+
+func NewArchiveTaskUseCase(repo task.Repository, columns column.Repository, cache cache.Invalidator) *ArchiveTaskUseCase {
+	return &ArchiveTaskUseCase{repo: repo, columns: columns, cache: cache}
+}
+
+func (uc *ArchiveTaskUseCase) Execute(ctx context.Context, input ArchiveTaskInput) (*ArchiveTaskOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := uc.repo.Get(ctx, tid)
+	if err != nil {
+		return nil, err
+	}
+	// ключ порядка остаётся, но соседи его больше не учитывают — сдвигать никого не нужно
+	if err := t.Archive(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Save(ctx, t); err != nil {
+		return nil, err
+	}
+
+	if uc.cache != nil {
+		if c, err := uc.columns.Get(ctx, t.ColumnId()); err == nil {
+			_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
+		}
+	}
+
+	return &ArchiveTaskOutput{Task: t}, nil
+}
@@ -0,0 +1,63 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type AssignTaskUseCase struct {
+	repo    task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+}
+
+type AssignTaskInput struct {
+	TaskId     string
+	AssigneeId string
+}
+
+type AssignTaskOutput struct {
+	Task *task.Task
+}
+
+func NewAssignTaskUseCase(repo task.Repository, columns column.Repository, cache cache.Invalidator) *AssignTaskUseCase {
+	return &AssignTaskUseCase{repo: repo, columns: columns, cache: cache}
+}
+
+// Execute меняет только исполнителя, остальные поля задачи остаются как есть.
+func (uc *AssignTaskUseCase) Execute(ctx context.Context, input AssignTaskInput) (*AssignTaskOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	aid, err := shared.UserIdFromString(input.AssigneeId)
+	if err != nil {
+		return nil, fmt.Errorf("task assignee_id: %w", err)
+	}
+
+	t, err := uc.repo.Get(ctx, tid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !t.Update(t.Title(), t.Description(), aid, t.Dates(), t.Priority(), t.Estimate()) {
+		return &AssignTaskOutput{Task: t}, nil
+	}
+
+	if err := uc.repo.Save(ctx, t); err != nil {
+		return nil, fmt.Errorf("save task: %w", err)
+	}
+
+	if uc.cache != nil {
+		if c, err := uc.columns.Get(ctx, t.ColumnId()); err == nil {
+			_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
+		}
+	}
+
+	return &AssignTaskOutput{Task: t}, nil
+}
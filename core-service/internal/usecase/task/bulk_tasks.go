@@ -0,0 +1,207 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+const MaxBulkOperations = 100
+
+var (
+	ErrNoOperations        = fmt.Errorf("%s %w", "bulk operations", shared.ErrIsEmpty)
+	ErrTooManyOperations   = fmt.Errorf("%s %w", "bulk operations", shared.ErrIsTooLong)
+	ErrInvalidOperation    = fmt.Errorf("%s %w", "bulk operation", shared.ErrIsInvalid)
+	ErrOperationNotApplied = errors.New("operation not applied: batch aborted")
+)
+
+// BulkTasksUseCase применяет пачку операций над задачами. Сами операции выполняют
+// обычные сценарии без кэша, а кэш каждой затронутой доски сбрасывается один раз в конце.
+type BulkTasksUseCase struct {
+	repo    task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+
+	move   *MoveTaskUseCase
+	update *UpdateTaskUseCase
+	delete *DeleteTaskUseCase
+	assign *AssignTaskUseCase
+}
+
+// BulkTaskOperation — ровно одна из операций.
+type BulkTaskOperation struct {
+	Move   *MoveTaskInput
+	Update *UpdateTaskInput
+	Delete *DeleteTaskInput
+	Assign *AssignTaskInput
+}
+
+type BulkTasksInput struct {
+	RequesterId string
+	Operations  []BulkTaskOperation
+	// BestEffort — каждая операция в своей транзакции, ошибки не отменяют остальные.
+	// По умолчанию пачка применяется целиком в одной транзакции или не применяется вовсе.
+	BestEffort bool
+}
+
+// BulkTaskResult — итог операции; Task пуст для удаления и при ошибке.
+type BulkTaskResult struct {
+	Task *task.Task
+	Err  error
+}
+
+type BulkTasksOutput struct {
+	Results []BulkTaskResult
+}
+
+func NewBulkTasksUseCase(
+	repo task.Repository,
+	columns column.Repository,
+	boards board.Repository,
+	labels label.Repository,
+	cache cache.Invalidator,
+) *BulkTasksUseCase {
+	return &BulkTasksUseCase{
+		repo:    repo,
+		columns: columns,
+		cache:   cache,
+		move:    NewMoveTaskUseCase(repo, columns, boards, labels, nil),
+		update:  NewUpdateTaskUseCase(repo, columns, nil),
+		delete:  NewDeleteTaskUseCase(repo, columns, nil),
+		assign:  NewAssignTaskUseCase(repo, columns, nil),
+	}
+}
+
+func (uc *BulkTasksUseCase) Execute(ctx context.Context, input BulkTasksInput) (*BulkTasksOutput, error) {
+	if len(input.Operations) == 0 {
+		return nil, ErrNoOperations
+	}
+	if len(input.Operations) > MaxBulkOperations {
+		return nil, ErrTooManyOperations
+	}
+
+	results := make([]BulkTaskResult, len(input.Operations))
+	touched := make(map[board.Id]struct{})
+
+	if input.BestEffort {
+		for i, op := range input.Operations {
+			boards := make(map[board.Id]struct{})
+			err := uc.repo.InTx(ctx, func(ctx context.Context) error {
+				t, err := uc.apply(ctx, op, input.RequesterId, boards)
+				results[i] = BulkTaskResult{Task: t, Err: err}
+				return err
+			})
+			if err == nil {
+				for bid := range boards {
+					touched[bid] = struct{}{}
+				}
+			}
+		}
+	} else {
+		failed := false
+		err := uc.repo.InTx(ctx, func(ctx context.Context) error {
+			for i, op := range input.Operations {
+				t, err := uc.apply(ctx, op, input.RequesterId, touched)
+				if err != nil {
+					// откат отменяет и уже выполненные операции
+					for j := range results {
+						results[j] = BulkTaskResult{Err: ErrOperationNotApplied}
+					}
+					results[i] = BulkTaskResult{Err: err}
+					failed = true
+					return err
+				}
+				results[i] = BulkTaskResult{Task: t}
+			}
+			return nil
+		})
+		if err != nil && !failed {
+			return nil, err
+		}
+		if err != nil {
+			clear(touched)
+		}
+	}
+
+	if uc.cache != nil {
+		for bid := range touched {
+			_ = uc.cache.InvalidateBoard(ctx, bid)
+		}
+	}
+
+	return &BulkTasksOutput{Results: results}, nil
+}
+
+// apply выполняет одну операцию и запоминает доски, которые она затронула.
+func (uc *BulkTasksUseCase) apply(ctx context.Context, op BulkTaskOperation, requesterId string, boards map[board.Id]struct{}) (*task.Task, error) {
+	set := 0
+	for _, ok := range []bool{op.Move != nil, op.Update != nil, op.Delete != nil, op.Assign != nil} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, ErrInvalidOperation
+	}
+
+	switch {
+	case op.Move != nil:
+		uc.rememberBoard(ctx, op.Move.TaskId, boards)
+		in := *op.Move
+		in.RequesterId = requesterId
+		out, err := uc.move.Execute(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		uc.rememberBoard(ctx, in.TaskId, boards)
+		return out.Task, nil
+
+	case op.Update != nil:
+		uc.rememberBoard(ctx, op.Update.TaskId, boards)
+		out, err := uc.update.Execute(ctx, *op.Update)
+		if err != nil {
+			return nil, err
+		}
+		return out.Task, nil
+
+	case op.Delete != nil:
+		uc.rememberBoard(ctx, op.Delete.TaskId, boards)
+		if _, err := uc.delete.Execute(ctx, *op.Delete); err != nil {
+			return nil, err
+		}
+		return nil, nil
+
+	default:
+		uc.rememberBoard(ctx, op.Assign.TaskId, boards)
+		out, err := uc.assign.Execute(ctx, *op.Assign)
+		if err != nil {
+			return nil, err
+		}
+		return out.Task, nil
+	}
+}
+
+// rememberBoard добавляет доску, на которой сейчас стоит задача; ошибки поиска
+// не важны — их всё равно вернёт сама операция.
+func (uc *BulkTasksUseCase) rememberBoard(ctx context.Context, rawTaskId string, boards map[board.Id]struct{}) {
+	tid, err := task.IdFromString(rawTaskId)
+	if err != nil {
+		return
+	}
+	t, err := uc.repo.Get(ctx, tid)
+	if err != nil {
+		return
+	}
+	c, err := uc.columns.Get(ctx, t.ColumnId())
+	if err != nil {
+		return
+	}
+	boards[c.BoardId()] = struct{}{}
+}
@@ -3,10 +3,12 @@ package task
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
 	"github.com/smarrog/task-board/shared/domain/shared"
 )
 
@@ -22,6 +24,11 @@ type CreateTaskInput struct {
 	Title       string
 	Description string
 	AssigneeId  string
+	StartAt     time.Time
+	DueAt       time.Time
+	Priority    string
+	// Estimate — nil значит «без оценки».
+	Estimate *float64
 }
 
 type CreateTaskOutput struct {
@@ -53,18 +60,49 @@ func (uc *CreateTaskUseCase) Execute(ctx context.Context, input CreateTaskInput)
 	if err != nil {
 		return nil, fmt.Errorf("task assignee_id: %w", err)
 	}
+	dates, err := task.NewDates(input.StartAt, input.DueAt)
+	if err != nil {
+		return nil, err
+	}
+	priority, err := task.NewPriority(input.Priority)
+	if err != nil {
+		return nil, err
+	}
+	estimate, err := task.EstimateFromPtr(input.Estimate)
+	if err != nil {
+		return nil, err
+	}
+
+	var c *column.Column
+	var t *task.Task
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		c, err = uc.columns.Get(ctx, cid)
+		if err != nil {
+			return err
+		}
 
-	t := task.New(cid, position, title, desc, aid)
+		n, err := uc.repo.CountInColumn(ctx, cid)
+		if err != nil {
+			return err
+		}
+		pos := common.Clamp(position.Int(), 0, n)
+		key, err := uc.repo.SortKeyAt(ctx, cid, pos, task.Id{})
+		if err != nil {
+			return err
+		}
 
-	err = uc.repo.Save(ctx, t)
+		t = task.New(cid, task.Position(pos), key, title, desc, aid, dates, priority, estimate)
+		if err := uc.repo.Save(ctx, t); err != nil {
+			return fmt.Errorf("save task: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("save task: %w", err)
+		return nil, err
 	}
 
 	if uc.cache != nil {
-		if c, err := uc.columns.Get(ctx, cid); err == nil {
-			_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
-		}
+		_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
 	}
 
 	output = &CreateTaskOutput{
@@ -37,6 +37,7 @@ func (uc *DeleteTaskUseCase) Execute(ctx context.Context, input DeleteTaskInput)
 		return nil, err
 	}
 
+	// позиции выводятся из ключей порядка, так что дыры после удаления не остаётся
 	err = uc.repo.Delete(ctx, tid)
 	if err != nil {
 		return nil, fmt.Errorf("delete task: %w", err)
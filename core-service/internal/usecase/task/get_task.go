@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/smarrog/task-board/core-service/internal/domain/attachment"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 )
 
 type GetTaskUseCase struct {
-	repo task.Repository
+	repo        task.Repository
+	attachments attachment.Repository
 }
 
 type GetTaskInput struct {
@@ -16,11 +18,12 @@ type GetTaskInput struct {
 }
 
 type GetTaskOutput struct {
-	Task *task.Task
+	Task        *task.Task
+	Attachments int
 }
 
-func NewGetTaskUseCase(repo task.Repository) *GetTaskUseCase {
-	return &GetTaskUseCase{repo: repo}
+func NewGetTaskUseCase(repo task.Repository, attachments attachment.Repository) *GetTaskUseCase {
+	return &GetTaskUseCase{repo: repo, attachments: attachments}
 }
 
 func (uc *GetTaskUseCase) Execute(ctx context.Context, input GetTaskInput) (*GetTaskOutput, error) {
@@ -34,8 +37,14 @@ func (uc *GetTaskUseCase) Execute(ctx context.Context, input GetTaskInput) (*Get
 		return nil, fmt.Errorf("get task: %w", err)
 	}
 
+	counts, err := uc.attachments.CountByTasks(ctx, []task.Id{id})
+	if err != nil {
+		return nil, fmt.Errorf("count attachments: %w", err)
+	}
+
 	output := &GetTaskOutput{
-		Task: t,
+		Task:        t,
+		Attachments: counts[id],
 	}
 
 	return output, nil
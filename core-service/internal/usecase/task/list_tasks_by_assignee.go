@@ -0,0 +1,78 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type ListTasksByAssigneeUseCase struct {
+	repo task.Repository
+}
+
+type ListTasksByAssigneeInput struct {
+	RequesterId     string
+	AssigneeId      string
+	Sort            string
+	GroupByBoard    bool
+	IncludeArchived bool
+}
+
+type AssignedTaskGroup struct {
+	BoardId    board.Id
+	BoardTitle board.Title
+	Tasks      []task.AssignedTask
+}
+
+type ListTasksByAssigneeOutput struct {
+	Tasks  []task.AssignedTask
+	Groups []AssignedTaskGroup
+}
+
+func NewListTasksByAssigneeUseCase(repo task.Repository) *ListTasksByAssigneeUseCase {
+	return &ListTasksByAssigneeUseCase{repo: repo}
+}
+
+func (uc *ListTasksByAssigneeUseCase) Execute(ctx context.Context, input ListTasksByAssigneeInput) (*ListTasksByAssigneeOutput, error) {
+	rid, err := shared.UserIdFromString(input.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+	aid, err := shared.UserIdFromString(input.AssigneeId)
+	if err != nil {
+		return nil, fmt.Errorf("assignee_id: %w", err)
+	}
+	sort, err := task.NewAssignedSort(input.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	// Чужие задачи видны только на досках запрашивающего, свои — на любых.
+	tasks, err := uc.repo.ListTasksByAssignee(ctx, rid, aid, sort, input.IncludeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("list tasks by assignee: %w", err)
+	}
+
+	if !input.GroupByBoard {
+		return &ListTasksByAssigneeOutput{Tasks: tasks}, nil
+	}
+
+	// Группы идут в порядке первой задачи, внутри группы сохраняется сортировка.
+	groups := make([]AssignedTaskGroup, 0)
+	index := make(map[string]int)
+	for _, t := range tasks {
+		bid := t.BoardId.String()
+		i, ok := index[bid]
+		if !ok {
+			i = len(groups)
+			index[bid] = i
+			groups = append(groups, AssignedTaskGroup{BoardId: t.BoardId, BoardTitle: t.BoardTitle})
+		}
+		groups[i].Tasks = append(groups[i].Tasks, t)
+	}
+
+	return &ListTasksByAssigneeOutput{Groups: groups}, nil
+}
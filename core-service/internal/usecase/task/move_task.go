@@ -3,31 +3,47 @@ package task
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
 	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	"github.com/smarrog/task-board/shared/domain/shared"
 )
 
 type MoveTaskUseCase struct {
 	repo    task.Repository
 	columns column.Repository
+	boards  board.Repository
+	labels  label.Repository
 	cache   cache.Invalidator
 }
 
 type MoveTaskInput struct {
-	TaskId     string
-	ToColumnId string
-	ToPosition int
+	// RequesterId нужен только при переносе между досками: обе доски должны быть его.
+	RequesterId string
+	TaskId      string
+	ToColumnId  string
+	ToPosition  int
+	// ExpectedVersion — версия из If-Match; 0 — без проверки.
+	ExpectedVersion int64
 }
 
 type MoveTaskOutput struct {
 	Task *task.Task
 }
 
-func NewMoveTaskUseCase(repo task.Repository, columns column.Repository, cache cache.Invalidator) *MoveTaskUseCase {
-	return &MoveTaskUseCase{repo: repo, columns: columns, cache: cache}
+func NewMoveTaskUseCase(
+	repo task.Repository,
+	columns column.Repository,
+	boards board.Repository,
+	labels label.Repository,
+	cache cache.Invalidator,
+) *MoveTaskUseCase {
+	return &MoveTaskUseCase{repo: repo, columns: columns, boards: boards, labels: labels, cache: cache}
 }
 
 func (uc *MoveTaskUseCase) Execute(ctx context.Context, input MoveTaskInput) (*MoveTaskOutput, error) {
@@ -45,77 +61,72 @@ func (uc *MoveTaskUseCase) Execute(ctx context.Context, input MoveTaskInput) (*M
 	}
 
 	var out *MoveTaskOutput
-	var fromColId column.Id
-	var toColId = toCol
+	var fromBoardId, toBoardId board.Id
 
 	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
 		t, err := uc.repo.Get(ctx, tid)
 		if err != nil {
 			return err
 		}
+		// задача из архива возвращается в колонку только через UnarchiveTask
+		if t.IsArchived() {
+			return task.ErrArchived
+		}
+		if err := t.CheckVersion(input.ExpectedVersion); err != nil {
+			return err
+		}
 
 		fromCol := t.ColumnId()
-		fromColId = fromCol
-		fromPos := int(t.Position())
-
-		// смена позиции внутри одной колонки
-		if fromCol == toCol {
-			if err := uc.repo.LockColumnTasks(ctx, toCol); err != nil {
+		from, err := uc.columns.Get(ctx, fromCol)
+		if err != nil {
+			return err
+		}
+		to := from
+		// перенос между колонками; колонка из корзины задачи не принимает
+		if fromCol != toCol {
+			if to, err = uc.columns.Get(ctx, toCol); err != nil {
 				return err
 			}
+		}
+		fromBoardId, toBoardId = from.BoardId(), to.BoardId()
 
-			n, err := uc.repo.CountInColumn(ctx, toCol)
+		if fromBoardId != toBoardId {
+			rid, err := shared.UserIdFromString(input.RequesterId)
 			if err != nil {
-				return err
+				return fmt.Errorf("requester_id: %w", err)
 			}
-			if n == 0 {
-				return fmt.Errorf("column has no tasks")
-			}
-
-			clampedToPos := common.Clamp(int(toPos), 0, n-1)
-			shift, needShift := common.CalcShift(fromPos, clampedToPos)
-			if needShift {
-				if err := uc.repo.ShiftPositions(ctx, toCol, shift.FromPosition, shift.ToPosition, shift.Delta); err != nil {
-					return err
-				}
-			}
-
-			t.Move(toCol, task.Position(clampedToPos))
-			if err := uc.repo.Save(ctx, t); err != nil {
+			if err := common.CheckCrossBoard(ctx, uc.boards, rid, fromBoardId, toBoardId); err != nil {
 				return err
 			}
-
-			out = &MoveTaskOutput{Task: t}
-			return nil
 		}
 
-		// перенос между колонками
-		first, second := fromCol, toCol
-		if first.UUID().String() > second.UUID().String() {
-			first, second = second, first
-		}
-		if err := uc.repo.LockColumnTasks(ctx, first); err != nil {
+		n, err := uc.repo.CountInColumn(ctx, toCol)
+		if err != nil {
 			return err
 		}
-		if err := uc.repo.LockColumnTasks(ctx, second); err != nil {
-			return err
+		// внутри колонки сама задача в счёт мест не входит
+		if fromCol == toCol {
+			n--
 		}
 
-		nd, err := uc.repo.CountInColumn(ctx, toCol)
+		// меняется только ключ самой задачи — соседей не сдвигаем
+		pos := common.Clamp(int(toPos), 0, n)
+		key, err := uc.repo.SortKeyAt(ctx, toCol, pos, t.Id())
 		if err != nil {
 			return err
 		}
 
-		insertPos := common.Clamp(int(toPos), 0, nd)
+		t.Move(toCol, task.Position(pos), key, fromBoardId, toBoardId)
 
-		if err := uc.repo.ShiftAfterRemove(ctx, fromCol, fromPos); err != nil {
-			return err
-		}
-		if err := uc.repo.ShiftForInsert(ctx, toCol, insertPos); err != nil {
-			return err
+		// метки принадлежат доске: на новой доске остаются только одноимённые
+		if fromBoardId != toBoardId && len(t.LabelIds()) > 0 {
+			labelIds, err := uc.retargetLabels(ctx, t.LabelIds(), toBoardId)
+			if err != nil {
+				return err
+			}
+			t.SetLabels(labelIds)
 		}
 
-		t.Move(toCol, task.Position(insertPos))
 		if err := uc.repo.Save(ctx, t); err != nil {
 			return err
 		}
@@ -128,13 +139,35 @@ func (uc *MoveTaskUseCase) Execute(ctx context.Context, input MoveTaskInput) (*M
 	}
 
 	if uc.cache != nil {
-		if c, err := uc.columns.Get(ctx, fromColId); err == nil {
-			_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
-		}
-		if c, err := uc.columns.Get(ctx, toColId); err == nil {
-			_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
+		_ = uc.cache.InvalidateBoard(ctx, fromBoardId)
+		if toBoardId != fromBoardId {
+			_ = uc.cache.InvalidateBoard(ctx, toBoardId)
 		}
 	}
 
 	return out, nil
 }
+
+func (uc *MoveTaskUseCase) retargetLabels(ctx context.Context, ids []label.Id, toBoardId board.Id) ([]label.Id, error) {
+	current, err := uc.labels.ListByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	target, err := uc.labels.ListByBoard(ctx, toBoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]label.Id, len(target))
+	for _, l := range target {
+		byName[strings.ToLower(l.Name().String())] = l.Id()
+	}
+
+	out := make([]label.Id, 0, len(current))
+	for _, l := range current {
+		if id, ok := byName[strings.ToLower(l.Name().String())]; ok {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
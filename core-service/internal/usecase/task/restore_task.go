@@ -0,0 +1,72 @@
+package task
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type RestoreTaskUseCase struct {
+	repo    task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+}
+
+type RestoreTaskInput struct {
+	TaskId string
+}
+
+type RestoreTaskOutput struct {
+	Task *task.Task
+}
+
+func NewRestoreTaskUseCase(repo task.Repository, columns column.Repository, cache cache.Invalidator) *RestoreTaskUseCase {
+	return &RestoreTaskUseCase{repo: repo, columns: columns, cache: cache}
+}
+
+func (uc *RestoreTaskUseCase) Execute(ctx context.Context, input RestoreTaskInput) (*RestoreTaskOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *RestoreTaskOutput
+	var c *column.Column
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		trashed, err := uc.repo.GetTrashed(ctx, tid)
+		if err != nil {
+			return err
+		}
+		t := trashed.Task
+
+		// задача возвращается только в живую колонку
+		c, err = uc.columns.Get(ctx, t.ColumnId())
+		if errors.Is(err, column.ErrNotFound) {
+			return task.ErrColumnDeleted
+		}
+		if err != nil {
+			return err
+		}
+
+		// ключ порядка сохранился, поэтому задача встаёт между прежними соседями
+		t.Restore(t.Position())
+		if err := uc.repo.Restore(ctx, t); err != nil {
+			return err
+		}
+
+		out = &RestoreTaskOutput{Task: t}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
+	}
+
+	return out, nil
+}
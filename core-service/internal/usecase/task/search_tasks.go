@@ -0,0 +1,114 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+const (
+	DefaultSearchPageSize = 20
+	MaxSearchPageSize     = 100
+)
+
+type SearchTasksUseCase struct {
+	repo task.Repository
+}
+
+type SearchTasksInput struct {
+	RequesterId     string
+	Query           string
+	BoardId         string
+	ColumnId        string
+	AssigneeId      string
+	CreatedFrom     time.Time
+	CreatedTo       time.Time
+	PageSize        int
+	Cursor          string
+	IncludeArchived bool
+}
+
+type SearchTasksOutput struct {
+	Hits       []task.SearchHit
+	NextCursor string
+}
+
+func NewSearchTasksUseCase(repo task.Repository) *SearchTasksUseCase {
+	return &SearchTasksUseCase{repo: repo}
+}
+
+func (uc *SearchTasksUseCase) Execute(ctx context.Context, input SearchTasksInput) (*SearchTasksOutput, error) {
+	rid, err := shared.UserIdFromString(input.RequesterId)
+	if err != nil {
+		return nil, fmt.Errorf("requester_id: %w", err)
+	}
+	text, err := task.NewSearchText(input.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	q := task.SearchQuery{
+		Text:            text,
+		RequesterId:     rid,
+		CreatedFrom:     input.CreatedFrom,
+		CreatedTo:       input.CreatedTo,
+		IncludeArchived: input.IncludeArchived,
+	}
+	if !q.CreatedFrom.IsZero() && !q.CreatedTo.IsZero() && !q.CreatedFrom.Before(q.CreatedTo) {
+		return nil, task.ErrInvalidDateRange
+	}
+	if strings.TrimSpace(input.BoardId) != "" {
+		bid, err := board.IdFromString(input.BoardId)
+		if err != nil {
+			return nil, err
+		}
+		q.BoardId = &bid
+	}
+	if strings.TrimSpace(input.ColumnId) != "" {
+		cid, err := column.IdFromString(input.ColumnId)
+		if err != nil {
+			return nil, err
+		}
+		q.ColumnId = &cid
+	}
+	if strings.TrimSpace(input.AssigneeId) != "" {
+		aid, err := shared.UserIdFromString(input.AssigneeId)
+		if err != nil {
+			return nil, fmt.Errorf("assignee_id: %w", err)
+		}
+		q.AssigneeId = &aid
+	}
+	if input.Cursor != "" {
+		after, err := task.DecodeSearchCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		q.After = &after
+	}
+
+	pageSize := DefaultSearchPageSize
+	if input.PageSize > 0 {
+		pageSize = common.Clamp(input.PageSize, 1, MaxSearchPageSize)
+	}
+	q.Limit = pageSize + 1
+
+	hits, err := uc.repo.Search(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("search tasks: %w", err)
+	}
+
+	var nextCursor string
+	if len(hits) > pageSize {
+		hits = hits[:pageSize]
+		nextCursor = hits[pageSize-1].Cursor().Encode()
+	}
+
+	return &SearchTasksOutput{Hits: hits, NextCursor: nextCursor}, nil
+}
@@ -0,0 +1,101 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type SetTaskLabelsUseCase struct {
+	repo    task.Repository
+	columns column.Repository
+	labels  label.Repository
+	cache   cache.Invalidator
+}
+
+type SetTaskLabelsInput struct {
+	TaskId   string
+	LabelIds []string
+	// ExpectedVersion — версия из If-Match; 0 — без проверки.
+	ExpectedVersion int64
+}
+
+type SetTaskLabelsOutput struct {
+	Task *task.Task
+}
+
+func NewSetTaskLabelsUseCase(
+	repo task.Repository,
+	columns column.Repository,
+	labels label.Repository,
+	cache cache.Invalidator,
+) *SetTaskLabelsUseCase {
+	return &SetTaskLabelsUseCase{repo: repo, columns: columns, labels: labels, cache: cache}
+}
+
+func (uc *SetTaskLabelsUseCase) Execute(ctx context.Context, input SetTaskLabelsInput) (*SetTaskLabelsOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	labelIds := make([]label.Id, 0, len(input.LabelIds))
+	for _, raw := range input.LabelIds {
+		lid, err := label.IdFromString(raw)
+		if err != nil {
+			return nil, err
+		}
+		labelIds = append(labelIds, lid)
+	}
+
+	t, err := uc.repo.Get(ctx, tid)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.CheckVersion(input.ExpectedVersion); err != nil {
+		return nil, err
+	}
+	c, err := uc.columns.Get(ctx, t.ColumnId())
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := uc.labels.ListByIds(ctx, labelIds)
+	if err != nil {
+		return nil, fmt.Errorf("list labels: %w", err)
+	}
+	found := make(map[label.Id]struct{}, len(labels))
+	for _, l := range labels {
+		if l.BoardId() != c.BoardId() {
+			return nil, label.ErrBoardMismatch
+		}
+		found[l.Id()] = struct{}{}
+	}
+	for _, lid := range labelIds {
+		if _, ok := found[lid]; !ok {
+			return nil, label.ErrNotFound
+		}
+	}
+
+	if !t.SetLabels(labelIds) {
+		return &SetTaskLabelsOutput{Task: t}, nil
+	}
+
+	err = uc.repo.Save(ctx, t)
+	if err != nil {
+		return nil, fmt.Errorf("save task: %w", err)
+	}
+
+	if uc.cache != nil {
+		_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
+	}
+
+	output := &SetTaskLabelsOutput{
+		Task: t,
+	}
+
+	return output, nil
+}
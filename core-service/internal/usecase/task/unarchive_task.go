@@ -0,0 +1,108 @@
+package task
+
+import (
+	"context"
+	"strings"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+)
+
+type UnarchiveTaskUseCase struct {
+	repo    task.Repository
+	columns column.Repository
+	cache   cache.Invalidator
+}
+
+type UnarchiveTaskInput struct {
+	TaskId string
+	// ColumnId — куда вернуть задачу; пустой — в прежнюю колонку.
+	ColumnId string
+}
+
+type UnarchiveTaskOutput struct {
+	Task *task.Task
+}
+
+func NewUnarchiveTaskUseCase(repo task.Repository, columns column.Repository, cache cache.Invalidator) *UnarchiveTaskUseCase {
+	return &UnarchiveTaskUseCase{repo: repo, columns: columns, cache: cache}
+}
+
+func (uc *UnarchiveTaskUseCase) Execute(ctx context.Context, input UnarchiveTaskInput) (*UnarchiveTaskOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	var toCol *column.Id
+	if strings.TrimSpace(input.ColumnId) != "" {
+		cid, err := column.IdFromString(input.ColumnId)
+		if err != nil {
+			return nil, err
+		}
+		toCol = &cid
+	}
+
+	var out *UnarchiveTaskOutput
+	var toColId column.Id
+	err = uc.repo.InTx(ctx, func(ctx context.Context) error {
+		t, err := uc.repo.Get(ctx, tid)
+		if err != nil {
+			return err
+		}
+		if !t.IsArchived() {
+			return task.ErrNotArchived
+		}
+
+		toColId = t.ColumnId()
+		if toCol != nil && *toCol != toColId {
+			// колонка из корзины задачи не принимает; на другую доску задачу переносит только MoveTask
+			from, err := uc.columns.Get(ctx, toColId)
+			if err != nil {
+				return err
+			}
+			to, err := uc.columns.Get(ctx, *toCol)
+			if err != nil {
+				return err
+			}
+			if to.BoardId() != from.BoardId() {
+				return column.ErrBoardMismatch
+			}
+			toColId = *toCol
+		} else if _, err := uc.columns.Get(ctx, toColId); err != nil {
+			return err
+		}
+
+		// задача из архива встаёт в конец колонки
+		n, err := uc.repo.CountInColumn(ctx, toColId)
+		if err != nil {
+			return err
+		}
+		key, err := uc.repo.SortKeyAt(ctx, toColId, n, t.Id())
+		if err != nil {
+			return err
+		}
+
+		if err := t.Unarchive(toColId, task.Position(n), key); err != nil {
+			return err
+		}
+		if err := uc.repo.Save(ctx, t); err != nil {
+			return err
+		}
+
+		out = &UnarchiveTaskOutput{Task: t}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// задачи из архива в кэше доски не было, меняется только доска, куда она вернулась
+	if uc.cache != nil {
+		if c, err := uc.columns.Get(ctx, toColId); err == nil {
+			_ = uc.cache.InvalidateBoard(ctx, c.BoardId())
+		}
+	}
+
+	return out, nil
+}
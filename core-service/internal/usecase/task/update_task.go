@@ -3,11 +3,14 @@ package task
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/smarrog/task-board/core-service/internal/domain/column"
 	"github.com/smarrog/task-board/core-service/internal/domain/task"
 	"github.com/smarrog/task-board/core-service/internal/usecase/cache"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
 	"github.com/smarrog/task-board/shared/domain/shared"
+	shtask "github.com/smarrog/task-board/shared/domain/task"
 )
 
 type UpdateTaskUseCase struct {
@@ -21,6 +24,15 @@ type UpdateTaskInput struct {
 	Title       string
 	Description string
 	AssigneeId  string
+	StartAt     time.Time
+	DueAt       time.Time
+	Priority    string
+	// Estimate — nil значит «без оценки».
+	Estimate *float64
+	// ExpectedVersion — версия из If-Match; 0 — без проверки.
+	ExpectedVersion int64
+	// UpdateMask — пути изменяемых полей; пустая маска заменяет все поля.
+	UpdateMask []string
 }
 
 type UpdateTaskOutput struct {
@@ -36,25 +48,63 @@ func (uc *UpdateTaskUseCase) Execute(ctx context.Context, input UpdateTaskInput)
 	if err != nil {
 		return nil, err
 	}
-	title, err := task.NewTitle(input.Title)
+	mask, err := common.NewUpdateMask(input.UpdateMask, task.UpdatableFields, task.ErrInvalidUpdateMask)
 	if err != nil {
 		return nil, err
 	}
-	desc, err := task.NewDescription(input.Description)
+
+	t, err := uc.repo.Get(ctx, tid)
 	if err != nil {
 		return nil, err
 	}
-	aid, err := shared.UserIdFromString(input.AssigneeId)
-	if err != nil {
-		return nil, fmt.Errorf("task assignee_id: %w", err)
+	if err := t.CheckVersion(input.ExpectedVersion); err != nil {
+		return nil, err
 	}
 
-	t, err := uc.repo.Get(ctx, tid)
+	// поля вне маски остаются как есть, их значения в запросе не разбираем
+	title, desc, aid := t.Title(), t.Description(), t.AssigneeId()
+	startAt, dueAt := t.Dates().StartAt(), t.Dates().DueAt()
+	priority, estimate := t.Priority(), t.Estimate()
+	if mask.Has(shtask.FieldTitle) {
+		if title, err = task.NewTitle(input.Title); err != nil {
+			return nil, err
+		}
+	}
+	if mask.Has(shtask.FieldDescription) {
+		if desc, err = task.NewDescription(input.Description); err != nil {
+			return nil, err
+		}
+	}
+	if mask.Has(shtask.FieldAssigneeId) {
+		if aid, err = shared.UserIdFromString(input.AssigneeId); err != nil {
+			return nil, fmt.Errorf("task assignee_id: %w", err)
+		}
+	}
+	if mask.Has(shtask.FieldStartAt) {
+		startAt = input.StartAt
+	}
+	if mask.Has(shtask.FieldDueAt) {
+		dueAt = input.DueAt
+	}
+	// даты проверяем парой: маска могла задеть только одну из них
+	dates, err := task.NewDates(startAt, dueAt)
 	if err != nil {
 		return nil, err
 	}
+	if mask.Has(shtask.FieldPriority) {
+		if priority, err = task.NewPriority(input.Priority); err != nil {
+			return nil, err
+		}
+	}
+	if mask.Has(shtask.FieldEstimate) {
+		if estimate, err = task.EstimateFromPtr(input.Estimate); err != nil {
+			return nil, err
+		}
+	}
 
-	t.Update(title, desc, aid)
+	if !t.Update(title, desc, aid, dates, priority, estimate) {
+		return &UpdateTaskOutput{Task: t}, nil
+	}
 
 	err = uc.repo.Save(ctx, t)
 	if err != nil {
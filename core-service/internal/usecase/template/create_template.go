@@ -0,0 +1,96 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/label"
+	"github.com/smarrog/task-board/core-service/internal/domain/template"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type CreateTemplateUseCase struct {
+	repo    template.Repository
+	boards  board.Repository
+	columns column.Repository
+	labels  label.Repository
+}
+
+type CreateTemplateInput struct {
+	OwnerId     string
+	BoardId     string
+	Name        string
+	Description string
+}
+
+type CreateTemplateOutput struct {
+	Template *template.Template
+}
+
+func NewCreateTemplateUseCase(
+	repo template.Repository,
+	boards board.Repository,
+	columns column.Repository,
+	labels label.Repository,
+) *CreateTemplateUseCase {
+	return &CreateTemplateUseCase{repo: repo, boards: boards, columns: columns, labels: labels}
+}
+
+// Execute сохраняет раскладку доски — колонки и метки — как шаблон владельца доски.
+func (uc *CreateTemplateUseCase) Execute(ctx context.Context, input CreateTemplateInput) (*CreateTemplateOutput, error) {
+	oid, err := shared.UserIdFromString(input.OwnerId)
+	if err != nil {
+		return nil, fmt.Errorf("board template owner_id: %w", err)
+	}
+	bid, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+	name, err := template.NewName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	description, err := template.NewDescription(input.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := uc.boards.Get(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+	if b.OwnerId().UUID() != oid.UUID() {
+		return nil, board.ErrOwnerMismatch
+	}
+
+	cols, err := uc.columns.ListByBoard(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+	titles := make([]column.Title, 0, len(cols))
+	for _, c := range cols {
+		titles = append(titles, c.Title())
+	}
+
+	lbls, err := uc.labels.ListByBoard(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+	specs := make([]template.LabelSpec, 0, len(lbls))
+	for _, l := range lbls {
+		specs = append(specs, template.LabelSpec{Name: l.Name(), Color: l.Color()})
+	}
+
+	t, err := template.New(oid, name, description, titles, specs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Save(ctx, t); err != nil {
+		return nil, fmt.Errorf("save board template: %w", err)
+	}
+
+	return &CreateTemplateOutput{Template: t}, nil
+}
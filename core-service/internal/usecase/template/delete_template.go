@@ -0,0 +1,53 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/template"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type DeleteTemplateUseCase struct {
+	repo template.Repository
+}
+
+type DeleteTemplateInput struct {
+	OwnerId    string
+	TemplateId string
+}
+
+type DeleteTemplateOutput struct{}
+
+func NewDeleteTemplateUseCase(repo template.Repository) *DeleteTemplateUseCase {
+	return &DeleteTemplateUseCase{repo: repo}
+}
+
+// Execute удаляет шаблон владельца; встроенные шаблоны удалить нельзя.
+func (uc *DeleteTemplateUseCase) Execute(ctx context.Context, input DeleteTemplateInput) (*DeleteTemplateOutput, error) {
+	oid, err := shared.UserIdFromString(input.OwnerId)
+	if err != nil {
+		return nil, fmt.Errorf("board template owner_id: %w", err)
+	}
+	id, err := template.IdFromString(input.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !t.VisibleTo(oid) {
+		return nil, template.ErrNotFound
+	}
+	if t.IsBuiltIn() {
+		return nil, template.ErrOwnerMismatch
+	}
+
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &DeleteTemplateOutput{}, nil
+}
@@ -0,0 +1,39 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/template"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type ListTemplatesUseCase struct {
+	repo template.Repository
+}
+
+type ListTemplatesInput struct {
+	OwnerId string
+}
+
+type ListTemplatesOutput struct {
+	Templates []*template.Template
+}
+
+func NewListTemplatesUseCase(repo template.Repository) *ListTemplatesUseCase {
+	return &ListTemplatesUseCase{repo: repo}
+}
+
+func (uc *ListTemplatesUseCase) Execute(ctx context.Context, input ListTemplatesInput) (*ListTemplatesOutput, error) {
+	oid, err := shared.UserIdFromString(input.OwnerId)
+	if err != nil {
+		return nil, fmt.Errorf("board template owner_id: %w", err)
+	}
+
+	templates, err := uc.repo.ListVisible(ctx, oid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListTemplatesOutput{Templates: templates}, nil
+}
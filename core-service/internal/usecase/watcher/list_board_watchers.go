@@ -0,0 +1,45 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/watcher"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type ListBoardWatchersUseCase struct {
+	repo   watcher.Repository
+	boards board.Repository
+}
+
+type ListBoardWatchersInput struct {
+	BoardId string
+}
+
+type ListBoardWatchersOutput struct {
+	UserIds []shared.UserId
+}
+
+func NewListBoardWatchersUseCase(repo watcher.Repository, boards board.Repository) *ListBoardWatchersUseCase {
+	return &ListBoardWatchersUseCase{repo: repo, boards: boards}
+}
+
+func (uc *ListBoardWatchersUseCase) Execute(ctx context.Context, input ListBoardWatchersInput) (*ListBoardWatchersOutput, error) {
+	bid, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.boards.Get(ctx, bid); err != nil {
+		return nil, err
+	}
+
+	ids, err := uc.repo.ListByBoard(ctx, bid)
+	if err != nil {
+		return nil, fmt.Errorf("list board watchers: %w", err)
+	}
+
+	return &ListBoardWatchersOutput{UserIds: ids}, nil
+}
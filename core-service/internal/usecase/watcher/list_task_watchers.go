@@ -0,0 +1,46 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/domain/watcher"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type ListTaskWatchersUseCase struct {
+	repo  watcher.Repository
+	tasks task.Repository
+}
+
+type ListTaskWatchersInput struct {
+	TaskId string
+}
+
+type ListTaskWatchersOutput struct {
+	// UserIds включает и подписчиков доски, в которой лежит задача.
+	UserIds []shared.UserId
+}
+
+func NewListTaskWatchersUseCase(repo watcher.Repository, tasks task.Repository) *ListTaskWatchersUseCase {
+	return &ListTaskWatchersUseCase{repo: repo, tasks: tasks}
+}
+
+func (uc *ListTaskWatchersUseCase) Execute(ctx context.Context, input ListTaskWatchersInput) (*ListTaskWatchersOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.tasks.Get(ctx, tid); err != nil {
+		return nil, err
+	}
+
+	ids, err := uc.repo.ListByTask(ctx, tid)
+	if err != nil {
+		return nil, fmt.Errorf("list task watchers: %w", err)
+	}
+
+	return &ListTaskWatchersOutput{UserIds: ids}, nil
+}
@@ -0,0 +1,58 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/watcher"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type SetBoardWatchingUseCase struct {
+	repo   watcher.Repository
+	boards board.Repository
+}
+
+type SetBoardWatchingInput struct {
+	BoardId  string
+	UserId   string
+	Watching bool
+}
+
+type SetBoardWatchingOutput struct{}
+
+func NewSetBoardWatchingUseCase(repo watcher.Repository, boards board.Repository) *SetBoardWatchingUseCase {
+	return &SetBoardWatchingUseCase{repo: repo, boards: boards}
+}
+
+func (uc *SetBoardWatchingUseCase) Execute(ctx context.Context, input SetBoardWatchingInput) (*SetBoardWatchingOutput, error) {
+	bid, err := board.IdFromString(input.BoardId)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := shared.UserIdFromString(input.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("user_id: %w", err)
+	}
+
+	b, err := uc.boards.Get(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Watching {
+		// подписаться можно только на свою доску, отписаться — от любой
+		if b.OwnerId() != uid {
+			return nil, board.ErrOwnerMismatch
+		}
+		err = uc.repo.WatchBoard(ctx, bid, uid)
+	} else {
+		err = uc.repo.UnwatchBoard(ctx, bid, uid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("set board watching: %w", err)
+	}
+
+	return &SetBoardWatchingOutput{}, nil
+}
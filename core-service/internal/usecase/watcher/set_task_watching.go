@@ -0,0 +1,74 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smarrog/task-board/core-service/internal/domain/board"
+	"github.com/smarrog/task-board/core-service/internal/domain/column"
+	"github.com/smarrog/task-board/core-service/internal/domain/task"
+	"github.com/smarrog/task-board/core-service/internal/domain/watcher"
+	"github.com/smarrog/task-board/core-service/internal/usecase/common"
+	"github.com/smarrog/task-board/shared/domain/shared"
+)
+
+type SetTaskWatchingUseCase struct {
+	repo    watcher.Repository
+	tasks   task.Repository
+	columns column.Repository
+	boards  board.Repository
+}
+
+type SetTaskWatchingInput struct {
+	TaskId   string
+	UserId   string
+	Watching bool
+}
+
+type SetTaskWatchingOutput struct{}
+
+func NewSetTaskWatchingUseCase(repo watcher.Repository, tasks task.Repository, columns column.Repository, boards board.Repository) *SetTaskWatchingUseCase {
+	return &SetTaskWatchingUseCase{repo: repo, tasks: tasks, columns: columns, boards: boards}
+}
+
+func (uc *SetTaskWatchingUseCase) Execute(ctx context.Context, input SetTaskWatchingInput) (*SetTaskWatchingOutput, error) {
+	tid, err := task.IdFromString(input.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := shared.UserIdFromString(input.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("user_id: %w", err)
+	}
+
+	t, err := uc.tasks.Get(ctx, tid)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Watching {
+		if err := uc.checkCanWatch(ctx, t, uid); err != nil {
+			return nil, err
+		}
+		err = uc.repo.WatchTask(ctx, tid, uid)
+	} else {
+		err = uc.repo.UnwatchTask(ctx, tid, uid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("set task watching: %w", err)
+	}
+
+	return &SetTaskWatchingOutput{}, nil
+}
+
+// checkCanWatch пускает исполнителя задачи и владельца её доски; отписаться может любой.
+func (uc *SetTaskWatchingUseCase) checkCanWatch(ctx context.Context, t *task.Task, uid shared.UserId) error {
+	if t.AssigneeId() == uid {
+		return nil
+	}
+	c, err := uc.columns.Get(ctx, t.ColumnId())
+	if err != nil {
+		return err
+	}
+	return common.CheckBoardOwner(ctx, uc.boards, uid, c.BoardId())
+}
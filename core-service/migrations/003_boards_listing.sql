@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+DROP INDEX idx_boards_owner_id;
+CREATE INDEX idx_boards_owner_updated ON boards(owner_id, updated_at DESC, id DESC);
+CREATE INDEX idx_boards_owner_title ON boards(owner_id, lower(title), id);
+CREATE INDEX idx_boards_title_trgm ON boards USING GIN (title gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX idx_boards_title_trgm;
+DROP INDEX idx_boards_owner_title;
+DROP INDEX idx_boards_owner_updated;
+CREATE INDEX idx_boards_owner_id ON boards(owner_id);
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE tasks
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', title), 'A') ||
+        setweight(to_tsvector('simple', description), 'B')
+    ) STORED;
+
+CREATE INDEX idx_tasks_search_vector ON tasks USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX idx_tasks_search_vector;
+ALTER TABLE tasks DROP COLUMN search_vector;
\ No newline at end of file
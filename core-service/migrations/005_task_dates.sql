@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE tasks
+    ADD COLUMN start_at TIMESTAMPTZ,
+    ADD COLUMN due_at TIMESTAMPTZ,
+    ADD COLUMN due_soon_notified_at TIMESTAMPTZ,
+    ADD COLUMN overdue_notified_at TIMESTAMPTZ,
+    ADD CONSTRAINT chk_tasks_start_before_due CHECK (start_at IS NULL OR due_at IS NULL OR start_at < due_at);
+
+CREATE INDEX idx_tasks_due_pending ON tasks(due_at)
+    WHERE due_at IS NOT NULL AND overdue_notified_at IS NULL;
+CREATE INDEX idx_tasks_assignee_due ON tasks(assignee_id, due_at);
+
+-- +goose Down
+DROP INDEX idx_tasks_assignee_due;
+DROP INDEX idx_tasks_due_pending;
+ALTER TABLE tasks
+    DROP CONSTRAINT chk_tasks_start_before_due,
+    DROP COLUMN overdue_notified_at,
+    DROP COLUMN due_soon_notified_at,
+    DROP COLUMN due_at,
+    DROP COLUMN start_at;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE checklist_items (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    text TEXT NOT NULL,
+    done BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE checklist_items
+    ADD CONSTRAINT ux_checklist_items_task_position
+        UNIQUE (task_id, position)
+    DEFERRABLE INITIALLY DEFERRED;
+
+-- +goose Down
+DROP TABLE checklist_items;
\ No newline at end of file
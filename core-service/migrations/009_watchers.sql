@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE task_watchers (
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (task_id, user_id)
+);
+
+CREATE TABLE board_watchers (
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (board_id, user_id)
+);
+
+ALTER TABLE outbox_events ADD COLUMN watchers UUID[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE outbox_events DROP COLUMN watchers;
+DROP TABLE board_watchers;
+DROP TABLE task_watchers;
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE tasks
+    ADD COLUMN priority TEXT,
+    ADD COLUMN estimate NUMERIC(6, 2),
+    ADD CONSTRAINT chk_tasks_priority CHECK (priority IN ('low', 'medium', 'high', 'urgent')),
+    ADD CONSTRAINT chk_tasks_estimate CHECK (estimate >= 0);
+
+-- +goose Down
+ALTER TABLE tasks
+    DROP CONSTRAINT chk_tasks_estimate,
+    DROP CONSTRAINT chk_tasks_priority,
+    DROP COLUMN estimate,
+    DROP COLUMN priority;
\ No newline at end of file
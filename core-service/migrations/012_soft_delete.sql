@@ -0,0 +1,53 @@
+-- +goose Up
+-- Удаление мягкое: deleted_at ставится записи и всем её потомкам одним значением,
+-- по нему восстановление возвращает ровно то, что было удалено вместе.
+ALTER TABLE boards ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- live = TRUE у живых строк и NULL у удалённых: NULL в UNIQUE не конфликтует,
+-- поэтому позиции в корзине не мешают живым, а ограничение остаётся DEFERRABLE.
+ALTER TABLE columns
+    ADD COLUMN deleted_at TIMESTAMPTZ,
+    ADD COLUMN live BOOLEAN GENERATED ALWAYS AS (CASE WHEN deleted_at IS NULL THEN TRUE END) STORED,
+    DROP CONSTRAINT ux_columns_board_position,
+    ADD CONSTRAINT ux_columns_board_position
+        UNIQUE (board_id, position, live)
+    DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE tasks
+    ADD COLUMN deleted_at TIMESTAMPTZ,
+    ADD COLUMN live BOOLEAN GENERATED ALWAYS AS (CASE WHEN deleted_at IS NULL THEN TRUE END) STORED,
+    DROP CONSTRAINT ux_tasks_column_position,
+    ADD CONSTRAINT ux_tasks_column_position
+        UNIQUE (column_id, position, live)
+    DEFERRABLE INITIALLY DEFERRED;
+
+CREATE INDEX idx_boards_deleted_at ON boards(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_columns_deleted_at ON columns(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_tasks_deleted_at ON tasks(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_tasks_deleted_at;
+DROP INDEX idx_columns_deleted_at;
+DROP INDEX idx_boards_deleted_at;
+
+DELETE FROM boards WHERE deleted_at IS NOT NULL;
+DELETE FROM columns WHERE deleted_at IS NOT NULL;
+DELETE FROM tasks WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE tasks
+    DROP CONSTRAINT ux_tasks_column_position,
+    DROP COLUMN live,
+    DROP COLUMN deleted_at,
+    ADD CONSTRAINT ux_tasks_column_position
+        UNIQUE (column_id, position)
+    DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE columns
+    DROP CONSTRAINT ux_columns_board_position,
+    DROP COLUMN live,
+    DROP COLUMN deleted_at,
+    ADD CONSTRAINT ux_columns_board_position
+        UNIQUE (board_id, position)
+    DEFERRABLE INITIALLY DEFERRED;
+
+ALTER TABLE boards DROP COLUMN deleted_at;
\ No newline at end of file
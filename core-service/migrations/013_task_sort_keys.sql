@@ -0,0 +1,43 @@
+-- +goose Up
+-- Порядок задач в колонке теперь задаёт sort_key, а позиция выводится из него при чтении:
+-- перемещение меняет одну строку вместо сдвига всей колонки.
+ALTER TABLE tasks ADD COLUMN sort_key TEXT COLLATE "C";
+
+-- Ключи по текущему порядку. Шестнадцатеричные цифры — подмножество алфавита ключей
+-- с тем же порядком, а хвостовые нули ключу не положены.
+UPDATE tasks t
+SET sort_key = rtrim(lpad(to_hex(r.n), 8, '0'), '0')
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY column_id ORDER BY position, deleted_at NULLS FIRST, id) AS n
+    FROM tasks
+) r
+WHERE t.id = r.id;
+
+ALTER TABLE tasks
+    ALTER COLUMN sort_key SET NOT NULL,
+    DROP CONSTRAINT ux_tasks_column_position,
+    DROP COLUMN live,
+    DROP COLUMN position;
+
+CREATE INDEX idx_tasks_column_sort_key ON tasks(column_id, sort_key, id) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX idx_tasks_column_sort_key;
+
+ALTER TABLE tasks ADD COLUMN position INT;
+
+UPDATE tasks t
+SET position = r.n
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY column_id, deleted_at IS NULL ORDER BY sort_key, id) - 1 AS n
+    FROM tasks
+) r
+WHERE t.id = r.id;
+
+ALTER TABLE tasks
+    ALTER COLUMN position SET NOT NULL,
+    DROP COLUMN sort_key,
+    ADD COLUMN live BOOLEAN GENERATED ALWAYS AS (CASE WHEN deleted_at IS NULL THEN TRUE END) STORED,
+    ADD CONSTRAINT ux_tasks_column_position
+        UNIQUE (column_id, position, live)
+    DEFERRABLE INITIALLY DEFERRED;
\ No newline at end of file
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Архив: доска пропадает из списка досок, задача — из порядка своей колонки.
+-- Задача в архиве сохраняет sort_key, но соседи и позиции его не учитывают.
+ALTER TABLE boards ADD COLUMN archived_at TIMESTAMPTZ;
+ALTER TABLE tasks ADD COLUMN archived_at TIMESTAMPTZ;
+
+DROP INDEX idx_tasks_column_sort_key;
+CREATE INDEX idx_tasks_column_sort_key ON tasks(column_id, sort_key, id)
+    WHERE deleted_at IS NULL AND archived_at IS NULL;
+
+-- +goose Down
+DROP INDEX idx_tasks_column_sort_key;
+CREATE INDEX idx_tasks_column_sort_key ON tasks(column_id, sort_key, id) WHERE deleted_at IS NULL;
+
+ALTER TABLE tasks DROP COLUMN archived_at;
+ALTER TABLE boards DROP COLUMN archived_at;
\ No newline at end of file
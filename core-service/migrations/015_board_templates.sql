@@ -0,0 +1,68 @@
+-- +goose Up
+-- Название колонки необязательное: старые колонки остаются без названия.
+ALTER TABLE columns ADD COLUMN title TEXT NOT NULL DEFAULT '';
+
+-- Шаблоны досок. owner_id IS NULL — встроенный шаблон, виден всем.
+CREATE TABLE board_templates (
+    id UUID PRIMARY KEY,
+    owner_id UUID,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_board_templates_owner_id ON board_templates(owner_id);
+
+CREATE TABLE board_template_columns (
+    template_id UUID NOT NULL REFERENCES board_templates(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (template_id, position)
+);
+
+CREATE TABLE board_template_labels (
+    template_id UUID NOT NULL REFERENCES board_templates(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    color TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX ux_board_template_labels_name ON board_template_labels(template_id, lower(name));
+
+INSERT INTO board_templates (id, owner_id, name, description) VALUES
+    ('00000000-0000-0000-0000-000000000001', NULL, 'Kanban', 'Backlog, In progress, Review, Done'),
+    ('00000000-0000-0000-0000-000000000002', NULL, 'Scrum', 'Product backlog, Sprint backlog, In progress, Review, Done'),
+    ('00000000-0000-0000-0000-000000000003', NULL, 'Simple', 'To do, Doing, Done'),
+    ('00000000-0000-0000-0000-000000000004', NULL, 'Bug tracking', 'Triage, Confirmed, In progress, Fixed, Closed');
+
+INSERT INTO board_template_columns (template_id, position, title) VALUES
+    ('00000000-0000-0000-0000-000000000001', 0, 'Backlog'),
+    ('00000000-0000-0000-0000-000000000001', 1, 'In progress'),
+    ('00000000-0000-0000-0000-000000000001', 2, 'Review'),
+    ('00000000-0000-0000-0000-000000000001', 3, 'Done'),
+    ('00000000-0000-0000-0000-000000000002', 0, 'Product backlog'),
+    ('00000000-0000-0000-0000-000000000002', 1, 'Sprint backlog'),
+    ('00000000-0000-0000-0000-000000000002', 2, 'In progress'),
+    ('00000000-0000-0000-0000-000000000002', 3, 'Review'),
+    ('00000000-0000-0000-0000-000000000002', 4, 'Done'),
+    ('00000000-0000-0000-0000-000000000003', 0, 'To do'),
+    ('00000000-0000-0000-0000-000000000003', 1, 'Doing'),
+    ('00000000-0000-0000-0000-000000000003', 2, 'Done'),
+    ('00000000-0000-0000-0000-000000000004', 0, 'Triage'),
+    ('00000000-0000-0000-0000-000000000004', 1, 'Confirmed'),
+    ('00000000-0000-0000-0000-000000000004', 2, 'In progress'),
+    ('00000000-0000-0000-0000-000000000004', 3, 'Fixed'),
+    ('00000000-0000-0000-0000-000000000004', 4, 'Closed');
+
+INSERT INTO board_template_labels (template_id, name, color) VALUES
+    ('00000000-0000-0000-0000-000000000004', 'bug', '#d73a4a'),
+    ('00000000-0000-0000-0000-000000000004', 'regression', '#b60205'),
+    ('00000000-0000-0000-0000-000000000004', 'needs info', '#fbca04'),
+    ('00000000-0000-0000-0000-000000000004', 'wontfix', '#cccccc');
+
+-- +goose Down
+DROP TABLE board_template_labels;
+DROP TABLE board_template_columns;
+DROP TABLE board_templates;
+
+ALTER TABLE columns DROP COLUMN title;
\ No newline at end of file
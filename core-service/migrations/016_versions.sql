@@ -0,0 +1,11 @@
+-- +goose Up
+-- Версия строки для оптимистичной блокировки: каждая запись агрегата увеличивает её на единицу,
+-- а запись по устаревшей версии отклоняется. Существующие строки начинают с 1.
+ALTER TABLE boards ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE columns ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE tasks ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE tasks DROP COLUMN version;
+ALTER TABLE columns DROP COLUMN version;
+ALTER TABLE boards DROP COLUMN version;
\ No newline at end of file
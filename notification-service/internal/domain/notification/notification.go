@@ -4,6 +4,8 @@ import "context"
 
 type Notification struct {
 	Text string
+	// Recipients — id пользователей, которым адресовано уведомление.
+	Recipients []string
 }
 
 type Notifier interface {
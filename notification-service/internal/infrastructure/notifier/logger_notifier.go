@@ -16,6 +16,6 @@ func NewLoggerNotifier(log *zerolog.Logger) *LoggerNotifier {
 }
 
 func (n *LoggerNotifier) Notify(ctx context.Context, notif notification.Notification) error {
-	n.log.Info().Msg(notif.Text)
+	n.log.Info().Strs("recipients", notif.Recipients).Msg(notif.Text)
 	return nil
 }
@@ -9,7 +9,9 @@ import (
 	infra "github.com/smarrog/task-board/notification-service/internal/infrastructure/kafka"
 	uc "github.com/smarrog/task-board/notification-service/internal/usecase/notification"
 	"github.com/smarrog/task-board/shared/domain/board"
+	"github.com/smarrog/task-board/shared/domain/checklist"
 	"github.com/smarrog/task-board/shared/domain/column"
+	"github.com/smarrog/task-board/shared/domain/comment"
 	"github.com/smarrog/task-board/shared/domain/outbox"
 	"github.com/smarrog/task-board/shared/domain/task"
 )
@@ -28,18 +30,34 @@ func NewOutboxHandler(log *zerolog.Logger, ucHandler *uc.Handler, dlq *infra.Dlq
 	h := &OutboxHandler{log: log, uc: ucHandler, dlq: dlq}
 
 	h.handlers = map[string]handlerFn{
-		board.EvtCreated: makeHandler[board.CreatedEvent](h.uc.HandleBoardCreated, h.publishToDlq),
-		board.EvtUpdated: makeHandler[board.UpdatedEvent](h.uc.HandleBoardUpdated, h.publishToDlq),
-		board.EvtDeleted: makeHandler[board.DeletedEvent](h.uc.HandleBoardDeleted, h.publishToDlq),
-
-		column.EvtCreated: makeHandler[column.CreatedEvent](h.uc.HandleColumnCreated, h.publishToDlq),
-		column.EvtMoved:   makeHandler[column.MovedEvent](h.uc.HandleColumnMoved, h.publishToDlq),
-		column.EvtDeleted: makeHandler[column.DeletedEvent](h.uc.HandleColumnDeleted, h.publishToDlq),
-
-		task.EvtCreated: makeHandler[task.CreatedEvent](h.uc.HandleTaskCreated, h.publishToDlq),
-		task.EvtUpdated: makeHandler[task.UpdatedEvent](h.uc.HandleTaskUpdated, h.publishToDlq),
-		task.EvtMoved:   makeHandler[task.MovedEvent](h.uc.HandleTaskMoved, h.publishToDlq),
-		task.EvtDeleted: makeHandler[task.DeletedEvent](h.uc.HandleTaskDeleted, h.publishToDlq),
+		board.EvtCreated:    makeHandler[board.CreatedEvent](h.uc.HandleBoardCreated, h.publishToDlq),
+		board.EvtUpdated:    makeHandler[board.UpdatedEvent](h.uc.HandleBoardUpdated, h.publishToDlq),
+		board.EvtDeleted:    makeHandler[board.DeletedEvent](h.uc.HandleBoardDeleted, h.publishToDlq),
+		board.EvtRestored:   makeHandler[board.RestoredEvent](h.uc.HandleBoardRestored, h.publishToDlq),
+		board.EvtArchived:   makeHandler[board.ArchivedEvent](h.uc.HandleBoardArchived, h.publishToDlq),
+		board.EvtUnarchived: makeHandler[board.UnarchivedEvent](h.uc.HandleBoardUnarchived, h.publishToDlq),
+
+		column.EvtCreated:   makeHandler[column.CreatedEvent](h.uc.HandleColumnCreated, h.publishToDlq),
+		column.EvtMoved:     makeHandler[column.MovedEvent](h.uc.HandleColumnMoved, h.publishToDlq),
+		column.EvtDeleted:   makeHandler[column.DeletedEvent](h.uc.HandleColumnDeleted, h.publishToDlq),
+		column.EvtRestored:  makeHandler[column.RestoredEvent](h.uc.HandleColumnRestored, h.publishToDlq),
+		column.EvtReordered: makeHandler[column.ReorderedEvent](h.uc.HandleColumnsReordered, h.publishToDlq),
+
+		task.EvtCreated:    makeHandler[task.CreatedEvent](h.uc.HandleTaskCreated, h.publishToDlq),
+		task.EvtUpdated:    makeHandler[task.UpdatedEvent](h.uc.HandleTaskUpdated, h.publishToDlq),
+		task.EvtMoved:      makeHandler[task.MovedEvent](h.uc.HandleTaskMoved, h.publishToDlq),
+		task.EvtDeleted:    makeHandler[task.DeletedEvent](h.uc.HandleTaskDeleted, h.publishToDlq),
+		task.EvtRestored:   makeHandler[task.RestoredEvent](h.uc.HandleTaskRestored, h.publishToDlq),
+		task.EvtArchived:   makeHandler[task.ArchivedEvent](h.uc.HandleTaskArchived, h.publishToDlq),
+		task.EvtUnarchived: makeHandler[task.UnarchivedEvent](h.uc.HandleTaskUnarchived, h.publishToDlq),
+		task.EvtDueSoon:    makeHandler[task.DueSoonEvent](h.uc.HandleTaskDueSoon, h.publishToDlq),
+		task.EvtOverdue:    makeHandler[task.OverdueEvent](h.uc.HandleTaskOverdue, h.publishToDlq),
+
+		checklist.EvtItemCompleted: makeHandler[checklist.ItemCompletedEvent](h.uc.HandleChecklistItemCompleted, h.publishToDlq),
+		checklist.EvtItemReopened:  makeHandler[checklist.ItemReopenedEvent](h.uc.HandleChecklistItemReopened, h.publishToDlq),
+
+		comment.EvtCreated:   makeHandler[comment.CreatedEvent](h.uc.HandleCommentCreated, h.publishToDlq),
+		comment.EvtMentioned: makeHandler[comment.MentionedEvent](h.uc.HandleUserMentioned, h.publishToDlq),
 	}
 
 	return h
@@ -3,10 +3,14 @@ package notification
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	notif "github.com/smarrog/task-board/notification-service/internal/domain/notification"
 	"github.com/smarrog/task-board/shared/domain/board"
+	"github.com/smarrog/task-board/shared/domain/checklist"
 	"github.com/smarrog/task-board/shared/domain/column"
+	"github.com/smarrog/task-board/shared/domain/comment"
 	"github.com/smarrog/task-board/shared/domain/outbox"
 	"github.com/smarrog/task-board/shared/domain/task"
 )
@@ -25,15 +29,24 @@ func (h *Handler) HandleBoardCreated(ctx context.Context, env outbox.Message, e
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleBoardUpdated(ctx context.Context, env outbox.Message, e board.UpdatedEvent) error {
-	text := fmt.Sprintf("Board updated: '%s' (board_id=%s)", e.Title, e.Id)
+	var text string
+	switch {
+	case len(e.Fields) == 0:
+		// событие старого формата (ещё лежало в outbox или Kafka): все поля без списка Fields
+		text = fmt.Sprintf("Board updated: '%s' (board_id=%s)", stringValue(e.Title), e.Id)
+	case e.Title != nil:
+		text = fmt.Sprintf("Board updated: '%s', %s (board_id=%s)", *e.Title, strings.Join(e.Fields, ", "), e.Id)
+	default:
+		text = fmt.Sprintf("Board updated: %s (board_id=%s)", strings.Join(e.Fields, ", "), e.Id)
+	}
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleBoardDeleted(ctx context.Context, env outbox.Message, e board.DeletedEvent) error {
@@ -41,7 +54,31 @@ func (h *Handler) HandleBoardDeleted(ctx context.Context, env outbox.Message, e
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleBoardRestored(ctx context.Context, env outbox.Message, e board.RestoredEvent) error {
+	text := fmt.Sprintf("Board restored: (board_id=%s)", e.Id)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleBoardArchived(ctx context.Context, env outbox.Message, e board.ArchivedEvent) error {
+	text := fmt.Sprintf("Board archived: (board_id=%s)", e.Id)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleBoardUnarchived(ctx context.Context, env outbox.Message, e board.UnarchivedEvent) error {
+	text := fmt.Sprintf("Board unarchived: (board_id=%s)", e.Id)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleColumnCreated(ctx context.Context, env outbox.Message, e column.CreatedEvent) error {
@@ -49,15 +86,18 @@ func (h *Handler) HandleColumnCreated(ctx context.Context, env outbox.Message, e
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleColumnMoved(ctx context.Context, env outbox.Message, e column.MovedEvent) error {
 	text := fmt.Sprintf("Column moved: (column_id=%s, from_position=%d, to_position=%d)", e.Id, e.FromPosition, e.ToPosition)
+	if e.FromBoardId != e.ToBoardId {
+		text = fmt.Sprintf("Column moved to another board: (column_id=%s, from_board_id=%s, to_board_id=%s, to_position=%d)", e.Id, e.FromBoardId, e.ToBoardId, e.ToPosition)
+	}
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleColumnDeleted(ctx context.Context, env outbox.Message, e column.DeletedEvent) error {
@@ -65,7 +105,23 @@ func (h *Handler) HandleColumnDeleted(ctx context.Context, env outbox.Message, e
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleColumnRestored(ctx context.Context, env outbox.Message, e column.RestoredEvent) error {
+	text := fmt.Sprintf("Column restored: (column_id=%s, board_id=%s, position=%d)", e.Id, e.BoardId, e.Position)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleColumnsReordered(ctx context.Context, env outbox.Message, e column.ReorderedEvent) error {
+	text := fmt.Sprintf("Columns reordered: (board_id=%s, column_ids=%s)", e.BoardId, strings.Join(e.ColumnIds, ","))
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleTaskCreated(ctx context.Context, env outbox.Message, e task.CreatedEvent) error {
@@ -73,23 +129,35 @@ func (h *Handler) HandleTaskCreated(ctx context.Context, env outbox.Message, e t
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleTaskUpdated(ctx context.Context, env outbox.Message, e task.UpdatedEvent) error {
-	text := fmt.Sprintf("Task updated: '%s' (task_id=%s, assignee_id=%s)", e.Title, e.Id, e.AssigneeId)
+	var text string
+	switch {
+	case len(e.Fields) == 0:
+		// событие старого формата (ещё лежало в outbox или Kafka): все поля без списка Fields
+		text = fmt.Sprintf("Task updated: '%s' (task_id=%s, assignee_id=%s)", stringValue(e.Title), e.Id, stringValue(e.AssigneeId))
+	case e.AssigneeId != nil:
+		text = fmt.Sprintf("Task updated: %s (task_id=%s, assignee_id=%s)", strings.Join(e.Fields, ", "), e.Id, *e.AssigneeId)
+	default:
+		text = fmt.Sprintf("Task updated: %s (task_id=%s)", strings.Join(e.Fields, ", "), e.Id)
+	}
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleTaskMoved(ctx context.Context, env outbox.Message, e task.MovedEvent) error {
 	text := fmt.Sprintf("Task moved: (task_id=%s, from_column_id=%s, to_column_id=%s, from_position=%d, to_position=%d)", e.Id, e.FromColumnId, e.ToColumnId, e.FromPosition, e.ToPosition)
+	if e.FromBoardId != e.ToBoardId {
+		text = fmt.Sprintf("Task moved to another board: (task_id=%s, from_board_id=%s, to_board_id=%s, to_column_id=%s, to_position=%d)", e.Id, e.FromBoardId, e.ToBoardId, e.ToColumnId, e.ToPosition)
+	}
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
 }
 
 func (h *Handler) HandleTaskDeleted(ctx context.Context, env outbox.Message, e task.DeletedEvent) error {
@@ -97,7 +165,88 @@ func (h *Handler) HandleTaskDeleted(ctx context.Context, env outbox.Message, e t
 	if err := h.saveHistory(ctx, env, text); err != nil {
 		return err
 	}
-	return h.notifier.Notify(ctx, notif.Notification{Text: text})
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleTaskRestored(ctx context.Context, env outbox.Message, e task.RestoredEvent) error {
+	text := fmt.Sprintf("Task restored: (task_id=%s, column_id=%s, position=%d)", e.Id, e.ColumnId, e.Position)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleTaskArchived(ctx context.Context, env outbox.Message, e task.ArchivedEvent) error {
+	text := fmt.Sprintf("Task archived: (task_id=%s, column_id=%s)", e.Id, e.ColumnId)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleTaskUnarchived(ctx context.Context, env outbox.Message, e task.UnarchivedEvent) error {
+	text := fmt.Sprintf("Task unarchived: (task_id=%s, from_column_id=%s, to_column_id=%s, position=%d)", e.Id, e.FromColumnId, e.ToColumnId, e.Position)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleTaskDueSoon(ctx context.Context, env outbox.Message, e task.DueSoonEvent) error {
+	text := fmt.Sprintf("Task due soon: '%s' (task_id=%s, assignee_id=%s, due_at=%s)", e.Title, e.Id, e.AssigneeId, e.DueAt.Format(time.RFC3339))
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleTaskOverdue(ctx context.Context, env outbox.Message, e task.OverdueEvent) error {
+	text := fmt.Sprintf("Task overdue: '%s' (task_id=%s, assignee_id=%s, due_at=%s)", e.Title, e.Id, e.AssigneeId, e.DueAt.Format(time.RFC3339))
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleChecklistItemCompleted(ctx context.Context, env outbox.Message, e checklist.ItemCompletedEvent) error {
+	text := fmt.Sprintf("Checklist item completed: '%s' (item_id=%s, task_id=%s)", e.Text, e.Id, e.TaskId)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleChecklistItemReopened(ctx context.Context, env outbox.Message, e checklist.ItemReopenedEvent) error {
+	text := fmt.Sprintf("Checklist item reopened: '%s' (item_id=%s, task_id=%s)", e.Text, e.Id, e.TaskId)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleCommentCreated(ctx context.Context, env outbox.Message, e comment.CreatedEvent) error {
+	text := fmt.Sprintf("Comment added (comment_id=%s, task_id=%s, author_id=%s)", e.Id, e.TaskId, e.AuthorId)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	return h.notify(ctx, env.Watchers, text)
+}
+
+func (h *Handler) HandleUserMentioned(ctx context.Context, env outbox.Message, e comment.MentionedEvent) error {
+	text := fmt.Sprintf("User mentioned in comment (user_id=%s, comment_id=%s, task_id=%s, author_id=%s)", e.UserId, e.CommentId, e.TaskId, e.AuthorId)
+	if err := h.saveHistory(ctx, env, text); err != nil {
+		return err
+	}
+	// упоминание адресно: уходит только упомянутому, подписан он на задачу или нет
+	return h.notify(ctx, []string{e.UserId}, text)
+}
+
+// notify отправляет уведомление только подписчикам; без подписчиков событие остаётся лишь в истории.
+func (h *Handler) notify(ctx context.Context, recipients []string, text string) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	return h.notifier.Notify(ctx, notif.Notification{Text: text, Recipients: recipients})
 }
 
 func (h *Handler) saveHistory(ctx context.Context, env outbox.Message, text string) error {
@@ -115,3 +264,10 @@ func (h *Handler) saveHistory(ctx context.Context, env outbox.Message, text stri
 		Text:           text,
 	})
 }
+
+func stringValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
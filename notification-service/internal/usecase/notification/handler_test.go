@@ -0,0 +1,105 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	notif "github.com/smarrog/task-board/notification-service/internal/domain/notification"
+	"github.com/smarrog/task-board/shared/domain/board"
+	"github.com/smarrog/task-board/shared/domain/outbox"
+	"github.com/smarrog/task-board/shared/domain/task"
+)
+
+type recordingNotifier struct{ texts []string }
+
+func (n *recordingNotifier) Notify(_ context.Context, m notif.Notification) error {
+	n.texts = append(n.texts, m.Text)
+	return nil
+}
+
+func decodeEvent[T any](t *testing.T, payload string) T {
+	t.Helper()
+	var e T
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestHandleTaskUpdatedText(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		want    string
+	}{
+		{
+			// формат до появления Fields: все поля, без списка изменённых
+			"legacy payload",
+			`{"id":"t1","title":"Fix login","description":"d","assignee_id":"u1","priority":"high","at":"2026-01-01T00:00:00Z"}`,
+			"Task updated: 'Fix login' (task_id=t1, assignee_id=u1)",
+		},
+		{
+			"fields",
+			`{"id":"t1","fields":["title","due_at"],"title":"Fix login","at":"2026-01-01T00:00:00Z"}`,
+			"Task updated: title, due_at (task_id=t1)",
+		},
+		{
+			"assignee changed",
+			`{"id":"t1","fields":["assignee_id"],"assignee_id":"u2","at":"2026-01-01T00:00:00Z"}`,
+			"Task updated: assignee_id (task_id=t1, assignee_id=u2)",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n := &recordingNotifier{}
+			h := NewHandler(n, nil)
+			env := outbox.Message{Watchers: []string{"u1"}}
+
+			if err := h.HandleTaskUpdated(context.Background(), env, decodeEvent[task.UpdatedEvent](t, c.payload)); err != nil {
+				t.Fatal(err)
+			}
+			if len(n.texts) != 1 || n.texts[0] != c.want {
+				t.Fatalf("texts = %q, want %q", n.texts, c.want)
+			}
+		})
+	}
+}
+
+func TestHandleBoardUpdatedText(t *testing.T) {
+	cases := []struct {
+		name    string
+		payload string
+		want    string
+	}{
+		{
+			"legacy payload",
+			`{"id":"b1","title":"Roadmap","description":"d","at":"2026-01-01T00:00:00Z"}`,
+			"Board updated: 'Roadmap' (board_id=b1)",
+		},
+		{
+			"title changed",
+			`{"id":"b1","fields":["title"],"title":"Roadmap","at":"2026-01-01T00:00:00Z"}`,
+			"Board updated: 'Roadmap', title (board_id=b1)",
+		},
+		{
+			"description changed",
+			`{"id":"b1","fields":["description"],"description":"d","at":"2026-01-01T00:00:00Z"}`,
+			"Board updated: description (board_id=b1)",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n := &recordingNotifier{}
+			h := NewHandler(n, nil)
+			env := outbox.Message{Watchers: []string{"u1"}}
+
+			if err := h.HandleBoardUpdated(context.Background(), env, decodeEvent[board.UpdatedEvent](t, c.payload)); err != nil {
+				t.Fatal(err)
+			}
+			if len(n.texts) != 1 || n.texts[0] != c.want {
+				t.Fatalf("texts = %q, want %q", n.texts, c.want)
+			}
+		})
+	}
+}
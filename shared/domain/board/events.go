@@ -5,9 +5,18 @@ import (
 )
 
 const (
-	EvtCreated = "BoardCreated"
-	EvtUpdated = "BoardUpdated"
-	EvtDeleted = "BoardDeleted"
+	EvtCreated    = "BoardCreated"
+	EvtUpdated    = "BoardUpdated"
+	EvtDeleted    = "BoardDeleted"
+	EvtRestored   = "BoardRestored"
+	EvtArchived   = "BoardArchived"
+	EvtUnarchived = "BoardUnarchived"
+)
+
+// Имена изменяемых полей доски: пути маски обновления и UpdatedEvent.Fields.
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
 )
 
 type CreatedEvent struct {
@@ -21,10 +30,13 @@ type CreatedEvent struct {
 func (e CreatedEvent) Name() string          { return EvtCreated }
 func (e CreatedEvent) OccurredAt() time.Time { return e.At }
 
+// UpdatedEvent несёт только изменённые поля, их имена перечислены в Fields.
+// У событий, записанных до появления Fields, список пуст, а заполнены все поля.
 type UpdatedEvent struct {
 	Id          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
+	Fields      []string  `json:"fields"`
+	Title       *string   `json:"title,omitempty"`
+	Description *string   `json:"description,omitempty"`
 	At          time.Time `json:"at"`
 }
 
@@ -38,3 +50,27 @@ type DeletedEvent struct {
 
 func (e DeletedEvent) Name() string          { return EvtDeleted }
 func (e DeletedEvent) OccurredAt() time.Time { return e.At }
+
+type RestoredEvent struct {
+	Id string    `json:"id"`
+	At time.Time `json:"at"`
+}
+
+func (e RestoredEvent) Name() string          { return EvtRestored }
+func (e RestoredEvent) OccurredAt() time.Time { return e.At }
+
+type ArchivedEvent struct {
+	Id string    `json:"id"`
+	At time.Time `json:"at"`
+}
+
+func (e ArchivedEvent) Name() string          { return EvtArchived }
+func (e ArchivedEvent) OccurredAt() time.Time { return e.At }
+
+type UnarchivedEvent struct {
+	Id string    `json:"id"`
+	At time.Time `json:"at"`
+}
+
+func (e UnarchivedEvent) Name() string          { return EvtUnarchived }
+func (e UnarchivedEvent) OccurredAt() time.Time { return e.At }
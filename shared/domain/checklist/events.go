@@ -0,0 +1,30 @@
+package checklist
+
+import (
+	"time"
+)
+
+const (
+	EvtItemCompleted = "ChecklistItemCompleted"
+	EvtItemReopened  = "ChecklistItemReopened"
+)
+
+type ItemCompletedEvent struct {
+	Id     string    `json:"id"`
+	TaskId string    `json:"task_id"`
+	Text   string    `json:"text"`
+	At     time.Time `json:"at"`
+}
+
+func (e ItemCompletedEvent) Name() string          { return EvtItemCompleted }
+func (e ItemCompletedEvent) OccurredAt() time.Time { return e.At }
+
+type ItemReopenedEvent struct {
+	Id     string    `json:"id"`
+	TaskId string    `json:"task_id"`
+	Text   string    `json:"text"`
+	At     time.Time `json:"at"`
+}
+
+func (e ItemReopenedEvent) Name() string          { return EvtItemReopened }
+func (e ItemReopenedEvent) OccurredAt() time.Time { return e.At }
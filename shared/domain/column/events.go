@@ -5,22 +5,28 @@ import (
 )
 
 const (
-	EvtCreated = "ColumnCreated"
-	EvtMoved   = "ColumnMoved"
-	EvtDeleted = "ColumnDeleted"
+	EvtCreated   = "ColumnCreated"
+	EvtMoved     = "ColumnMoved"
+	EvtDeleted   = "ColumnDeleted"
+	EvtRestored  = "ColumnRestored"
+	EvtReordered = "ColumnsReordered"
 )
 
 type CreatedEvent struct {
 	Id      string    `json:"id"`
 	BoardId string    `json:"board_id"`
+	Title   string    `json:"title,omitempty"`
 	At      time.Time `json:"at"`
 }
 
 func (e CreatedEvent) Name() string          { return EvtCreated }
 func (e CreatedEvent) OccurredAt() time.Time { return e.At }
 
+// MovedEvent — перенос колонки; FromBoardId и ToBoardId различаются при переносе между досками.
 type MovedEvent struct {
 	Id           string    `json:"id"`
+	FromBoardId  string    `json:"from_board_id"`
+	ToBoardId    string    `json:"to_board_id"`
 	FromPosition int       `json:"from_position"`
 	ToPosition   int       `json:"to_position"`
 	At           time.Time `json:"at"`
@@ -36,3 +42,23 @@ type DeletedEvent struct {
 
 func (e DeletedEvent) Name() string          { return EvtDeleted }
 func (e DeletedEvent) OccurredAt() time.Time { return e.At }
+
+type RestoredEvent struct {
+	Id       string    `json:"id"`
+	BoardId  string    `json:"board_id"`
+	Position int       `json:"position"`
+	At       time.Time `json:"at"`
+}
+
+func (e RestoredEvent) Name() string          { return EvtRestored }
+func (e RestoredEvent) OccurredAt() time.Time { return e.At }
+
+// ReorderedEvent — новый порядок колонок доски целиком; ColumnIds идут по возрастанию позиции.
+type ReorderedEvent struct {
+	BoardId   string    `json:"board_id"`
+	ColumnIds []string  `json:"column_ids"`
+	At        time.Time `json:"at"`
+}
+
+func (e ReorderedEvent) Name() string          { return EvtReordered }
+func (e ReorderedEvent) OccurredAt() time.Time { return e.At }
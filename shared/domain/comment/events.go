@@ -0,0 +1,57 @@
+package comment
+
+import (
+	"time"
+)
+
+const (
+	EvtCreated   = "CommentCreated"
+	EvtUpdated   = "CommentUpdated"
+	EvtDeleted   = "CommentDeleted"
+	EvtMentioned = "UserMentioned"
+)
+
+type CreatedEvent struct {
+	Id       string    `json:"id"`
+	TaskId   string    `json:"task_id"`
+	AuthorId string    `json:"author_id"`
+	Body     string    `json:"body"`
+	Mentions []string  `json:"mentions,omitempty"`
+	At       time.Time `json:"at"`
+}
+
+func (e CreatedEvent) Name() string          { return EvtCreated }
+func (e CreatedEvent) OccurredAt() time.Time { return e.At }
+
+type UpdatedEvent struct {
+	Id       string    `json:"id"`
+	TaskId   string    `json:"task_id"`
+	AuthorId string    `json:"author_id"`
+	Body     string    `json:"body"`
+	Mentions []string  `json:"mentions,omitempty"`
+	At       time.Time `json:"at"`
+}
+
+func (e UpdatedEvent) Name() string          { return EvtUpdated }
+func (e UpdatedEvent) OccurredAt() time.Time { return e.At }
+
+type DeletedEvent struct {
+	Id     string    `json:"id"`
+	TaskId string    `json:"task_id"`
+	At     time.Time `json:"at"`
+}
+
+func (e DeletedEvent) Name() string          { return EvtDeleted }
+func (e DeletedEvent) OccurredAt() time.Time { return e.At }
+
+// MentionedEvent — пользователь UserId упомянут в комментарии; на каждое новое упоминание своё событие.
+type MentionedEvent struct {
+	CommentId string    `json:"comment_id"`
+	TaskId    string    `json:"task_id"`
+	AuthorId  string    `json:"author_id"`
+	UserId    string    `json:"user_id"`
+	At        time.Time `json:"at"`
+}
+
+func (e MentionedEvent) Name() string          { return EvtMentioned }
+func (e MentionedEvent) OccurredAt() time.Time { return e.At }
@@ -13,4 +13,6 @@ type Message struct {
 	CreatedAt     time.Time       `json:"created_at"`
 	Payload       json.RawMessage `json:"payload"`
 	Version       int             `json:"version"`
+	// Watchers — кто следил за задачей/доской на момент события; адресаты уведомлений.
+	Watchers []string `json:"watchers,omitempty"`
 }
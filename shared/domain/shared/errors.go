@@ -6,10 +6,15 @@ import (
 )
 
 var (
-	ErrNotFound   = errors.New("not found")
-	ErrIsEmpty    = errors.New("empty")
-	ErrIsInvalid  = errors.New("invalid")
-	ErrIsTooLong  = fmt.Errorf("%s %w", "too long", ErrIsInvalid)
-	ErrIsRequired = errors.New("required")
-	ErrIsMismatch = errors.New("mismatch")
+	ErrNotFound      = errors.New("not found")
+	ErrIsEmpty       = errors.New("empty")
+	ErrIsInvalid     = errors.New("invalid")
+	ErrIsTooLong     = fmt.Errorf("%s %w", "too long", ErrIsInvalid)
+	ErrIsRequired    = errors.New("required")
+	ErrIsMismatch    = errors.New("mismatch")
+	ErrIsDeleted     = errors.New("deleted")
+	ErrIsArchived    = errors.New("archived")
+	ErrIsNotArchived = errors.New("not archived")
+	ErrIsStale       = errors.New("stale")
+	ErrIsConflict    = errors.New("conflict")
 )
@@ -5,29 +5,53 @@ import (
 )
 
 const (
-	EvtCreated = "TaskCreated"
-	EvtUpdated = "TaskUpdated"
-	EvtMoved   = "TaskMoved"
-	EvtDeleted = "TaskDeleted"
+	EvtCreated    = "TaskCreated"
+	EvtUpdated    = "TaskUpdated"
+	EvtMoved      = "TaskMoved"
+	EvtDeleted    = "TaskDeleted"
+	EvtRestored   = "TaskRestored"
+	EvtArchived   = "TaskArchived"
+	EvtUnarchived = "TaskUnarchived"
+	EvtDueSoon    = "TaskDueSoon"
+	EvtOverdue    = "TaskOverdue"
+)
+
+// Имена изменяемых полей задачи: пути маски обновления и UpdatedEvent.Fields.
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldAssigneeId  = "assignee_id"
+	FieldStartAt     = "start_at"
+	FieldDueAt       = "due_at"
+	FieldPriority    = "priority"
+	FieldEstimate    = "estimate"
+	FieldLabelIds    = "label_ids"
 )
 
 type CreatedEvent struct {
-	Id          string    `json:"id"`
-	ColumnId    string    `json:"column_id"`
-	Position    int       `json:"position"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	AssigneeId  string    `json:"assignee_id"`
-	At          time.Time `json:"at"`
+	Id          string     `json:"id"`
+	ColumnId    string     `json:"column_id"`
+	Position    int        `json:"position"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	AssigneeId  string     `json:"assignee_id"`
+	StartAt     *time.Time `json:"start_at,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Priority    string     `json:"priority,omitempty"`
+	Estimate    *float64   `json:"estimate,omitempty"`
+	At          time.Time  `json:"at"`
 }
 
 func (e CreatedEvent) Name() string          { return EvtCreated }
 func (e CreatedEvent) OccurredAt() time.Time { return e.At }
 
+// MovedEvent — перенос задачи; FromBoardId и ToBoardId различаются при переносе между досками.
 type MovedEvent struct {
 	Id           string    `json:"id"`
 	FromColumnId string    `json:"from_column_id"`
 	ToColumnId   string    `json:"to_column_id"`
+	FromBoardId  string    `json:"from_board_id"`
+	ToBoardId    string    `json:"to_board_id"`
 	FromPosition int       `json:"from_position"`
 	ToPosition   int       `json:"to_position"`
 	At           time.Time `json:"at"`
@@ -36,12 +60,23 @@ type MovedEvent struct {
 func (e MovedEvent) Name() string          { return EvtMoved }
 func (e MovedEvent) OccurredAt() time.Time { return e.At }
 
+// UpdatedEvent несёт только изменённые поля, их имена перечислены в Fields.
+// nil у поля из Fields значит, что значение сброшено (дата, оценка).
+// У событий, записанных до появления Fields, список пуст, а заполнены все поля.
 type UpdatedEvent struct {
-	Id          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	AssigneeId  string    `json:"assignee_id"`
-	At          time.Time `json:"at"`
+	Id          string     `json:"id"`
+	Fields      []string   `json:"fields"`
+	Title       *string    `json:"title,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	AssigneeId  *string    `json:"assignee_id,omitempty"`
+	StartAt     *time.Time `json:"start_at,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Priority    *string    `json:"priority,omitempty"`
+	Estimate    *float64   `json:"estimate,omitempty"`
+	// LabelsAdded / LabelsRemoved — изменение набора меток задачи, если оно было.
+	LabelsAdded   []string  `json:"labels_added,omitempty"`
+	LabelsRemoved []string  `json:"labels_removed,omitempty"`
+	At            time.Time `json:"at"`
 }
 
 func (e UpdatedEvent) Name() string          { return EvtUpdated }
@@ -54,3 +89,57 @@ type DeletedEvent struct {
 
 func (e DeletedEvent) Name() string          { return EvtDeleted }
 func (e DeletedEvent) OccurredAt() time.Time { return e.At }
+
+type DueSoonEvent struct {
+	Id         string    `json:"id"`
+	ColumnId   string    `json:"column_id"`
+	Title      string    `json:"title"`
+	AssigneeId string    `json:"assignee_id"`
+	DueAt      time.Time `json:"due_at"`
+	At         time.Time `json:"at"`
+}
+
+func (e DueSoonEvent) Name() string          { return EvtDueSoon }
+func (e DueSoonEvent) OccurredAt() time.Time { return e.At }
+
+type OverdueEvent struct {
+	Id         string    `json:"id"`
+	ColumnId   string    `json:"column_id"`
+	Title      string    `json:"title"`
+	AssigneeId string    `json:"assignee_id"`
+	DueAt      time.Time `json:"due_at"`
+	At         time.Time `json:"at"`
+}
+
+func (e OverdueEvent) Name() string          { return EvtOverdue }
+func (e OverdueEvent) OccurredAt() time.Time { return e.At }
+
+type RestoredEvent struct {
+	Id       string    `json:"id"`
+	ColumnId string    `json:"column_id"`
+	Position int       `json:"position"`
+	At       time.Time `json:"at"`
+}
+
+func (e RestoredEvent) Name() string          { return EvtRestored }
+func (e RestoredEvent) OccurredAt() time.Time { return e.At }
+
+type ArchivedEvent struct {
+	Id       string    `json:"id"`
+	ColumnId string    `json:"column_id"`
+	At       time.Time `json:"at"`
+}
+
+func (e ArchivedEvent) Name() string          { return EvtArchived }
+func (e ArchivedEvent) OccurredAt() time.Time { return e.At }
+
+type UnarchivedEvent struct {
+	Id           string    `json:"id"`
+	FromColumnId string    `json:"from_column_id"`
+	ToColumnId   string    `json:"to_column_id"`
+	Position     int       `json:"position"`
+	At           time.Time `json:"at"`
+}
+
+func (e UnarchivedEvent) Name() string          { return EvtUnarchived }
+func (e UnarchivedEvent) OccurredAt() time.Time { return e.At }
@@ -9,6 +9,8 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -27,6 +29,8 @@ type Board struct {
 	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // задан — доска в архиве
+	Version       int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`                        // растёт с каждой записью; для ETag
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,10 +93,25 @@ func (x *Board) GetDescription() string {
 	return ""
 }
 
+func (x *Board) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Board) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type BoardFull struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
 	Columns       []*ColumnFull          `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	Labels        []*Label               `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,12 +160,20 @@ func (x *BoardFull) GetColumns() []*ColumnFull {
 	return nil
 }
 
+func (x *BoardFull) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type CreateBoardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"` // пустое при template_id — название шаблона
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // необязательный шаблон колонок и меток
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -209,6 +236,13 @@ func (x *CreateBoardRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateBoardRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
 type CreateBoardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -265,6 +299,7 @@ type GetBoardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	LabelIds      []string               `protobuf:"bytes,3,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"` // оставить только задачи хотя бы с одной из меток
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -313,6 +348,13 @@ func (x *GetBoardRequest) GetBoardId() string {
 	return ""
 }
 
+func (x *GetBoardRequest) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
 type GetBoardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -366,11 +408,17 @@ func (x *GetBoardResponse) GetData() *BoardFull {
 }
 
 type ListBoardsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OwnerId         string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                      // 0 — размер страницы по умолчанию
+	Cursor          string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                                           // next_cursor из предыдущего ответа
+	Sort            string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`                                               // "updated_at" (по умолчанию) или "title"
+	Search          string                 `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`                                           // подстрока в названии доски
+	Summary         bool                   `protobuf:"varint,7,opt,name=summary,proto3" json:"summary,omitempty"`                                        // только доски, без колонок и задач
+	IncludeArchived bool                   `protobuf:"varint,8,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // вместе с досками из архива
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListBoardsRequest) Reset() {
@@ -417,10 +465,53 @@ func (x *ListBoardsRequest) GetOwnerId() string {
 	return ""
 }
 
+func (x *ListBoardsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBoardsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBoardsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListBoardsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListBoardsRequest) GetSummary() bool {
+	if x != nil {
+		return x.Summary
+	}
+	return false
+}
+
+func (x *ListBoardsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 type ListBoardsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Boards        []*BoardFull           `protobuf:"bytes,2,rep,name=boards,proto3" json:"boards,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // пустой — страниц больше нет
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -469,13 +560,23 @@ func (x *ListBoardsResponse) GetBoards() []*BoardFull {
 	return nil
 }
 
+func (x *ListBoardsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type UpdateBoardRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId         string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	OwnerId         string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title           string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // из If-Match; 0 — без проверки
+	// пути: title, description; пустая маска — замена всех полей
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -545,6 +646,20 @@ func (x *UpdateBoardRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateBoardRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateBoardRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type UpdateBoardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -693,60 +808,790 @@ func (x *DeleteBoardResponse) GetBase() *BaseResponse {
 	return nil
 }
 
-var File_base_v1_boards_proto protoreflect.FileDescriptor
+type RestoreBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_base_v1_boards_proto_rawDesc = "" +
-	"\n" +
-	"\x14base/v1/boards.proto\x12\ftaskboard.v1\x1a\x14base/v1/common.proto\x1a\x15base/v1/columns.proto\"j\n" +
-	"\x05Board\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"j\n" +
-	"\tBoardFull\x12)\n" +
-	"\x05board\x18\x01 \x01(\v2\x13.taskboard.v1.BoardR\x05board\x122\n" +
-	"\acolumns\x18\x02 \x03(\v2\x18.taskboard.v1.ColumnFullR\acolumns\"\x96\x01\n" +
-	"\x12CreateBoardRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"r\n" +
-	"\x13CreateBoardResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
-	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"[\n" +
-	"\x0fGetBoardRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
-	"\bboard_id\x18\x02 \x01(\tR\aboardId\"o\n" +
-	"\x10GetBoardResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
-	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"]\n" +
-	"\x11ListBoardsRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
-	"\bowner_id\x18\x02 \x01(\tR\aownerId\"u\n" +
-	"\x12ListBoardsResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12/\n" +
-	"\x06boards\x18\x02 \x03(\v2\x17.taskboard.v1.BoardFullR\x06boards\"\xb1\x01\n" +
-	"\x12UpdateBoardRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
-	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"r\n" +
-	"\x13UpdateBoardResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
-	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"^\n" +
-	"\x12DeleteBoardRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
-	"\bboard_id\x18\x02 \x01(\tR\aboardId\"E\n" +
-	"\x13DeleteBoardResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base2\xa7\x03\n" +
+func (x *RestoreBoardRequest) Reset() {
+	*x = RestoreBoardRequest{}
+	mi := &file_base_v1_boards_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBoardRequest) ProtoMessage() {}
+
+func (x *RestoreBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBoardRequest.ProtoReflect.Descriptor instead.
+func (*RestoreBoardRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreBoardRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+type RestoreBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *BoardFull             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBoardResponse) Reset() {
+	*x = RestoreBoardResponse{}
+	mi := &file_base_v1_boards_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBoardResponse) ProtoMessage() {}
+
+func (x *RestoreBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBoardResponse.ProtoReflect.Descriptor instead.
+func (*RestoreBoardResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreBoardResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreBoardResponse) GetData() *BoardFull {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ArchiveBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveBoardRequest) Reset() {
+	*x = ArchiveBoardRequest{}
+	mi := &file_base_v1_boards_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveBoardRequest) ProtoMessage() {}
+
+func (x *ArchiveBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveBoardRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveBoardRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArchiveBoardRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ArchiveBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+type ArchiveBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *BoardFull             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveBoardResponse) Reset() {
+	*x = ArchiveBoardResponse{}
+	mi := &file_base_v1_boards_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveBoardResponse) ProtoMessage() {}
+
+func (x *ArchiveBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveBoardResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveBoardResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ArchiveBoardResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ArchiveBoardResponse) GetData() *BoardFull {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UnarchiveBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveBoardRequest) Reset() {
+	*x = UnarchiveBoardRequest{}
+	mi := &file_base_v1_boards_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveBoardRequest) ProtoMessage() {}
+
+func (x *UnarchiveBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveBoardRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveBoardRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnarchiveBoardRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnarchiveBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+type UnarchiveBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *BoardFull             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveBoardResponse) Reset() {
+	*x = UnarchiveBoardResponse{}
+	mi := &file_base_v1_boards_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveBoardResponse) ProtoMessage() {}
+
+func (x *UnarchiveBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveBoardResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveBoardResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnarchiveBoardResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnarchiveBoardResponse) GetData() *BoardFull {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DuplicateBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                    // пустое — "<название> (copy)"
+	IncludeTasks  bool                   `protobuf:"varint,5,opt,name=include_tasks,json=includeTasks,proto3" json:"include_tasks,omitempty"` // копировать и задачи, кроме архива
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateBoardRequest) Reset() {
+	*x = DuplicateBoardRequest{}
+	mi := &file_base_v1_boards_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateBoardRequest) ProtoMessage() {}
+
+func (x *DuplicateBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateBoardRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateBoardRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DuplicateBoardRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DuplicateBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *DuplicateBoardRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *DuplicateBoardRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DuplicateBoardRequest) GetIncludeTasks() bool {
+	if x != nil {
+		return x.IncludeTasks
+	}
+	return false
+}
+
+type DuplicateBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *BoardFull             `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateBoardResponse) Reset() {
+	*x = DuplicateBoardResponse{}
+	mi := &file_base_v1_boards_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateBoardResponse) ProtoMessage() {}
+
+func (x *DuplicateBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateBoardResponse.ProtoReflect.Descriptor instead.
+func (*DuplicateBoardResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DuplicateBoardResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DuplicateBoardResponse) GetData() *BoardFull {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TrashedColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashedColumn) Reset() {
+	*x = TrashedColumn{}
+	mi := &file_base_v1_boards_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedColumn) ProtoMessage() {}
+
+func (x *TrashedColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedColumn.ProtoReflect.Descriptor instead.
+func (*TrashedColumn) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TrashedColumn) GetColumn() *Column {
+	if x != nil {
+		return x.Column
+	}
+	return nil
+}
+
+func (x *TrashedColumn) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type TrashedTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashedTask) Reset() {
+	*x = TrashedTask{}
+	mi := &file_base_v1_boards_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedTask) ProtoMessage() {}
+
+func (x *TrashedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedTask.ProtoReflect.Descriptor instead.
+func (*TrashedTask) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TrashedTask) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TrashedTask) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type ListTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_base_v1_boards_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTrashRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTrashRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+type ListTrashResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Base           *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardDeletedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=board_deleted_at,json=boardDeletedAt,proto3" json:"board_deleted_at,omitempty"` // задан, если в корзине вся доска
+	Columns        []*TrashedColumn       `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`                                       // удалённые без доски
+	Tasks          []*TrashedTask         `protobuf:"bytes,4,rep,name=tasks,proto3" json:"tasks,omitempty"`                                           // удалённые без колонки
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_base_v1_boards_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_boards_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_boards_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTrashResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetBoardDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BoardDeletedAt
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetColumns() []*TrashedColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetTasks() []*TrashedTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+var File_base_v1_boards_proto protoreflect.FileDescriptor
+
+const file_base_v1_boards_proto_rawDesc = "" +
+	"\n" +
+	"\x14base/v1/boards.proto\x12\ftaskboard.v1\x1a\x14base/v1/common.proto\x1a\x15base/v1/columns.proto\x1a\x14base/v1/labels.proto\x1a\x13base/v1/tasks.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x01\n" +
+	"\x05Board\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12;\n" +
+	"\varchived_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\"\x97\x01\n" +
+	"\tBoardFull\x12)\n" +
+	"\x05board\x18\x01 \x01(\v2\x13.taskboard.v1.BoardR\x05board\x122\n" +
+	"\acolumns\x18\x02 \x03(\v2\x18.taskboard.v1.ColumnFullR\acolumns\x12+\n" +
+	"\x06labels\x18\x03 \x03(\v2\x13.taskboard.v1.LabelR\x06labels\"\xb7\x01\n" +
+	"\x12CreateBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vtemplate_id\x18\x05 \x01(\tR\n" +
+	"templateId\"r\n" +
+	"\x13CreateBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"x\n" +
+	"\x0fGetBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x1b\n" +
+	"\tlabel_ids\x18\x03 \x03(\tR\blabelIds\"o\n" +
+	"\x10GetBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"\x83\x02\n" +
+	"\x11ListBoardsRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06search\x18\x06 \x01(\tR\x06search\x12\x18\n" +
+	"\asummary\x18\a \x01(\bR\asummary\x12)\n" +
+	"\x10include_archived\x18\b \x01(\bR\x0fincludeArchived\"\x96\x01\n" +
+	"\x12ListBoardsResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12/\n" +
+	"\x06boards\x18\x02 \x03(\v2\x17.taskboard.v1.BoardFullR\x06boards\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x99\x02\n" +
+	"\x12UpdateBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x03R\x0fexpectedVersion\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"r\n" +
+	"\x13UpdateBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"^\n" +
+	"\x12DeleteBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\"E\n" +
+	"\x13DeleteBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\"_\n" +
+	"\x13RestoreBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\"s\n" +
+	"\x14RestoreBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"_\n" +
+	"\x13ArchiveBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\"s\n" +
+	"\x14ArchiveBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"a\n" +
+	"\x15UnarchiveBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\"u\n" +
+	"\x16UnarchiveBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"\xb7\x01\n" +
+	"\x15DuplicateBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12#\n" +
+	"\rinclude_tasks\x18\x05 \x01(\bR\fincludeTasks\"u\n" +
+	"\x16DuplicateBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.taskboard.v1.BoardFullR\x04data\"x\n" +
+	"\rTrashedColumn\x12,\n" +
+	"\x06column\x18\x01 \x01(\v2\x14.taskboard.v1.ColumnR\x06column\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"p\n" +
+	"\vTrashedTask\x12&\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.taskboard.v1.TaskR\x04task\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\\\n" +
+	"\x10ListTrashRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\"\xf1\x01\n" +
+	"\x11ListTrashResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12D\n" +
+	"\x10board_deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0eboardDeletedAt\x125\n" +
+	"\acolumns\x18\x03 \x03(\v2\x1b.taskboard.v1.TrashedColumnR\acolumns\x12/\n" +
+	"\x05tasks\x18\x04 \x03(\v2\x19.taskboard.v1.TrashedTaskR\x05tasks2\xdd\x06\n" +
 	"\rBoardsService\x12R\n" +
 	"\vCreateBoard\x12 .taskboard.v1.CreateBoardRequest\x1a!.taskboard.v1.CreateBoardResponse\x12I\n" +
 	"\bGetBoard\x12\x1d.taskboard.v1.GetBoardRequest\x1a\x1e.taskboard.v1.GetBoardResponse\x12O\n" +
 	"\n" +
 	"ListBoards\x12\x1f.taskboard.v1.ListBoardsRequest\x1a .taskboard.v1.ListBoardsResponse\x12R\n" +
 	"\vUpdateBoard\x12 .taskboard.v1.UpdateBoardRequest\x1a!.taskboard.v1.UpdateBoardResponse\x12R\n" +
-	"\vDeleteBoard\x12 .taskboard.v1.DeleteBoardRequest\x1a!.taskboard.v1.DeleteBoardResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
+	"\vDeleteBoard\x12 .taskboard.v1.DeleteBoardRequest\x1a!.taskboard.v1.DeleteBoardResponse\x12U\n" +
+	"\fRestoreBoard\x12!.taskboard.v1.RestoreBoardRequest\x1a\".taskboard.v1.RestoreBoardResponse\x12L\n" +
+	"\tListTrash\x12\x1e.taskboard.v1.ListTrashRequest\x1a\x1f.taskboard.v1.ListTrashResponse\x12U\n" +
+	"\fArchiveBoard\x12!.taskboard.v1.ArchiveBoardRequest\x1a\".taskboard.v1.ArchiveBoardResponse\x12[\n" +
+	"\x0eUnarchiveBoard\x12#.taskboard.v1.UnarchiveBoardRequest\x1a$.taskboard.v1.UnarchiveBoardResponse\x12[\n" +
+	"\x0eDuplicateBoard\x12#.taskboard.v1.DuplicateBoardRequest\x1a$.taskboard.v1.DuplicateBoardResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
 
 var (
 	file_base_v1_boards_proto_rawDescOnce sync.Once
@@ -760,56 +1605,107 @@ func file_base_v1_boards_proto_rawDescGZIP() []byte {
 	return file_base_v1_boards_proto_rawDescData
 }
 
-var file_base_v1_boards_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_base_v1_boards_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_base_v1_boards_proto_goTypes = []any{
-	(*Board)(nil),               // 0: taskboard.v1.Board
-	(*BoardFull)(nil),           // 1: taskboard.v1.BoardFull
-	(*CreateBoardRequest)(nil),  // 2: taskboard.v1.CreateBoardRequest
-	(*CreateBoardResponse)(nil), // 3: taskboard.v1.CreateBoardResponse
-	(*GetBoardRequest)(nil),     // 4: taskboard.v1.GetBoardRequest
-	(*GetBoardResponse)(nil),    // 5: taskboard.v1.GetBoardResponse
-	(*ListBoardsRequest)(nil),   // 6: taskboard.v1.ListBoardsRequest
-	(*ListBoardsResponse)(nil),  // 7: taskboard.v1.ListBoardsResponse
-	(*UpdateBoardRequest)(nil),  // 8: taskboard.v1.UpdateBoardRequest
-	(*UpdateBoardResponse)(nil), // 9: taskboard.v1.UpdateBoardResponse
-	(*DeleteBoardRequest)(nil),  // 10: taskboard.v1.DeleteBoardRequest
-	(*DeleteBoardResponse)(nil), // 11: taskboard.v1.DeleteBoardResponse
-	(*ColumnFull)(nil),          // 12: taskboard.v1.ColumnFull
-	(*BaseRequest)(nil),         // 13: taskboard.v1.BaseRequest
-	(*BaseResponse)(nil),        // 14: taskboard.v1.BaseResponse
+	(*Board)(nil),                  // 0: taskboard.v1.Board
+	(*BoardFull)(nil),              // 1: taskboard.v1.BoardFull
+	(*CreateBoardRequest)(nil),     // 2: taskboard.v1.CreateBoardRequest
+	(*CreateBoardResponse)(nil),    // 3: taskboard.v1.CreateBoardResponse
+	(*GetBoardRequest)(nil),        // 4: taskboard.v1.GetBoardRequest
+	(*GetBoardResponse)(nil),       // 5: taskboard.v1.GetBoardResponse
+	(*ListBoardsRequest)(nil),      // 6: taskboard.v1.ListBoardsRequest
+	(*ListBoardsResponse)(nil),     // 7: taskboard.v1.ListBoardsResponse
+	(*UpdateBoardRequest)(nil),     // 8: taskboard.v1.UpdateBoardRequest
+	(*UpdateBoardResponse)(nil),    // 9: taskboard.v1.UpdateBoardResponse
+	(*DeleteBoardRequest)(nil),     // 10: taskboard.v1.DeleteBoardRequest
+	(*DeleteBoardResponse)(nil),    // 11: taskboard.v1.DeleteBoardResponse
+	(*RestoreBoardRequest)(nil),    // 12: taskboard.v1.RestoreBoardRequest
+	(*RestoreBoardResponse)(nil),   // 13: taskboard.v1.RestoreBoardResponse
+	(*ArchiveBoardRequest)(nil),    // 14: taskboard.v1.ArchiveBoardRequest
+	(*ArchiveBoardResponse)(nil),   // 15: taskboard.v1.ArchiveBoardResponse
+	(*UnarchiveBoardRequest)(nil),  // 16: taskboard.v1.UnarchiveBoardRequest
+	(*UnarchiveBoardResponse)(nil), // 17: taskboard.v1.UnarchiveBoardResponse
+	(*DuplicateBoardRequest)(nil),  // 18: taskboard.v1.DuplicateBoardRequest
+	(*DuplicateBoardResponse)(nil), // 19: taskboard.v1.DuplicateBoardResponse
+	(*TrashedColumn)(nil),          // 20: taskboard.v1.TrashedColumn
+	(*TrashedTask)(nil),            // 21: taskboard.v1.TrashedTask
+	(*ListTrashRequest)(nil),       // 22: taskboard.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),      // 23: taskboard.v1.ListTrashResponse
+	(*timestamppb.Timestamp)(nil),  // 24: google.protobuf.Timestamp
+	(*ColumnFull)(nil),             // 25: taskboard.v1.ColumnFull
+	(*Label)(nil),                  // 26: taskboard.v1.Label
+	(*BaseRequest)(nil),            // 27: taskboard.v1.BaseRequest
+	(*BaseResponse)(nil),           // 28: taskboard.v1.BaseResponse
+	(*fieldmaskpb.FieldMask)(nil),  // 29: google.protobuf.FieldMask
+	(*Column)(nil),                 // 30: taskboard.v1.Column
+	(*Task)(nil),                   // 31: taskboard.v1.Task
 }
 var file_base_v1_boards_proto_depIdxs = []int32{
-	0,  // 0: taskboard.v1.BoardFull.board:type_name -> taskboard.v1.Board
-	12, // 1: taskboard.v1.BoardFull.columns:type_name -> taskboard.v1.ColumnFull
-	13, // 2: taskboard.v1.CreateBoardRequest.base:type_name -> taskboard.v1.BaseRequest
-	14, // 3: taskboard.v1.CreateBoardResponse.base:type_name -> taskboard.v1.BaseResponse
-	1,  // 4: taskboard.v1.CreateBoardResponse.data:type_name -> taskboard.v1.BoardFull
-	13, // 5: taskboard.v1.GetBoardRequest.base:type_name -> taskboard.v1.BaseRequest
-	14, // 6: taskboard.v1.GetBoardResponse.base:type_name -> taskboard.v1.BaseResponse
-	1,  // 7: taskboard.v1.GetBoardResponse.data:type_name -> taskboard.v1.BoardFull
-	13, // 8: taskboard.v1.ListBoardsRequest.base:type_name -> taskboard.v1.BaseRequest
-	14, // 9: taskboard.v1.ListBoardsResponse.base:type_name -> taskboard.v1.BaseResponse
-	1,  // 10: taskboard.v1.ListBoardsResponse.boards:type_name -> taskboard.v1.BoardFull
-	13, // 11: taskboard.v1.UpdateBoardRequest.base:type_name -> taskboard.v1.BaseRequest
-	14, // 12: taskboard.v1.UpdateBoardResponse.base:type_name -> taskboard.v1.BaseResponse
-	1,  // 13: taskboard.v1.UpdateBoardResponse.data:type_name -> taskboard.v1.BoardFull
-	13, // 14: taskboard.v1.DeleteBoardRequest.base:type_name -> taskboard.v1.BaseRequest
-	14, // 15: taskboard.v1.DeleteBoardResponse.base:type_name -> taskboard.v1.BaseResponse
-	2,  // 16: taskboard.v1.BoardsService.CreateBoard:input_type -> taskboard.v1.CreateBoardRequest
-	4,  // 17: taskboard.v1.BoardsService.GetBoard:input_type -> taskboard.v1.GetBoardRequest
-	6,  // 18: taskboard.v1.BoardsService.ListBoards:input_type -> taskboard.v1.ListBoardsRequest
-	8,  // 19: taskboard.v1.BoardsService.UpdateBoard:input_type -> taskboard.v1.UpdateBoardRequest
-	10, // 20: taskboard.v1.BoardsService.DeleteBoard:input_type -> taskboard.v1.DeleteBoardRequest
-	3,  // 21: taskboard.v1.BoardsService.CreateBoard:output_type -> taskboard.v1.CreateBoardResponse
-	5,  // 22: taskboard.v1.BoardsService.GetBoard:output_type -> taskboard.v1.GetBoardResponse
-	7,  // 23: taskboard.v1.BoardsService.ListBoards:output_type -> taskboard.v1.ListBoardsResponse
-	9,  // 24: taskboard.v1.BoardsService.UpdateBoard:output_type -> taskboard.v1.UpdateBoardResponse
-	11, // 25: taskboard.v1.BoardsService.DeleteBoard:output_type -> taskboard.v1.DeleteBoardResponse
-	21, // [21:26] is the sub-list for method output_type
-	16, // [16:21] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	24, // 0: taskboard.v1.Board.archived_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: taskboard.v1.BoardFull.board:type_name -> taskboard.v1.Board
+	25, // 2: taskboard.v1.BoardFull.columns:type_name -> taskboard.v1.ColumnFull
+	26, // 3: taskboard.v1.BoardFull.labels:type_name -> taskboard.v1.Label
+	27, // 4: taskboard.v1.CreateBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 5: taskboard.v1.CreateBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 6: taskboard.v1.CreateBoardResponse.data:type_name -> taskboard.v1.BoardFull
+	27, // 7: taskboard.v1.GetBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 8: taskboard.v1.GetBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 9: taskboard.v1.GetBoardResponse.data:type_name -> taskboard.v1.BoardFull
+	27, // 10: taskboard.v1.ListBoardsRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 11: taskboard.v1.ListBoardsResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 12: taskboard.v1.ListBoardsResponse.boards:type_name -> taskboard.v1.BoardFull
+	27, // 13: taskboard.v1.UpdateBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	29, // 14: taskboard.v1.UpdateBoardRequest.update_mask:type_name -> google.protobuf.FieldMask
+	28, // 15: taskboard.v1.UpdateBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 16: taskboard.v1.UpdateBoardResponse.data:type_name -> taskboard.v1.BoardFull
+	27, // 17: taskboard.v1.DeleteBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 18: taskboard.v1.DeleteBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	27, // 19: taskboard.v1.RestoreBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 20: taskboard.v1.RestoreBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 21: taskboard.v1.RestoreBoardResponse.data:type_name -> taskboard.v1.BoardFull
+	27, // 22: taskboard.v1.ArchiveBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 23: taskboard.v1.ArchiveBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 24: taskboard.v1.ArchiveBoardResponse.data:type_name -> taskboard.v1.BoardFull
+	27, // 25: taskboard.v1.UnarchiveBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 26: taskboard.v1.UnarchiveBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 27: taskboard.v1.UnarchiveBoardResponse.data:type_name -> taskboard.v1.BoardFull
+	27, // 28: taskboard.v1.DuplicateBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 29: taskboard.v1.DuplicateBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 30: taskboard.v1.DuplicateBoardResponse.data:type_name -> taskboard.v1.BoardFull
+	30, // 31: taskboard.v1.TrashedColumn.column:type_name -> taskboard.v1.Column
+	24, // 32: taskboard.v1.TrashedColumn.deleted_at:type_name -> google.protobuf.Timestamp
+	31, // 33: taskboard.v1.TrashedTask.task:type_name -> taskboard.v1.Task
+	24, // 34: taskboard.v1.TrashedTask.deleted_at:type_name -> google.protobuf.Timestamp
+	27, // 35: taskboard.v1.ListTrashRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 36: taskboard.v1.ListTrashResponse.base:type_name -> taskboard.v1.BaseResponse
+	24, // 37: taskboard.v1.ListTrashResponse.board_deleted_at:type_name -> google.protobuf.Timestamp
+	20, // 38: taskboard.v1.ListTrashResponse.columns:type_name -> taskboard.v1.TrashedColumn
+	21, // 39: taskboard.v1.ListTrashResponse.tasks:type_name -> taskboard.v1.TrashedTask
+	2,  // 40: taskboard.v1.BoardsService.CreateBoard:input_type -> taskboard.v1.CreateBoardRequest
+	4,  // 41: taskboard.v1.BoardsService.GetBoard:input_type -> taskboard.v1.GetBoardRequest
+	6,  // 42: taskboard.v1.BoardsService.ListBoards:input_type -> taskboard.v1.ListBoardsRequest
+	8,  // 43: taskboard.v1.BoardsService.UpdateBoard:input_type -> taskboard.v1.UpdateBoardRequest
+	10, // 44: taskboard.v1.BoardsService.DeleteBoard:input_type -> taskboard.v1.DeleteBoardRequest
+	12, // 45: taskboard.v1.BoardsService.RestoreBoard:input_type -> taskboard.v1.RestoreBoardRequest
+	22, // 46: taskboard.v1.BoardsService.ListTrash:input_type -> taskboard.v1.ListTrashRequest
+	14, // 47: taskboard.v1.BoardsService.ArchiveBoard:input_type -> taskboard.v1.ArchiveBoardRequest
+	16, // 48: taskboard.v1.BoardsService.UnarchiveBoard:input_type -> taskboard.v1.UnarchiveBoardRequest
+	18, // 49: taskboard.v1.BoardsService.DuplicateBoard:input_type -> taskboard.v1.DuplicateBoardRequest
+	3,  // 50: taskboard.v1.BoardsService.CreateBoard:output_type -> taskboard.v1.CreateBoardResponse
+	5,  // 51: taskboard.v1.BoardsService.GetBoard:output_type -> taskboard.v1.GetBoardResponse
+	7,  // 52: taskboard.v1.BoardsService.ListBoards:output_type -> taskboard.v1.ListBoardsResponse
+	9,  // 53: taskboard.v1.BoardsService.UpdateBoard:output_type -> taskboard.v1.UpdateBoardResponse
+	11, // 54: taskboard.v1.BoardsService.DeleteBoard:output_type -> taskboard.v1.DeleteBoardResponse
+	13, // 55: taskboard.v1.BoardsService.RestoreBoard:output_type -> taskboard.v1.RestoreBoardResponse
+	23, // 56: taskboard.v1.BoardsService.ListTrash:output_type -> taskboard.v1.ListTrashResponse
+	15, // 57: taskboard.v1.BoardsService.ArchiveBoard:output_type -> taskboard.v1.ArchiveBoardResponse
+	17, // 58: taskboard.v1.BoardsService.UnarchiveBoard:output_type -> taskboard.v1.UnarchiveBoardResponse
+	19, // 59: taskboard.v1.BoardsService.DuplicateBoard:output_type -> taskboard.v1.DuplicateBoardResponse
+	50, // [50:60] is the sub-list for method output_type
+	40, // [40:50] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_base_v1_boards_proto_init() }
@@ -819,13 +1715,15 @@ func file_base_v1_boards_proto_init() {
 	}
 	file_base_v1_common_proto_init()
 	file_base_v1_columns_proto_init()
+	file_base_v1_labels_proto_init()
+	file_base_v1_tasks_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_base_v1_boards_proto_rawDesc), len(file_base_v1_boards_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
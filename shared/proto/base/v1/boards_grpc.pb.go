@@ -19,11 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BoardsService_CreateBoard_FullMethodName = "/taskboard.v1.BoardsService/CreateBoard"
-	BoardsService_GetBoard_FullMethodName    = "/taskboard.v1.BoardsService/GetBoard"
-	BoardsService_ListBoards_FullMethodName  = "/taskboard.v1.BoardsService/ListBoards"
-	BoardsService_UpdateBoard_FullMethodName = "/taskboard.v1.BoardsService/UpdateBoard"
-	BoardsService_DeleteBoard_FullMethodName = "/taskboard.v1.BoardsService/DeleteBoard"
+	BoardsService_CreateBoard_FullMethodName    = "/taskboard.v1.BoardsService/CreateBoard"
+	BoardsService_GetBoard_FullMethodName       = "/taskboard.v1.BoardsService/GetBoard"
+	BoardsService_ListBoards_FullMethodName     = "/taskboard.v1.BoardsService/ListBoards"
+	BoardsService_UpdateBoard_FullMethodName    = "/taskboard.v1.BoardsService/UpdateBoard"
+	BoardsService_DeleteBoard_FullMethodName    = "/taskboard.v1.BoardsService/DeleteBoard"
+	BoardsService_RestoreBoard_FullMethodName   = "/taskboard.v1.BoardsService/RestoreBoard"
+	BoardsService_ListTrash_FullMethodName      = "/taskboard.v1.BoardsService/ListTrash"
+	BoardsService_ArchiveBoard_FullMethodName   = "/taskboard.v1.BoardsService/ArchiveBoard"
+	BoardsService_UnarchiveBoard_FullMethodName = "/taskboard.v1.BoardsService/UnarchiveBoard"
+	BoardsService_DuplicateBoard_FullMethodName = "/taskboard.v1.BoardsService/DuplicateBoard"
 )
 
 // BoardsServiceClient is the client API for BoardsService service.
@@ -35,6 +40,11 @@ type BoardsServiceClient interface {
 	ListBoards(ctx context.Context, in *ListBoardsRequest, opts ...grpc.CallOption) (*ListBoardsResponse, error)
 	UpdateBoard(ctx context.Context, in *UpdateBoardRequest, opts ...grpc.CallOption) (*UpdateBoardResponse, error)
 	DeleteBoard(ctx context.Context, in *DeleteBoardRequest, opts ...grpc.CallOption) (*DeleteBoardResponse, error)
+	RestoreBoard(ctx context.Context, in *RestoreBoardRequest, opts ...grpc.CallOption) (*RestoreBoardResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	ArchiveBoard(ctx context.Context, in *ArchiveBoardRequest, opts ...grpc.CallOption) (*ArchiveBoardResponse, error)
+	UnarchiveBoard(ctx context.Context, in *UnarchiveBoardRequest, opts ...grpc.CallOption) (*UnarchiveBoardResponse, error)
+	DuplicateBoard(ctx context.Context, in *DuplicateBoardRequest, opts ...grpc.CallOption) (*DuplicateBoardResponse, error)
 }
 
 type boardsServiceClient struct {
@@ -95,6 +105,56 @@ func (c *boardsServiceClient) DeleteBoard(ctx context.Context, in *DeleteBoardRe
 	return out, nil
 }
 
+func (c *boardsServiceClient) RestoreBoard(ctx context.Context, in *RestoreBoardRequest, opts ...grpc.CallOption) (*RestoreBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreBoardResponse)
+	err := c.cc.Invoke(ctx, BoardsService_RestoreBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardsServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, BoardsService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardsServiceClient) ArchiveBoard(ctx context.Context, in *ArchiveBoardRequest, opts ...grpc.CallOption) (*ArchiveBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveBoardResponse)
+	err := c.cc.Invoke(ctx, BoardsService_ArchiveBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardsServiceClient) UnarchiveBoard(ctx context.Context, in *UnarchiveBoardRequest, opts ...grpc.CallOption) (*UnarchiveBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveBoardResponse)
+	err := c.cc.Invoke(ctx, BoardsService_UnarchiveBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardsServiceClient) DuplicateBoard(ctx context.Context, in *DuplicateBoardRequest, opts ...grpc.CallOption) (*DuplicateBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DuplicateBoardResponse)
+	err := c.cc.Invoke(ctx, BoardsService_DuplicateBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BoardsServiceServer is the server API for BoardsService service.
 // All implementations must embed UnimplementedBoardsServiceServer
 // for forward compatibility.
@@ -104,6 +164,11 @@ type BoardsServiceServer interface {
 	ListBoards(context.Context, *ListBoardsRequest) (*ListBoardsResponse, error)
 	UpdateBoard(context.Context, *UpdateBoardRequest) (*UpdateBoardResponse, error)
 	DeleteBoard(context.Context, *DeleteBoardRequest) (*DeleteBoardResponse, error)
+	RestoreBoard(context.Context, *RestoreBoardRequest) (*RestoreBoardResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	ArchiveBoard(context.Context, *ArchiveBoardRequest) (*ArchiveBoardResponse, error)
+	UnarchiveBoard(context.Context, *UnarchiveBoardRequest) (*UnarchiveBoardResponse, error)
+	DuplicateBoard(context.Context, *DuplicateBoardRequest) (*DuplicateBoardResponse, error)
 	mustEmbedUnimplementedBoardsServiceServer()
 }
 
@@ -129,6 +194,21 @@ func (UnimplementedBoardsServiceServer) UpdateBoard(context.Context, *UpdateBoar
 func (UnimplementedBoardsServiceServer) DeleteBoard(context.Context, *DeleteBoardRequest) (*DeleteBoardResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteBoard not implemented")
 }
+func (UnimplementedBoardsServiceServer) RestoreBoard(context.Context, *RestoreBoardRequest) (*RestoreBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreBoard not implemented")
+}
+func (UnimplementedBoardsServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedBoardsServiceServer) ArchiveBoard(context.Context, *ArchiveBoardRequest) (*ArchiveBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveBoard not implemented")
+}
+func (UnimplementedBoardsServiceServer) UnarchiveBoard(context.Context, *UnarchiveBoardRequest) (*UnarchiveBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnarchiveBoard not implemented")
+}
+func (UnimplementedBoardsServiceServer) DuplicateBoard(context.Context, *DuplicateBoardRequest) (*DuplicateBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateBoard not implemented")
+}
 func (UnimplementedBoardsServiceServer) mustEmbedUnimplementedBoardsServiceServer() {}
 func (UnimplementedBoardsServiceServer) testEmbeddedByValue()                       {}
 
@@ -240,6 +320,96 @@ func _BoardsService_DeleteBoard_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardsService_RestoreBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardsServiceServer).RestoreBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardsService_RestoreBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardsServiceServer).RestoreBoard(ctx, req.(*RestoreBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardsService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardsServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardsService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardsServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardsService_ArchiveBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardsServiceServer).ArchiveBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardsService_ArchiveBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardsServiceServer).ArchiveBoard(ctx, req.(*ArchiveBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardsService_UnarchiveBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardsServiceServer).UnarchiveBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardsService_UnarchiveBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardsServiceServer).UnarchiveBoard(ctx, req.(*UnarchiveBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardsService_DuplicateBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardsServiceServer).DuplicateBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BoardsService_DuplicateBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardsServiceServer).DuplicateBoard(ctx, req.(*DuplicateBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BoardsService_ServiceDesc is the grpc.ServiceDesc for BoardsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +437,26 @@ var BoardsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBoard",
 			Handler:    _BoardsService_DeleteBoard_Handler,
 		},
+		{
+			MethodName: "RestoreBoard",
+			Handler:    _BoardsService_RestoreBoard_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _BoardsService_ListTrash_Handler,
+		},
+		{
+			MethodName: "ArchiveBoard",
+			Handler:    _BoardsService_ArchiveBoard_Handler,
+		},
+		{
+			MethodName: "UnarchiveBoard",
+			Handler:    _BoardsService_UnarchiveBoard_Handler,
+		},
+		{
+			MethodName: "DuplicateBoard",
+			Handler:    _BoardsService_DuplicateBoard_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/boards.proto",
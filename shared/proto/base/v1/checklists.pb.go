@@ -0,0 +1,972 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: base/v1/checklists.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Done          bool                   `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_base_v1_checklists_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChecklistItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChecklistItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type ChecklistProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Done          int32                  `protobuf:"varint,1,opt,name=done,proto3" json:"done,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistProgress) Reset() {
+	*x = ChecklistProgress{}
+	mi := &file_base_v1_checklists_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistProgress) ProtoMessage() {}
+
+func (x *ChecklistProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistProgress.ProtoReflect.Descriptor instead.
+func (*ChecklistProgress) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChecklistProgress) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *ChecklistProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"` // < 0 — в конец списка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistItemRequest) Reset() {
+	*x = CreateChecklistItemRequest{}
+	mi := &file_base_v1_checklists_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistItemRequest) ProtoMessage() {}
+
+func (x *CreateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateChecklistItemRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateChecklistItemRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateChecklistItemRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *CreateChecklistItemRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CreateChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Item          *ChecklistItem         `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistItemResponse) Reset() {
+	*x = CreateChecklistItemResponse{}
+	mi := &file_base_v1_checklists_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistItemResponse) ProtoMessage() {}
+
+func (x *CreateChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateChecklistItemResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateChecklistItemResponse) GetItem() *ChecklistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ListChecklistItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistItemsRequest) Reset() {
+	*x = ListChecklistItemsRequest{}
+	mi := &file_base_v1_checklists_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistItemsRequest) ProtoMessage() {}
+
+func (x *ListChecklistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListChecklistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListChecklistItemsRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListChecklistItemsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type ListChecklistItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*ChecklistItem       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Progress      *ChecklistProgress     `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistItemsResponse) Reset() {
+	*x = ListChecklistItemsResponse{}
+	mi := &file_base_v1_checklists_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistItemsResponse) ProtoMessage() {}
+
+func (x *ListChecklistItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListChecklistItemsResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListChecklistItemsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListChecklistItemsResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListChecklistItemsResponse) GetProgress() *ChecklistProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type UpdateChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChecklistItemRequest) Reset() {
+	*x = UpdateChecklistItemRequest{}
+	mi := &file_base_v1_checklists_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChecklistItemRequest) ProtoMessage() {}
+
+func (x *UpdateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateChecklistItemRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateChecklistItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *UpdateChecklistItemRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type UpdateChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Item          *ChecklistItem         `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChecklistItemResponse) Reset() {
+	*x = UpdateChecklistItemResponse{}
+	mi := &file_base_v1_checklists_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChecklistItemResponse) ProtoMessage() {}
+
+func (x *UpdateChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateChecklistItemResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UpdateChecklistItemResponse) GetItem() *ChecklistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SetChecklistItemDoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Done          bool                   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChecklistItemDoneRequest) Reset() {
+	*x = SetChecklistItemDoneRequest{}
+	mi := &file_base_v1_checklists_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChecklistItemDoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChecklistItemDoneRequest) ProtoMessage() {}
+
+func (x *SetChecklistItemDoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChecklistItemDoneRequest.ProtoReflect.Descriptor instead.
+func (*SetChecklistItemDoneRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetChecklistItemDoneRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SetChecklistItemDoneRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *SetChecklistItemDoneRequest) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type SetChecklistItemDoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Item          *ChecklistItem         `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChecklistItemDoneResponse) Reset() {
+	*x = SetChecklistItemDoneResponse{}
+	mi := &file_base_v1_checklists_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChecklistItemDoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChecklistItemDoneResponse) ProtoMessage() {}
+
+func (x *SetChecklistItemDoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChecklistItemDoneResponse.ProtoReflect.Descriptor instead.
+func (*SetChecklistItemDoneResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetChecklistItemDoneResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SetChecklistItemDoneResponse) GetItem() *ChecklistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type MoveChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ToPosition    int32                  `protobuf:"varint,3,opt,name=to_position,json=toPosition,proto3" json:"to_position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveChecklistItemRequest) Reset() {
+	*x = MoveChecklistItemRequest{}
+	mi := &file_base_v1_checklists_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveChecklistItemRequest) ProtoMessage() {}
+
+func (x *MoveChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*MoveChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MoveChecklistItemRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MoveChecklistItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MoveChecklistItemRequest) GetToPosition() int32 {
+	if x != nil {
+		return x.ToPosition
+	}
+	return 0
+}
+
+type MoveChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Item          *ChecklistItem         `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveChecklistItemResponse) Reset() {
+	*x = MoveChecklistItemResponse{}
+	mi := &file_base_v1_checklists_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveChecklistItemResponse) ProtoMessage() {}
+
+func (x *MoveChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*MoveChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MoveChecklistItemResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MoveChecklistItemResponse) GetItem() *ChecklistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemRequest) Reset() {
+	*x = DeleteChecklistItemRequest{}
+	mi := &file_base_v1_checklists_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemRequest) ProtoMessage() {}
+
+func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteChecklistItemRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DeleteChecklistItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type DeleteChecklistItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemResponse) Reset() {
+	*x = DeleteChecklistItemResponse{}
+	mi := &file_base_v1_checklists_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemResponse) ProtoMessage() {}
+
+func (x *DeleteChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_checklists_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_checklists_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteChecklistItemResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_base_v1_checklists_proto protoreflect.FileDescriptor
+
+const file_base_v1_checklists_proto_rawDesc = "" +
+	"\n" +
+	"\x18base/v1/checklists.proto\x12\ftaskboard.v1\x1a\x14base/v1/common.proto\"|\n" +
+	"\rChecklistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x12\n" +
+	"\x04done\x18\x05 \x01(\bR\x04done\"=\n" +
+	"\x11ChecklistProgress\x12\x12\n" +
+	"\x04done\x18\x01 \x01(\x05R\x04done\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x94\x01\n" +
+	"\x1aCreateChecklistItemRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"~\n" +
+	"\x1bCreateChecklistItemResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12/\n" +
+	"\x04item\x18\x02 \x01(\v2\x1b.taskboard.v1.ChecklistItemR\x04item\"c\n" +
+	"\x19ListChecklistItemsRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"\xbc\x01\n" +
+	"\x1aListChecklistItemsResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x121\n" +
+	"\x05items\x18\x02 \x03(\v2\x1b.taskboard.v1.ChecklistItemR\x05items\x12;\n" +
+	"\bprogress\x18\x03 \x01(\v2\x1f.taskboard.v1.ChecklistProgressR\bprogress\"x\n" +
+	"\x1aUpdateChecklistItemRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"~\n" +
+	"\x1bUpdateChecklistItemResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12/\n" +
+	"\x04item\x18\x02 \x01(\v2\x1b.taskboard.v1.ChecklistItemR\x04item\"y\n" +
+	"\x1bSetChecklistItemDoneRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x12\n" +
+	"\x04done\x18\x03 \x01(\bR\x04done\"\x7f\n" +
+	"\x1cSetChecklistItemDoneResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12/\n" +
+	"\x04item\x18\x02 \x01(\v2\x1b.taskboard.v1.ChecklistItemR\x04item\"\x83\x01\n" +
+	"\x18MoveChecklistItemRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12\x1f\n" +
+	"\vto_position\x18\x03 \x01(\x05R\n" +
+	"toPosition\"|\n" +
+	"\x19MoveChecklistItemResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12/\n" +
+	"\x04item\x18\x02 \x01(\v2\x1b.taskboard.v1.ChecklistItemR\x04item\"d\n" +
+	"\x1aDeleteChecklistItemRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\"M\n" +
+	"\x1bDeleteChecklistItemResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base2\x95\x05\n" +
+	"\x11ChecklistsService\x12j\n" +
+	"\x13CreateChecklistItem\x12(.taskboard.v1.CreateChecklistItemRequest\x1a).taskboard.v1.CreateChecklistItemResponse\x12g\n" +
+	"\x12ListChecklistItems\x12'.taskboard.v1.ListChecklistItemsRequest\x1a(.taskboard.v1.ListChecklistItemsResponse\x12j\n" +
+	"\x13UpdateChecklistItem\x12(.taskboard.v1.UpdateChecklistItemRequest\x1a).taskboard.v1.UpdateChecklistItemResponse\x12m\n" +
+	"\x14SetChecklistItemDone\x12).taskboard.v1.SetChecklistItemDoneRequest\x1a*.taskboard.v1.SetChecklistItemDoneResponse\x12d\n" +
+	"\x11MoveChecklistItem\x12&.taskboard.v1.MoveChecklistItemRequest\x1a'.taskboard.v1.MoveChecklistItemResponse\x12j\n" +
+	"\x13DeleteChecklistItem\x12(.taskboard.v1.DeleteChecklistItemRequest\x1a).taskboard.v1.DeleteChecklistItemResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
+
+var (
+	file_base_v1_checklists_proto_rawDescOnce sync.Once
+	file_base_v1_checklists_proto_rawDescData []byte
+)
+
+func file_base_v1_checklists_proto_rawDescGZIP() []byte {
+	file_base_v1_checklists_proto_rawDescOnce.Do(func() {
+		file_base_v1_checklists_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_base_v1_checklists_proto_rawDesc), len(file_base_v1_checklists_proto_rawDesc)))
+	})
+	return file_base_v1_checklists_proto_rawDescData
+}
+
+var file_base_v1_checklists_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_base_v1_checklists_proto_goTypes = []any{
+	(*ChecklistItem)(nil),                // 0: taskboard.v1.ChecklistItem
+	(*ChecklistProgress)(nil),            // 1: taskboard.v1.ChecklistProgress
+	(*CreateChecklistItemRequest)(nil),   // 2: taskboard.v1.CreateChecklistItemRequest
+	(*CreateChecklistItemResponse)(nil),  // 3: taskboard.v1.CreateChecklistItemResponse
+	(*ListChecklistItemsRequest)(nil),    // 4: taskboard.v1.ListChecklistItemsRequest
+	(*ListChecklistItemsResponse)(nil),   // 5: taskboard.v1.ListChecklistItemsResponse
+	(*UpdateChecklistItemRequest)(nil),   // 6: taskboard.v1.UpdateChecklistItemRequest
+	(*UpdateChecklistItemResponse)(nil),  // 7: taskboard.v1.UpdateChecklistItemResponse
+	(*SetChecklistItemDoneRequest)(nil),  // 8: taskboard.v1.SetChecklistItemDoneRequest
+	(*SetChecklistItemDoneResponse)(nil), // 9: taskboard.v1.SetChecklistItemDoneResponse
+	(*MoveChecklistItemRequest)(nil),     // 10: taskboard.v1.MoveChecklistItemRequest
+	(*MoveChecklistItemResponse)(nil),    // 11: taskboard.v1.MoveChecklistItemResponse
+	(*DeleteChecklistItemRequest)(nil),   // 12: taskboard.v1.DeleteChecklistItemRequest
+	(*DeleteChecklistItemResponse)(nil),  // 13: taskboard.v1.DeleteChecklistItemResponse
+	(*BaseRequest)(nil),                  // 14: taskboard.v1.BaseRequest
+	(*BaseResponse)(nil),                 // 15: taskboard.v1.BaseResponse
+}
+var file_base_v1_checklists_proto_depIdxs = []int32{
+	14, // 0: taskboard.v1.CreateChecklistItemRequest.base:type_name -> taskboard.v1.BaseRequest
+	15, // 1: taskboard.v1.CreateChecklistItemResponse.base:type_name -> taskboard.v1.BaseResponse
+	0,  // 2: taskboard.v1.CreateChecklistItemResponse.item:type_name -> taskboard.v1.ChecklistItem
+	14, // 3: taskboard.v1.ListChecklistItemsRequest.base:type_name -> taskboard.v1.BaseRequest
+	15, // 4: taskboard.v1.ListChecklistItemsResponse.base:type_name -> taskboard.v1.BaseResponse
+	0,  // 5: taskboard.v1.ListChecklistItemsResponse.items:type_name -> taskboard.v1.ChecklistItem
+	1,  // 6: taskboard.v1.ListChecklistItemsResponse.progress:type_name -> taskboard.v1.ChecklistProgress
+	14, // 7: taskboard.v1.UpdateChecklistItemRequest.base:type_name -> taskboard.v1.BaseRequest
+	15, // 8: taskboard.v1.UpdateChecklistItemResponse.base:type_name -> taskboard.v1.BaseResponse
+	0,  // 9: taskboard.v1.UpdateChecklistItemResponse.item:type_name -> taskboard.v1.ChecklistItem
+	14, // 10: taskboard.v1.SetChecklistItemDoneRequest.base:type_name -> taskboard.v1.BaseRequest
+	15, // 11: taskboard.v1.SetChecklistItemDoneResponse.base:type_name -> taskboard.v1.BaseResponse
+	0,  // 12: taskboard.v1.SetChecklistItemDoneResponse.item:type_name -> taskboard.v1.ChecklistItem
+	14, // 13: taskboard.v1.MoveChecklistItemRequest.base:type_name -> taskboard.v1.BaseRequest
+	15, // 14: taskboard.v1.MoveChecklistItemResponse.base:type_name -> taskboard.v1.BaseResponse
+	0,  // 15: taskboard.v1.MoveChecklistItemResponse.item:type_name -> taskboard.v1.ChecklistItem
+	14, // 16: taskboard.v1.DeleteChecklistItemRequest.base:type_name -> taskboard.v1.BaseRequest
+	15, // 17: taskboard.v1.DeleteChecklistItemResponse.base:type_name -> taskboard.v1.BaseResponse
+	2,  // 18: taskboard.v1.ChecklistsService.CreateChecklistItem:input_type -> taskboard.v1.CreateChecklistItemRequest
+	4,  // 19: taskboard.v1.ChecklistsService.ListChecklistItems:input_type -> taskboard.v1.ListChecklistItemsRequest
+	6,  // 20: taskboard.v1.ChecklistsService.UpdateChecklistItem:input_type -> taskboard.v1.UpdateChecklistItemRequest
+	8,  // 21: taskboard.v1.ChecklistsService.SetChecklistItemDone:input_type -> taskboard.v1.SetChecklistItemDoneRequest
+	10, // 22: taskboard.v1.ChecklistsService.MoveChecklistItem:input_type -> taskboard.v1.MoveChecklistItemRequest
+	12, // 23: taskboard.v1.ChecklistsService.DeleteChecklistItem:input_type -> taskboard.v1.DeleteChecklistItemRequest
+	3,  // 24: taskboard.v1.ChecklistsService.CreateChecklistItem:output_type -> taskboard.v1.CreateChecklistItemResponse
+	5,  // 25: taskboard.v1.ChecklistsService.ListChecklistItems:output_type -> taskboard.v1.ListChecklistItemsResponse
+	7,  // 26: taskboard.v1.ChecklistsService.UpdateChecklistItem:output_type -> taskboard.v1.UpdateChecklistItemResponse
+	9,  // 27: taskboard.v1.ChecklistsService.SetChecklistItemDone:output_type -> taskboard.v1.SetChecklistItemDoneResponse
+	11, // 28: taskboard.v1.ChecklistsService.MoveChecklistItem:output_type -> taskboard.v1.MoveChecklistItemResponse
+	13, // 29: taskboard.v1.ChecklistsService.DeleteChecklistItem:output_type -> taskboard.v1.DeleteChecklistItemResponse
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_base_v1_checklists_proto_init() }
+func file_base_v1_checklists_proto_init() {
+	if File_base_v1_checklists_proto != nil {
+		return
+	}
+	file_base_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_base_v1_checklists_proto_rawDesc), len(file_base_v1_checklists_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_base_v1_checklists_proto_goTypes,
+		DependencyIndexes: file_base_v1_checklists_proto_depIdxs,
+		MessageInfos:      file_base_v1_checklists_proto_msgTypes,
+	}.Build()
+	File_base_v1_checklists_proto = out.File
+	file_base_v1_checklists_proto_goTypes = nil
+	file_base_v1_checklists_proto_depIdxs = nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: base/v1/checklists.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ChecklistsService_CreateChecklistItem_FullMethodName  = "/taskboard.v1.ChecklistsService/CreateChecklistItem"
+	ChecklistsService_ListChecklistItems_FullMethodName   = "/taskboard.v1.ChecklistsService/ListChecklistItems"
+	ChecklistsService_UpdateChecklistItem_FullMethodName  = "/taskboard.v1.ChecklistsService/UpdateChecklistItem"
+	ChecklistsService_SetChecklistItemDone_FullMethodName = "/taskboard.v1.ChecklistsService/SetChecklistItemDone"
+	ChecklistsService_MoveChecklistItem_FullMethodName    = "/taskboard.v1.ChecklistsService/MoveChecklistItem"
+	ChecklistsService_DeleteChecklistItem_FullMethodName  = "/taskboard.v1.ChecklistsService/DeleteChecklistItem"
+)
+
+// ChecklistsServiceClient is the client API for ChecklistsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChecklistsServiceClient interface {
+	CreateChecklistItem(ctx context.Context, in *CreateChecklistItemRequest, opts ...grpc.CallOption) (*CreateChecklistItemResponse, error)
+	ListChecklistItems(ctx context.Context, in *ListChecklistItemsRequest, opts ...grpc.CallOption) (*ListChecklistItemsResponse, error)
+	UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest, opts ...grpc.CallOption) (*UpdateChecklistItemResponse, error)
+	SetChecklistItemDone(ctx context.Context, in *SetChecklistItemDoneRequest, opts ...grpc.CallOption) (*SetChecklistItemDoneResponse, error)
+	MoveChecklistItem(ctx context.Context, in *MoveChecklistItemRequest, opts ...grpc.CallOption) (*MoveChecklistItemResponse, error)
+	DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*DeleteChecklistItemResponse, error)
+}
+
+type checklistsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChecklistsServiceClient(cc grpc.ClientConnInterface) ChecklistsServiceClient {
+	return &checklistsServiceClient{cc}
+}
+
+func (c *checklistsServiceClient) CreateChecklistItem(ctx context.Context, in *CreateChecklistItemRequest, opts ...grpc.CallOption) (*CreateChecklistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChecklistItemResponse)
+	err := c.cc.Invoke(ctx, ChecklistsService_CreateChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistsServiceClient) ListChecklistItems(ctx context.Context, in *ListChecklistItemsRequest, opts ...grpc.CallOption) (*ListChecklistItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChecklistItemsResponse)
+	err := c.cc.Invoke(ctx, ChecklistsService_ListChecklistItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistsServiceClient) UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest, opts ...grpc.CallOption) (*UpdateChecklistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateChecklistItemResponse)
+	err := c.cc.Invoke(ctx, ChecklistsService_UpdateChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistsServiceClient) SetChecklistItemDone(ctx context.Context, in *SetChecklistItemDoneRequest, opts ...grpc.CallOption) (*SetChecklistItemDoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChecklistItemDoneResponse)
+	err := c.cc.Invoke(ctx, ChecklistsService_SetChecklistItemDone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistsServiceClient) MoveChecklistItem(ctx context.Context, in *MoveChecklistItemRequest, opts ...grpc.CallOption) (*MoveChecklistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveChecklistItemResponse)
+	err := c.cc.Invoke(ctx, ChecklistsService_MoveChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistsServiceClient) DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*DeleteChecklistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChecklistItemResponse)
+	err := c.cc.Invoke(ctx, ChecklistsService_DeleteChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChecklistsServiceServer is the server API for ChecklistsService service.
+// All implementations must embed UnimplementedChecklistsServiceServer
+// for forward compatibility.
+type ChecklistsServiceServer interface {
+	CreateChecklistItem(context.Context, *CreateChecklistItemRequest) (*CreateChecklistItemResponse, error)
+	ListChecklistItems(context.Context, *ListChecklistItemsRequest) (*ListChecklistItemsResponse, error)
+	UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error)
+	SetChecklistItemDone(context.Context, *SetChecklistItemDoneRequest) (*SetChecklistItemDoneResponse, error)
+	MoveChecklistItem(context.Context, *MoveChecklistItemRequest) (*MoveChecklistItemResponse, error)
+	DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error)
+	mustEmbedUnimplementedChecklistsServiceServer()
+}
+
+// UnimplementedChecklistsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChecklistsServiceServer struct{}
+
+func (UnimplementedChecklistsServiceServer) CreateChecklistItem(context.Context, *CreateChecklistItemRequest) (*CreateChecklistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateChecklistItem not implemented")
+}
+func (UnimplementedChecklistsServiceServer) ListChecklistItems(context.Context, *ListChecklistItemsRequest) (*ListChecklistItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChecklistItems not implemented")
+}
+func (UnimplementedChecklistsServiceServer) UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*UpdateChecklistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateChecklistItem not implemented")
+}
+func (UnimplementedChecklistsServiceServer) SetChecklistItemDone(context.Context, *SetChecklistItemDoneRequest) (*SetChecklistItemDoneResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChecklistItemDone not implemented")
+}
+func (UnimplementedChecklistsServiceServer) MoveChecklistItem(context.Context, *MoveChecklistItemRequest) (*MoveChecklistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveChecklistItem not implemented")
+}
+func (UnimplementedChecklistsServiceServer) DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteChecklistItem not implemented")
+}
+func (UnimplementedChecklistsServiceServer) mustEmbedUnimplementedChecklistsServiceServer() {}
+func (UnimplementedChecklistsServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeChecklistsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChecklistsServiceServer will
+// result in compilation errors.
+type UnsafeChecklistsServiceServer interface {
+	mustEmbedUnimplementedChecklistsServiceServer()
+}
+
+func RegisterChecklistsServiceServer(s grpc.ServiceRegistrar, srv ChecklistsServiceServer) {
+	// If the following call panics, it indicates UnimplementedChecklistsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChecklistsService_ServiceDesc, srv)
+}
+
+func _ChecklistsService_CreateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistsServiceServer).CreateChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistsService_CreateChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistsServiceServer).CreateChecklistItem(ctx, req.(*CreateChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistsService_ListChecklistItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecklistItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistsServiceServer).ListChecklistItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistsService_ListChecklistItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistsServiceServer).ListChecklistItems(ctx, req.(*ListChecklistItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistsService_UpdateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistsServiceServer).UpdateChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistsService_UpdateChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistsServiceServer).UpdateChecklistItem(ctx, req.(*UpdateChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistsService_SetChecklistItemDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChecklistItemDoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistsServiceServer).SetChecklistItemDone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistsService_SetChecklistItemDone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistsServiceServer).SetChecklistItemDone(ctx, req.(*SetChecklistItemDoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistsService_MoveChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistsServiceServer).MoveChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistsService_MoveChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistsServiceServer).MoveChecklistItem(ctx, req.(*MoveChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistsService_DeleteChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistsServiceServer).DeleteChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistsService_DeleteChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistsServiceServer).DeleteChecklistItem(ctx, req.(*DeleteChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChecklistsService_ServiceDesc is the grpc.ServiceDesc for ChecklistsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChecklistsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taskboard.v1.ChecklistsService",
+	HandlerType: (*ChecklistsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateChecklistItem",
+			Handler:    _ChecklistsService_CreateChecklistItem_Handler,
+		},
+		{
+			MethodName: "ListChecklistItems",
+			Handler:    _ChecklistsService_ListChecklistItems_Handler,
+		},
+		{
+			MethodName: "UpdateChecklistItem",
+			Handler:    _ChecklistsService_UpdateChecklistItem_Handler,
+		},
+		{
+			MethodName: "SetChecklistItemDone",
+			Handler:    _ChecklistsService_SetChecklistItemDone_Handler,
+		},
+		{
+			MethodName: "MoveChecklistItem",
+			Handler:    _ChecklistsService_MoveChecklistItem_Handler,
+		},
+		{
+			MethodName: "DeleteChecklistItem",
+			Handler:    _ChecklistsService_DeleteChecklistItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/checklists.proto",
+}
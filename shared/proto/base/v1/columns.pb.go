@@ -26,6 +26,8 @@ type Column struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
 	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Version       int64                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"` // растёт с каждой записью; для ETag
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,11 +83,26 @@ func (x *Column) GetPosition() int32 {
 	return 0
 }
 
+func (x *Column) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Column) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateColumnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
 	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"` // необязательное
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,6 +158,13 @@ func (x *CreateColumnRequest) GetPosition() int32 {
 	return 0
 }
 
+func (x *CreateColumnRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
 type CreateColumnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -193,17 +217,131 @@ func (x *CreateColumnResponse) GetColumn() *Column {
 	return nil
 }
 
+type PriorityCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Priority      TaskPriority           `protobuf:"varint,1,opt,name=priority,proto3,enum=taskboard.v1.TaskPriority" json:"priority,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriorityCount) Reset() {
+	*x = PriorityCount{}
+	mi := &file_base_v1_columns_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityCount) ProtoMessage() {}
+
+func (x *PriorityCount) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_columns_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityCount.ProtoReflect.Descriptor instead.
+func (*PriorityCount) Descriptor() ([]byte, []int) {
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PriorityCount) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *PriorityCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ColumnStats — сводка по задачам колонки для планирования спринта.
+type ColumnStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskCount     int32                  `protobuf:"varint,1,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	TotalEstimate float64                `protobuf:"fixed64,2,opt,name=total_estimate,json=totalEstimate,proto3" json:"total_estimate,omitempty"`
+	ByPriority    []*PriorityCount       `protobuf:"bytes,3,rep,name=by_priority,json=byPriority,proto3" json:"by_priority,omitempty"` // только приоритеты, у которых есть задачи
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnStats) Reset() {
+	*x = ColumnStats{}
+	mi := &file_base_v1_columns_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnStats) ProtoMessage() {}
+
+func (x *ColumnStats) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_columns_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnStats.ProtoReflect.Descriptor instead.
+func (*ColumnStats) Descriptor() ([]byte, []int) {
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ColumnStats) GetTaskCount() int32 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
+func (x *ColumnStats) GetTotalEstimate() float64 {
+	if x != nil {
+		return x.TotalEstimate
+	}
+	return 0
+}
+
+func (x *ColumnStats) GetByPriority() []*PriorityCount {
+	if x != nil {
+		return x.ByPriority
+	}
+	return nil
+}
+
 type ColumnFull struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Column        *Column                `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
 	Tasks         []*Task                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Stats         *ColumnStats           `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ColumnFull) Reset() {
 	*x = ColumnFull{}
-	mi := &file_base_v1_columns_proto_msgTypes[3]
+	mi := &file_base_v1_columns_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +353,7 @@ func (x *ColumnFull) String() string {
 func (*ColumnFull) ProtoMessage() {}
 
 func (x *ColumnFull) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_columns_proto_msgTypes[3]
+	mi := &file_base_v1_columns_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +366,7 @@ func (x *ColumnFull) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ColumnFull.ProtoReflect.Descriptor instead.
 func (*ColumnFull) Descriptor() ([]byte, []int) {
-	return file_base_v1_columns_proto_rawDescGZIP(), []int{3}
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ColumnFull) GetColumn() *Column {
@@ -245,6 +383,13 @@ func (x *ColumnFull) GetTasks() []*Task {
 	return nil
 }
 
+func (x *ColumnFull) GetStats() *ColumnStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
 type GetColumnFullRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -255,7 +400,7 @@ type GetColumnFullRequest struct {
 
 func (x *GetColumnFullRequest) Reset() {
 	*x = GetColumnFullRequest{}
-	mi := &file_base_v1_columns_proto_msgTypes[4]
+	mi := &file_base_v1_columns_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +412,7 @@ func (x *GetColumnFullRequest) String() string {
 func (*GetColumnFullRequest) ProtoMessage() {}
 
 func (x *GetColumnFullRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_columns_proto_msgTypes[4]
+	mi := &file_base_v1_columns_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +425,7 @@ func (x *GetColumnFullRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnFullRequest.ProtoReflect.Descriptor instead.
 func (*GetColumnFullRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_columns_proto_rawDescGZIP(), []int{4}
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetColumnFullRequest) GetBase() *BaseRequest {
@@ -307,7 +452,7 @@ type GetColumnFullResponse struct {
 
 func (x *GetColumnFullResponse) Reset() {
 	*x = GetColumnFullResponse{}
-	mi := &file_base_v1_columns_proto_msgTypes[5]
+	mi := &file_base_v1_columns_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -319,7 +464,7 @@ func (x *GetColumnFullResponse) String() string {
 func (*GetColumnFullResponse) ProtoMessage() {}
 
 func (x *GetColumnFullResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_columns_proto_msgTypes[5]
+	mi := &file_base_v1_columns_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +477,7 @@ func (x *GetColumnFullResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetColumnFullResponse.ProtoReflect.Descriptor instead.
 func (*GetColumnFullResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_columns_proto_rawDescGZIP(), []int{5}
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetColumnFullResponse) GetBase() *BaseResponse {
@@ -350,17 +495,19 @@ func (x *GetColumnFullResponse) GetData() *ColumnFull {
 }
 
 type MoveColumnRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ColumnId      string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
-	ToPosition    int32                  `protobuf:"varint,3,opt,name=to_position,json=toPosition,proto3" json:"to_position,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ColumnId        string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	ToPosition      int32                  `protobuf:"varint,3,opt,name=to_position,json=toPosition,proto3" json:"to_position,omitempty"`
+	ToBoardId       string                 `protobuf:"bytes,4,opt,name=to_board_id,json=toBoardId,proto3" json:"to_board_id,omitempty"`                  // необязательная; колонка переезжает вместе с задачами
+	ExpectedVersion int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // из If-Match; 0 — без проверки
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *MoveColumnRequest) Reset() {
 	*x = MoveColumnRequest{}
-	mi := &file_base_v1_columns_proto_msgTypes[6]
+	mi := &file_base_v1_columns_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -372,7 +519,7 @@ func (x *MoveColumnRequest) String() string {
 func (*MoveColumnRequest) ProtoMessage() {}
 
 func (x *MoveColumnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_columns_proto_msgTypes[6]
+	mi := &file_base_v1_columns_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +532,7 @@ func (x *MoveColumnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveColumnRequest.ProtoReflect.Descriptor instead.
 func (*MoveColumnRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_columns_proto_rawDescGZIP(), []int{6}
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MoveColumnRequest) GetBase() *BaseRequest {
@@ -409,6 +556,20 @@ func (x *MoveColumnRequest) GetToPosition() int32 {
 	return 0
 }
 
+func (x *MoveColumnRequest) GetToBoardId() string {
+	if x != nil {
+		return x.ToBoardId
+	}
+	return ""
+}
+
+func (x *MoveColumnRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type MoveColumnResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -419,7 +580,7 @@ type MoveColumnResponse struct {
 
 func (x *MoveColumnResponse) Reset() {
 	*x = MoveColumnResponse{}
-	mi := &file_base_v1_columns_proto_msgTypes[7]
+	mi := &file_base_v1_columns_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -431,7 +592,7 @@ func (x *MoveColumnResponse) String() string {
 func (*MoveColumnResponse) ProtoMessage() {}
 
 func (x *MoveColumnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_columns_proto_msgTypes[7]
+	mi := &file_base_v1_columns_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +605,7 @@ func (x *MoveColumnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MoveColumnResponse.ProtoReflect.Descriptor instead.
 func (*MoveColumnResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_columns_proto_rawDescGZIP(), []int{7}
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MoveColumnResponse) GetBase() *BaseResponse {
@@ -461,6 +622,120 @@ func (x *MoveColumnResponse) GetColumn() *Column {
 	return nil
 }
 
+// ReorderColumnsRequest переставляет все живые колонки доски разом: column_ids должен
+// содержать каждую из них ровно один раз.
+type ReorderColumnsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	ColumnIds     []string               `protobuf:"bytes,3,rep,name=column_ids,json=columnIds,proto3" json:"column_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderColumnsRequest) Reset() {
+	*x = ReorderColumnsRequest{}
+	mi := &file_base_v1_columns_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderColumnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderColumnsRequest) ProtoMessage() {}
+
+func (x *ReorderColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_columns_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderColumnsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReorderColumnsRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReorderColumnsRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *ReorderColumnsRequest) GetColumnIds() []string {
+	if x != nil {
+		return x.ColumnIds
+	}
+	return nil
+}
+
+type ReorderColumnsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Columns       []*Column              `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderColumnsResponse) Reset() {
+	*x = ReorderColumnsResponse{}
+	mi := &file_base_v1_columns_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderColumnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderColumnsResponse) ProtoMessage() {}
+
+func (x *ReorderColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_columns_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderColumnsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReorderColumnsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ReorderColumnsResponse) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
 type DeleteColumnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -471,7 +746,7 @@ type DeleteColumnRequest struct {
 
 func (x *DeleteColumnRequest) Reset() {
 	*x = DeleteColumnRequest{}
-	mi := &file_base_v1_columns_proto_msgTypes[8]
+	mi := &file_base_v1_columns_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -483,7 +758,7 @@ func (x *DeleteColumnRequest) String() string {
 func (*DeleteColumnRequest) ProtoMessage() {}
 
 func (x *DeleteColumnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_columns_proto_msgTypes[8]
+	mi := &file_base_v1_columns_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +771,7 @@ func (x *DeleteColumnRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteColumnRequest.ProtoReflect.Descriptor instead.
 func (*DeleteColumnRequest) Descriptor() ([]byte, []int) {
-	return file_base_v1_columns_proto_rawDescGZIP(), []int{8}
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteColumnRequest) GetBase() *BaseRequest {
@@ -522,7 +797,7 @@ type DeleteColumnResponse struct {
 
 func (x *DeleteColumnResponse) Reset() {
 	*x = DeleteColumnResponse{}
-	mi := &file_base_v1_columns_proto_msgTypes[9]
+	mi := &file_base_v1_columns_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +809,7 @@ func (x *DeleteColumnResponse) String() string {
 func (*DeleteColumnResponse) ProtoMessage() {}
 
 func (x *DeleteColumnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_base_v1_columns_proto_msgTypes[9]
+	mi := &file_base_v1_columns_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +822,7 @@ func (x *DeleteColumnResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteColumnResponse.ProtoReflect.Descriptor instead.
 func (*DeleteColumnResponse) Descriptor() ([]byte, []int) {
-	return file_base_v1_columns_proto_rawDescGZIP(), []int{9}
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteColumnResponse) GetBase() *BaseResponse {
@@ -557,51 +832,186 @@ func (x *DeleteColumnResponse) GetBase() *BaseResponse {
 	return nil
 }
 
+type RestoreColumnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ColumnId      string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreColumnRequest) Reset() {
+	*x = RestoreColumnRequest{}
+	mi := &file_base_v1_columns_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreColumnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreColumnRequest) ProtoMessage() {}
+
+func (x *RestoreColumnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_columns_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreColumnRequest.ProtoReflect.Descriptor instead.
+func (*RestoreColumnRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RestoreColumnRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreColumnRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+type RestoreColumnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          *ColumnFull            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreColumnResponse) Reset() {
+	*x = RestoreColumnResponse{}
+	mi := &file_base_v1_columns_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreColumnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreColumnResponse) ProtoMessage() {}
+
+func (x *RestoreColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_columns_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreColumnResponse.ProtoReflect.Descriptor instead.
+func (*RestoreColumnResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_columns_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreColumnResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreColumnResponse) GetData() *ColumnFull {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_base_v1_columns_proto protoreflect.FileDescriptor
 
 const file_base_v1_columns_proto_rawDesc = "" +
 	"\n" +
-	"\x15base/v1/columns.proto\x12\ftaskboard.v1\x1a\x14base/v1/common.proto\x1a\x13base/v1/tasks.proto\"O\n" +
+	"\x15base/v1/columns.proto\x12\ftaskboard.v1\x1a\x14base/v1/common.proto\x1a\x13base/v1/tasks.proto\"\x7f\n" +
 	"\x06Column\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x1a\n" +
-	"\bposition\x18\x03 \x01(\x05R\bposition\"{\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x03R\aversion\"\x91\x01\n" +
 	"\x13CreateColumnRequest\x12-\n" +
 	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
 	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x1a\n" +
-	"\bposition\x18\x03 \x01(\x05R\bposition\"t\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\"t\n" +
 	"\x14CreateColumnResponse\x12.\n" +
 	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12,\n" +
-	"\x06column\x18\x02 \x01(\v2\x14.taskboard.v1.ColumnR\x06column\"d\n" +
+	"\x06column\x18\x02 \x01(\v2\x14.taskboard.v1.ColumnR\x06column\"]\n" +
+	"\rPriorityCount\x126\n" +
+	"\bpriority\x18\x01 \x01(\x0e2\x1a.taskboard.v1.TaskPriorityR\bpriority\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x91\x01\n" +
+	"\vColumnStats\x12\x1d\n" +
+	"\n" +
+	"task_count\x18\x01 \x01(\x05R\ttaskCount\x12%\n" +
+	"\x0etotal_estimate\x18\x02 \x01(\x01R\rtotalEstimate\x12<\n" +
+	"\vby_priority\x18\x03 \x03(\v2\x1b.taskboard.v1.PriorityCountR\n" +
+	"byPriority\"\x95\x01\n" +
 	"\n" +
 	"ColumnFull\x12,\n" +
 	"\x06column\x18\x01 \x01(\v2\x14.taskboard.v1.ColumnR\x06column\x12(\n" +
-	"\x05tasks\x18\x02 \x03(\v2\x12.taskboard.v1.TaskR\x05tasks\"b\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x12.taskboard.v1.TaskR\x05tasks\x12/\n" +
+	"\x05stats\x18\x03 \x01(\v2\x19.taskboard.v1.ColumnStatsR\x05stats\"b\n" +
 	"\x14GetColumnFullRequest\x12-\n" +
 	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x1b\n" +
 	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\"u\n" +
 	"\x15GetColumnFullResponse\x12.\n" +
 	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12,\n" +
-	"\x04data\x18\x02 \x01(\v2\x18.taskboard.v1.ColumnFullR\x04data\"\x80\x01\n" +
+	"\x04data\x18\x02 \x01(\v2\x18.taskboard.v1.ColumnFullR\x04data\"\xcb\x01\n" +
 	"\x11MoveColumnRequest\x12-\n" +
 	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x1b\n" +
 	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12\x1f\n" +
 	"\vto_position\x18\x03 \x01(\x05R\n" +
-	"toPosition\"r\n" +
+	"toPosition\x12\x1e\n" +
+	"\vto_board_id\x18\x04 \x01(\tR\ttoBoardId\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\"r\n" +
 	"\x12MoveColumnResponse\x12.\n" +
 	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12,\n" +
-	"\x06column\x18\x02 \x01(\v2\x14.taskboard.v1.ColumnR\x06column\"a\n" +
+	"\x06column\x18\x02 \x01(\v2\x14.taskboard.v1.ColumnR\x06column\"\x80\x01\n" +
+	"\x15ReorderColumnsRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x1d\n" +
+	"\n" +
+	"column_ids\x18\x03 \x03(\tR\tcolumnIds\"x\n" +
+	"\x16ReorderColumnsResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12.\n" +
+	"\acolumns\x18\x02 \x03(\v2\x14.taskboard.v1.ColumnR\acolumns\"a\n" +
 	"\x13DeleteColumnRequest\x12-\n" +
 	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x1b\n" +
 	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\"F\n" +
 	"\x14DeleteColumnResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base2\xe9\x02\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\"b\n" +
+	"\x14RestoreColumnRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\"u\n" +
+	"\x15RestoreColumnResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12,\n" +
+	"\x04data\x18\x02 \x01(\v2\x18.taskboard.v1.ColumnFullR\x04data2\xa0\x04\n" +
 	"\x0eColumnsService\x12U\n" +
 	"\fCreateColumn\x12!.taskboard.v1.CreateColumnRequest\x1a\".taskboard.v1.CreateColumnResponse\x12X\n" +
 	"\rGetColumnFull\x12\".taskboard.v1.GetColumnFullRequest\x1a#.taskboard.v1.GetColumnFullResponse\x12O\n" +
 	"\n" +
-	"MoveColumn\x12\x1f.taskboard.v1.MoveColumnRequest\x1a .taskboard.v1.MoveColumnResponse\x12U\n" +
-	"\fDeleteColumn\x12!.taskboard.v1.DeleteColumnRequest\x1a\".taskboard.v1.DeleteColumnResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
+	"MoveColumn\x12\x1f.taskboard.v1.MoveColumnRequest\x1a .taskboard.v1.MoveColumnResponse\x12[\n" +
+	"\x0eReorderColumns\x12#.taskboard.v1.ReorderColumnsRequest\x1a$.taskboard.v1.ReorderColumnsResponse\x12U\n" +
+	"\fDeleteColumn\x12!.taskboard.v1.DeleteColumnRequest\x1a\".taskboard.v1.DeleteColumnResponse\x12X\n" +
+	"\rRestoreColumn\x12\".taskboard.v1.RestoreColumnRequest\x1a#.taskboard.v1.RestoreColumnResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
 
 var (
 	file_base_v1_columns_proto_rawDescOnce sync.Once
@@ -615,49 +1025,69 @@ func file_base_v1_columns_proto_rawDescGZIP() []byte {
 	return file_base_v1_columns_proto_rawDescData
 }
 
-var file_base_v1_columns_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_base_v1_columns_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_base_v1_columns_proto_goTypes = []any{
-	(*Column)(nil),                // 0: taskboard.v1.Column
-	(*CreateColumnRequest)(nil),   // 1: taskboard.v1.CreateColumnRequest
-	(*CreateColumnResponse)(nil),  // 2: taskboard.v1.CreateColumnResponse
-	(*ColumnFull)(nil),            // 3: taskboard.v1.ColumnFull
-	(*GetColumnFullRequest)(nil),  // 4: taskboard.v1.GetColumnFullRequest
-	(*GetColumnFullResponse)(nil), // 5: taskboard.v1.GetColumnFullResponse
-	(*MoveColumnRequest)(nil),     // 6: taskboard.v1.MoveColumnRequest
-	(*MoveColumnResponse)(nil),    // 7: taskboard.v1.MoveColumnResponse
-	(*DeleteColumnRequest)(nil),   // 8: taskboard.v1.DeleteColumnRequest
-	(*DeleteColumnResponse)(nil),  // 9: taskboard.v1.DeleteColumnResponse
-	(*BaseRequest)(nil),           // 10: taskboard.v1.BaseRequest
-	(*BaseResponse)(nil),          // 11: taskboard.v1.BaseResponse
-	(*Task)(nil),                  // 12: taskboard.v1.Task
+	(*Column)(nil),                 // 0: taskboard.v1.Column
+	(*CreateColumnRequest)(nil),    // 1: taskboard.v1.CreateColumnRequest
+	(*CreateColumnResponse)(nil),   // 2: taskboard.v1.CreateColumnResponse
+	(*PriorityCount)(nil),          // 3: taskboard.v1.PriorityCount
+	(*ColumnStats)(nil),            // 4: taskboard.v1.ColumnStats
+	(*ColumnFull)(nil),             // 5: taskboard.v1.ColumnFull
+	(*GetColumnFullRequest)(nil),   // 6: taskboard.v1.GetColumnFullRequest
+	(*GetColumnFullResponse)(nil),  // 7: taskboard.v1.GetColumnFullResponse
+	(*MoveColumnRequest)(nil),      // 8: taskboard.v1.MoveColumnRequest
+	(*MoveColumnResponse)(nil),     // 9: taskboard.v1.MoveColumnResponse
+	(*ReorderColumnsRequest)(nil),  // 10: taskboard.v1.ReorderColumnsRequest
+	(*ReorderColumnsResponse)(nil), // 11: taskboard.v1.ReorderColumnsResponse
+	(*DeleteColumnRequest)(nil),    // 12: taskboard.v1.DeleteColumnRequest
+	(*DeleteColumnResponse)(nil),   // 13: taskboard.v1.DeleteColumnResponse
+	(*RestoreColumnRequest)(nil),   // 14: taskboard.v1.RestoreColumnRequest
+	(*RestoreColumnResponse)(nil),  // 15: taskboard.v1.RestoreColumnResponse
+	(*BaseRequest)(nil),            // 16: taskboard.v1.BaseRequest
+	(*BaseResponse)(nil),           // 17: taskboard.v1.BaseResponse
+	(TaskPriority)(0),              // 18: taskboard.v1.TaskPriority
+	(*Task)(nil),                   // 19: taskboard.v1.Task
 }
 var file_base_v1_columns_proto_depIdxs = []int32{
-	10, // 0: taskboard.v1.CreateColumnRequest.base:type_name -> taskboard.v1.BaseRequest
-	11, // 1: taskboard.v1.CreateColumnResponse.base:type_name -> taskboard.v1.BaseResponse
+	16, // 0: taskboard.v1.CreateColumnRequest.base:type_name -> taskboard.v1.BaseRequest
+	17, // 1: taskboard.v1.CreateColumnResponse.base:type_name -> taskboard.v1.BaseResponse
 	0,  // 2: taskboard.v1.CreateColumnResponse.column:type_name -> taskboard.v1.Column
-	0,  // 3: taskboard.v1.ColumnFull.column:type_name -> taskboard.v1.Column
-	12, // 4: taskboard.v1.ColumnFull.tasks:type_name -> taskboard.v1.Task
-	10, // 5: taskboard.v1.GetColumnFullRequest.base:type_name -> taskboard.v1.BaseRequest
-	11, // 6: taskboard.v1.GetColumnFullResponse.base:type_name -> taskboard.v1.BaseResponse
-	3,  // 7: taskboard.v1.GetColumnFullResponse.data:type_name -> taskboard.v1.ColumnFull
-	10, // 8: taskboard.v1.MoveColumnRequest.base:type_name -> taskboard.v1.BaseRequest
-	11, // 9: taskboard.v1.MoveColumnResponse.base:type_name -> taskboard.v1.BaseResponse
-	0,  // 10: taskboard.v1.MoveColumnResponse.column:type_name -> taskboard.v1.Column
-	10, // 11: taskboard.v1.DeleteColumnRequest.base:type_name -> taskboard.v1.BaseRequest
-	11, // 12: taskboard.v1.DeleteColumnResponse.base:type_name -> taskboard.v1.BaseResponse
-	1,  // 13: taskboard.v1.ColumnsService.CreateColumn:input_type -> taskboard.v1.CreateColumnRequest
-	4,  // 14: taskboard.v1.ColumnsService.GetColumnFull:input_type -> taskboard.v1.GetColumnFullRequest
-	6,  // 15: taskboard.v1.ColumnsService.MoveColumn:input_type -> taskboard.v1.MoveColumnRequest
-	8,  // 16: taskboard.v1.ColumnsService.DeleteColumn:input_type -> taskboard.v1.DeleteColumnRequest
-	2,  // 17: taskboard.v1.ColumnsService.CreateColumn:output_type -> taskboard.v1.CreateColumnResponse
-	5,  // 18: taskboard.v1.ColumnsService.GetColumnFull:output_type -> taskboard.v1.GetColumnFullResponse
-	7,  // 19: taskboard.v1.ColumnsService.MoveColumn:output_type -> taskboard.v1.MoveColumnResponse
-	9,  // 20: taskboard.v1.ColumnsService.DeleteColumn:output_type -> taskboard.v1.DeleteColumnResponse
-	17, // [17:21] is the sub-list for method output_type
-	13, // [13:17] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	18, // 3: taskboard.v1.PriorityCount.priority:type_name -> taskboard.v1.TaskPriority
+	3,  // 4: taskboard.v1.ColumnStats.by_priority:type_name -> taskboard.v1.PriorityCount
+	0,  // 5: taskboard.v1.ColumnFull.column:type_name -> taskboard.v1.Column
+	19, // 6: taskboard.v1.ColumnFull.tasks:type_name -> taskboard.v1.Task
+	4,  // 7: taskboard.v1.ColumnFull.stats:type_name -> taskboard.v1.ColumnStats
+	16, // 8: taskboard.v1.GetColumnFullRequest.base:type_name -> taskboard.v1.BaseRequest
+	17, // 9: taskboard.v1.GetColumnFullResponse.base:type_name -> taskboard.v1.BaseResponse
+	5,  // 10: taskboard.v1.GetColumnFullResponse.data:type_name -> taskboard.v1.ColumnFull
+	16, // 11: taskboard.v1.MoveColumnRequest.base:type_name -> taskboard.v1.BaseRequest
+	17, // 12: taskboard.v1.MoveColumnResponse.base:type_name -> taskboard.v1.BaseResponse
+	0,  // 13: taskboard.v1.MoveColumnResponse.column:type_name -> taskboard.v1.Column
+	16, // 14: taskboard.v1.ReorderColumnsRequest.base:type_name -> taskboard.v1.BaseRequest
+	17, // 15: taskboard.v1.ReorderColumnsResponse.base:type_name -> taskboard.v1.BaseResponse
+	0,  // 16: taskboard.v1.ReorderColumnsResponse.columns:type_name -> taskboard.v1.Column
+	16, // 17: taskboard.v1.DeleteColumnRequest.base:type_name -> taskboard.v1.BaseRequest
+	17, // 18: taskboard.v1.DeleteColumnResponse.base:type_name -> taskboard.v1.BaseResponse
+	16, // 19: taskboard.v1.RestoreColumnRequest.base:type_name -> taskboard.v1.BaseRequest
+	17, // 20: taskboard.v1.RestoreColumnResponse.base:type_name -> taskboard.v1.BaseResponse
+	5,  // 21: taskboard.v1.RestoreColumnResponse.data:type_name -> taskboard.v1.ColumnFull
+	1,  // 22: taskboard.v1.ColumnsService.CreateColumn:input_type -> taskboard.v1.CreateColumnRequest
+	6,  // 23: taskboard.v1.ColumnsService.GetColumnFull:input_type -> taskboard.v1.GetColumnFullRequest
+	8,  // 24: taskboard.v1.ColumnsService.MoveColumn:input_type -> taskboard.v1.MoveColumnRequest
+	10, // 25: taskboard.v1.ColumnsService.ReorderColumns:input_type -> taskboard.v1.ReorderColumnsRequest
+	12, // 26: taskboard.v1.ColumnsService.DeleteColumn:input_type -> taskboard.v1.DeleteColumnRequest
+	14, // 27: taskboard.v1.ColumnsService.RestoreColumn:input_type -> taskboard.v1.RestoreColumnRequest
+	2,  // 28: taskboard.v1.ColumnsService.CreateColumn:output_type -> taskboard.v1.CreateColumnResponse
+	7,  // 29: taskboard.v1.ColumnsService.GetColumnFull:output_type -> taskboard.v1.GetColumnFullResponse
+	9,  // 30: taskboard.v1.ColumnsService.MoveColumn:output_type -> taskboard.v1.MoveColumnResponse
+	11, // 31: taskboard.v1.ColumnsService.ReorderColumns:output_type -> taskboard.v1.ReorderColumnsResponse
+	13, // 32: taskboard.v1.ColumnsService.DeleteColumn:output_type -> taskboard.v1.DeleteColumnResponse
+	15, // 33: taskboard.v1.ColumnsService.RestoreColumn:output_type -> taskboard.v1.RestoreColumnResponse
+	28, // [28:34] is the sub-list for method output_type
+	22, // [22:28] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_base_v1_columns_proto_init() }
@@ -673,7 +1103,7 @@ func file_base_v1_columns_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_base_v1_columns_proto_rawDesc), len(file_base_v1_columns_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ColumnsService_CreateColumn_FullMethodName  = "/taskboard.v1.ColumnsService/CreateColumn"
-	ColumnsService_GetColumnFull_FullMethodName = "/taskboard.v1.ColumnsService/GetColumnFull"
-	ColumnsService_MoveColumn_FullMethodName    = "/taskboard.v1.ColumnsService/MoveColumn"
-	ColumnsService_DeleteColumn_FullMethodName  = "/taskboard.v1.ColumnsService/DeleteColumn"
+	ColumnsService_CreateColumn_FullMethodName   = "/taskboard.v1.ColumnsService/CreateColumn"
+	ColumnsService_GetColumnFull_FullMethodName  = "/taskboard.v1.ColumnsService/GetColumnFull"
+	ColumnsService_MoveColumn_FullMethodName     = "/taskboard.v1.ColumnsService/MoveColumn"
+	ColumnsService_ReorderColumns_FullMethodName = "/taskboard.v1.ColumnsService/ReorderColumns"
+	ColumnsService_DeleteColumn_FullMethodName   = "/taskboard.v1.ColumnsService/DeleteColumn"
+	ColumnsService_RestoreColumn_FullMethodName  = "/taskboard.v1.ColumnsService/RestoreColumn"
 )
 
 // ColumnsServiceClient is the client API for ColumnsService service.
@@ -32,7 +34,9 @@ type ColumnsServiceClient interface {
 	CreateColumn(ctx context.Context, in *CreateColumnRequest, opts ...grpc.CallOption) (*CreateColumnResponse, error)
 	GetColumnFull(ctx context.Context, in *GetColumnFullRequest, opts ...grpc.CallOption) (*GetColumnFullResponse, error)
 	MoveColumn(ctx context.Context, in *MoveColumnRequest, opts ...grpc.CallOption) (*MoveColumnResponse, error)
+	ReorderColumns(ctx context.Context, in *ReorderColumnsRequest, opts ...grpc.CallOption) (*ReorderColumnsResponse, error)
 	DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error)
+	RestoreColumn(ctx context.Context, in *RestoreColumnRequest, opts ...grpc.CallOption) (*RestoreColumnResponse, error)
 }
 
 type columnsServiceClient struct {
@@ -73,6 +77,16 @@ func (c *columnsServiceClient) MoveColumn(ctx context.Context, in *MoveColumnReq
 	return out, nil
 }
 
+func (c *columnsServiceClient) ReorderColumns(ctx context.Context, in *ReorderColumnsRequest, opts ...grpc.CallOption) (*ReorderColumnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderColumnsResponse)
+	err := c.cc.Invoke(ctx, ColumnsService_ReorderColumns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *columnsServiceClient) DeleteColumn(ctx context.Context, in *DeleteColumnRequest, opts ...grpc.CallOption) (*DeleteColumnResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteColumnResponse)
@@ -83,6 +97,16 @@ func (c *columnsServiceClient) DeleteColumn(ctx context.Context, in *DeleteColum
 	return out, nil
 }
 
+func (c *columnsServiceClient) RestoreColumn(ctx context.Context, in *RestoreColumnRequest, opts ...grpc.CallOption) (*RestoreColumnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreColumnResponse)
+	err := c.cc.Invoke(ctx, ColumnsService_RestoreColumn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ColumnsServiceServer is the server API for ColumnsService service.
 // All implementations must embed UnimplementedColumnsServiceServer
 // for forward compatibility.
@@ -90,7 +114,9 @@ type ColumnsServiceServer interface {
 	CreateColumn(context.Context, *CreateColumnRequest) (*CreateColumnResponse, error)
 	GetColumnFull(context.Context, *GetColumnFullRequest) (*GetColumnFullResponse, error)
 	MoveColumn(context.Context, *MoveColumnRequest) (*MoveColumnResponse, error)
+	ReorderColumns(context.Context, *ReorderColumnsRequest) (*ReorderColumnsResponse, error)
 	DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error)
+	RestoreColumn(context.Context, *RestoreColumnRequest) (*RestoreColumnResponse, error)
 	mustEmbedUnimplementedColumnsServiceServer()
 }
 
@@ -110,9 +136,15 @@ func (UnimplementedColumnsServiceServer) GetColumnFull(context.Context, *GetColu
 func (UnimplementedColumnsServiceServer) MoveColumn(context.Context, *MoveColumnRequest) (*MoveColumnResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MoveColumn not implemented")
 }
+func (UnimplementedColumnsServiceServer) ReorderColumns(context.Context, *ReorderColumnsRequest) (*ReorderColumnsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderColumns not implemented")
+}
 func (UnimplementedColumnsServiceServer) DeleteColumn(context.Context, *DeleteColumnRequest) (*DeleteColumnResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteColumn not implemented")
 }
+func (UnimplementedColumnsServiceServer) RestoreColumn(context.Context, *RestoreColumnRequest) (*RestoreColumnResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreColumn not implemented")
+}
 func (UnimplementedColumnsServiceServer) mustEmbedUnimplementedColumnsServiceServer() {}
 func (UnimplementedColumnsServiceServer) testEmbeddedByValue()                        {}
 
@@ -188,6 +220,24 @@ func _ColumnsService_MoveColumn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ColumnsService_ReorderColumns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderColumnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColumnsServiceServer).ReorderColumns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ColumnsService_ReorderColumns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColumnsServiceServer).ReorderColumns(ctx, req.(*ReorderColumnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ColumnsService_DeleteColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteColumnRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +256,24 @@ func _ColumnsService_DeleteColumn_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ColumnsService_RestoreColumn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreColumnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColumnsServiceServer).RestoreColumn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ColumnsService_RestoreColumn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColumnsServiceServer).RestoreColumn(ctx, req.(*RestoreColumnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ColumnsService_ServiceDesc is the grpc.ServiceDesc for ColumnsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,10 +293,18 @@ var ColumnsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MoveColumn",
 			Handler:    _ColumnsService_MoveColumn_Handler,
 		},
+		{
+			MethodName: "ReorderColumns",
+			Handler:    _ColumnsService_ReorderColumns_Handler,
+		},
 		{
 			MethodName: "DeleteColumn",
 			Handler:    _ColumnsService_DeleteColumn_Handler,
 		},
+		{
+			MethodName: "RestoreColumn",
+			Handler:    _ColumnsService_RestoreColumn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/columns.proto",
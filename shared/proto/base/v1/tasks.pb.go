@@ -9,6 +9,8 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,16 +23,80 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TaskPriority int32
+
+const (
+	TaskPriority_TASK_PRIORITY_UNSPECIFIED TaskPriority = 0 // приоритет не задан
+	TaskPriority_TASK_PRIORITY_LOW         TaskPriority = 1
+	TaskPriority_TASK_PRIORITY_MEDIUM      TaskPriority = 2
+	TaskPriority_TASK_PRIORITY_HIGH        TaskPriority = 3
+	TaskPriority_TASK_PRIORITY_URGENT      TaskPriority = 4
+)
+
+// Enum value maps for TaskPriority.
+var (
+	TaskPriority_name = map[int32]string{
+		0: "TASK_PRIORITY_UNSPECIFIED",
+		1: "TASK_PRIORITY_LOW",
+		2: "TASK_PRIORITY_MEDIUM",
+		3: "TASK_PRIORITY_HIGH",
+		4: "TASK_PRIORITY_URGENT",
+	}
+	TaskPriority_value = map[string]int32{
+		"TASK_PRIORITY_UNSPECIFIED": 0,
+		"TASK_PRIORITY_LOW":         1,
+		"TASK_PRIORITY_MEDIUM":      2,
+		"TASK_PRIORITY_HIGH":        3,
+		"TASK_PRIORITY_URGENT":      4,
+	}
+)
+
+func (x TaskPriority) Enum() *TaskPriority {
+	p := new(TaskPriority)
+	*p = x
+	return p
+}
+
+func (x TaskPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_base_v1_tasks_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskPriority) Type() protoreflect.EnumType {
+	return &file_base_v1_tasks_proto_enumTypes[0]
+}
+
+func (x TaskPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskPriority.Descriptor instead.
+func (TaskPriority) EnumDescriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{0}
+}
+
 type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ColumnId      string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
-	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	AssigneeId    string                 `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ColumnId         string                 `protobuf:"bytes,2,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	Position         int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Title            string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	AssigneeId       string                 `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	StartAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	DueAt            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	LabelIds         []string               `protobuf:"bytes,9,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
+	Checklist        *ChecklistProgress     `protobuf:"bytes,10,opt,name=checklist,proto3" json:"checklist,omitempty"`                                        // заполняется в составе доски
+	AttachmentsCount int32                  `protobuf:"varint,11,opt,name=attachments_count,json=attachmentsCount,proto3" json:"attachments_count,omitempty"` // в составе доски и в GetTask
+	Priority         TaskPriority           `protobuf:"varint,12,opt,name=priority,proto3,enum=taskboard.v1.TaskPriority" json:"priority,omitempty"`
+	Estimate         *float64               `protobuf:"fixed64,13,opt,name=estimate,proto3,oneof" json:"estimate,omitempty"`               // story points; не задан — без оценки
+	ArchivedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"` // задан — задача в архиве и вне порядка колонки
+	Version          int64                  `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`                        // растёт с каждой записью; для ETag
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
@@ -105,6 +171,69 @@ func (x *Task) GetAssigneeId() string {
 	return ""
 }
 
+func (x *Task) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Task) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Task) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *Task) GetChecklist() *ChecklistProgress {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+func (x *Task) GetAttachmentsCount() int32 {
+	if x != nil {
+		return x.AttachmentsCount
+	}
+	return 0
+}
+
+func (x *Task) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *Task) GetEstimate() float64 {
+	if x != nil && x.Estimate != nil {
+		return *x.Estimate
+	}
+	return 0
+}
+
+func (x *Task) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Task) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CreateTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -113,6 +242,10 @@ type CreateTaskRequest struct {
 	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	AssigneeId    string                 `protobuf:"bytes,6,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"` // не задан — без даты начала
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`       // не задан — без срока
+	Priority      TaskPriority           `protobuf:"varint,9,opt,name=priority,proto3,enum=taskboard.v1.TaskPriority" json:"priority,omitempty"`
+	Estimate      *float64               `protobuf:"fixed64,10,opt,name=estimate,proto3,oneof" json:"estimate,omitempty"` // не задан — без оценки
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,6 +322,34 @@ func (x *CreateTaskRequest) GetAssigneeId() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *CreateTaskRequest) GetEstimate() float64 {
+	if x != nil && x.Estimate != nil {
+		return *x.Estimate
+	}
+	return 0
+}
+
 type CreateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -346,12 +507,20 @@ func (x *GetTaskResponse) GetTask() *Task {
 }
 
 type UpdateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	AssigneeId    string                 `protobuf:"bytes,5,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId          string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	AssigneeId      string                 `protobuf:"bytes,5,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	StartAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`                           // не задан — дата начала снимается
+	DueAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                                 // не задан — срок снимается
+	Priority        TaskPriority           `protobuf:"varint,8,opt,name=priority,proto3,enum=taskboard.v1.TaskPriority" json:"priority,omitempty"`        // UNSPECIFIED — приоритет снимается
+	Estimate        *float64               `protobuf:"fixed64,9,opt,name=estimate,proto3,oneof" json:"estimate,omitempty"`                                // не задан — оценка снимается
+	ExpectedVersion int64                  `protobuf:"varint,10,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // из If-Match; 0 — без проверки
+	// пути: title, description, assignee_id, start_at, due_at, priority, estimate;
+	// пустая маска — замена всех полей
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,11,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -421,6 +590,48 @@ func (x *UpdateTaskRequest) GetAssigneeId() string {
 	return ""
 }
 
+func (x *UpdateTaskRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *UpdateTaskRequest) GetEstimate() float64 {
+	if x != nil && x.Estimate != nil {
+		return *x.Estimate
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type UpdateTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -474,13 +685,14 @@ func (x *UpdateTaskResponse) GetTask() *Task {
 }
 
 type MoveTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	ToColumnId    string                 `protobuf:"bytes,3,opt,name=to_column_id,json=toColumnId,proto3" json:"to_column_id,omitempty"`
-	ToPosition    int32                  `protobuf:"varint,4,opt,name=to_position,json=toPosition,proto3" json:"to_position,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId          string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ToColumnId      string                 `protobuf:"bytes,3,opt,name=to_column_id,json=toColumnId,proto3" json:"to_column_id,omitempty"`
+	ToPosition      int32                  `protobuf:"varint,4,opt,name=to_position,json=toPosition,proto3" json:"to_position,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // из If-Match; 0 — без проверки
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *MoveTaskRequest) Reset() {
@@ -541,6 +753,13 @@ func (x *MoveTaskRequest) GetToPosition() int32 {
 	return 0
 }
 
+func (x *MoveTaskRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type MoveTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -689,149 +908,1678 @@ func (x *DeleteTaskResponse) GetBase() *BaseResponse {
 	return nil
 }
 
-var File_base_v1_tasks_proto protoreflect.FileDescriptor
+type RestoreTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_base_v1_tasks_proto_rawDesc = "" +
-	"\n" +
-	"\x13base/v1/tasks.proto\x12\ftaskboard.v1\x1a\x14base/v1/common.proto\"\xa8\x01\n" +
-	"\x04Task\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12\x1a\n" +
-	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1f\n" +
-	"\vassignee_id\x18\x06 \x01(\tR\n" +
-	"assigneeId\"\xd4\x01\n" +
-	"\x11CreateTaskRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x1b\n" +
-	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12\x1a\n" +
-	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1f\n" +
-	"\vassignee_id\x18\x06 \x01(\tR\n" +
-	"assigneeId\"l\n" +
-	"\x12CreateTaskResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
-	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"X\n" +
-	"\x0eGetTaskRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\tR\x06taskId\"i\n" +
-	"\x0fGetTaskResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
-	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"\xb4\x01\n" +
-	"\x11UpdateTaskRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
-	"\vassignee_id\x18\x05 \x01(\tR\n" +
-	"assigneeId\"l\n" +
-	"\x12UpdateTaskResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
-	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"\x9c\x01\n" +
-	"\x0fMoveTaskRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12 \n" +
-	"\fto_column_id\x18\x03 \x01(\tR\n" +
-	"toColumnId\x12\x1f\n" +
-	"\vto_position\x18\x04 \x01(\x05R\n" +
-	"toPosition\"j\n" +
-	"\x10MoveTaskResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
-	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"[\n" +
-	"\x11DeleteTaskRequest\x12-\n" +
-	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\tR\x06taskId\"D\n" +
-	"\x12DeleteTaskResponse\x12.\n" +
-	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base2\x94\x03\n" +
-	"\fTasksService\x12O\n" +
-	"\n" +
-	"CreateTask\x12\x1f.taskboard.v1.CreateTaskRequest\x1a .taskboard.v1.CreateTaskResponse\x12F\n" +
-	"\aGetTask\x12\x1c.taskboard.v1.GetTaskRequest\x1a\x1d.taskboard.v1.GetTaskResponse\x12O\n" +
-	"\n" +
-	"UpdateTask\x12\x1f.taskboard.v1.UpdateTaskRequest\x1a .taskboard.v1.UpdateTaskResponse\x12I\n" +
-	"\bMoveTask\x12\x1d.taskboard.v1.MoveTaskRequest\x1a\x1e.taskboard.v1.MoveTaskResponse\x12O\n" +
-	"\n" +
-	"DeleteTask\x12\x1f.taskboard.v1.DeleteTaskRequest\x1a .taskboard.v1.DeleteTaskResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
+func (x *RestoreTaskRequest) Reset() {
+	*x = RestoreTaskRequest{}
+	mi := &file_base_v1_tasks_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_base_v1_tasks_proto_rawDescOnce sync.Once
-	file_base_v1_tasks_proto_rawDescData []byte
-)
+func (x *RestoreTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_base_v1_tasks_proto_rawDescGZIP() []byte {
-	file_base_v1_tasks_proto_rawDescOnce.Do(func() {
-		file_base_v1_tasks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_base_v1_tasks_proto_rawDesc), len(file_base_v1_tasks_proto_rawDesc)))
-	})
-	return file_base_v1_tasks_proto_rawDescData
+func (*RestoreTaskRequest) ProtoMessage() {}
+
+func (x *RestoreTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_base_v1_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_base_v1_tasks_proto_goTypes = []any{
-	(*Task)(nil),               // 0: taskboard.v1.Task
-	(*CreateTaskRequest)(nil),  // 1: taskboard.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil), // 2: taskboard.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),     // 3: taskboard.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),    // 4: taskboard.v1.GetTaskResponse
-	(*UpdateTaskRequest)(nil),  // 5: taskboard.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil), // 6: taskboard.v1.UpdateTaskResponse
-	(*MoveTaskRequest)(nil),    // 7: taskboard.v1.MoveTaskRequest
-	(*MoveTaskResponse)(nil),   // 8: taskboard.v1.MoveTaskResponse
-	(*DeleteTaskRequest)(nil),  // 9: taskboard.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil), // 10: taskboard.v1.DeleteTaskResponse
-	(*BaseRequest)(nil),        // 11: taskboard.v1.BaseRequest
-	(*BaseResponse)(nil),       // 12: taskboard.v1.BaseResponse
+// Deprecated: Use RestoreTaskRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{11}
 }
-var file_base_v1_tasks_proto_depIdxs = []int32{
-	11, // 0: taskboard.v1.CreateTaskRequest.base:type_name -> taskboard.v1.BaseRequest
-	12, // 1: taskboard.v1.CreateTaskResponse.base:type_name -> taskboard.v1.BaseResponse
-	0,  // 2: taskboard.v1.CreateTaskResponse.task:type_name -> taskboard.v1.Task
-	11, // 3: taskboard.v1.GetTaskRequest.base:type_name -> taskboard.v1.BaseRequest
-	12, // 4: taskboard.v1.GetTaskResponse.base:type_name -> taskboard.v1.BaseResponse
-	0,  // 5: taskboard.v1.GetTaskResponse.task:type_name -> taskboard.v1.Task
-	11, // 6: taskboard.v1.UpdateTaskRequest.base:type_name -> taskboard.v1.BaseRequest
-	12, // 7: taskboard.v1.UpdateTaskResponse.base:type_name -> taskboard.v1.BaseResponse
-	0,  // 8: taskboard.v1.UpdateTaskResponse.task:type_name -> taskboard.v1.Task
-	11, // 9: taskboard.v1.MoveTaskRequest.base:type_name -> taskboard.v1.BaseRequest
-	12, // 10: taskboard.v1.MoveTaskResponse.base:type_name -> taskboard.v1.BaseResponse
-	0,  // 11: taskboard.v1.MoveTaskResponse.task:type_name -> taskboard.v1.Task
-	11, // 12: taskboard.v1.DeleteTaskRequest.base:type_name -> taskboard.v1.BaseRequest
-	12, // 13: taskboard.v1.DeleteTaskResponse.base:type_name -> taskboard.v1.BaseResponse
-	1,  // 14: taskboard.v1.TasksService.CreateTask:input_type -> taskboard.v1.CreateTaskRequest
-	3,  // 15: taskboard.v1.TasksService.GetTask:input_type -> taskboard.v1.GetTaskRequest
-	5,  // 16: taskboard.v1.TasksService.UpdateTask:input_type -> taskboard.v1.UpdateTaskRequest
-	7,  // 17: taskboard.v1.TasksService.MoveTask:input_type -> taskboard.v1.MoveTaskRequest
-	9,  // 18: taskboard.v1.TasksService.DeleteTask:input_type -> taskboard.v1.DeleteTaskRequest
-	2,  // 19: taskboard.v1.TasksService.CreateTask:output_type -> taskboard.v1.CreateTaskResponse
-	4,  // 20: taskboard.v1.TasksService.GetTask:output_type -> taskboard.v1.GetTaskResponse
-	6,  // 21: taskboard.v1.TasksService.UpdateTask:output_type -> taskboard.v1.UpdateTaskResponse
-	8,  // 22: taskboard.v1.TasksService.MoveTask:output_type -> taskboard.v1.MoveTaskResponse
-	10, // 23: taskboard.v1.TasksService.DeleteTask:output_type -> taskboard.v1.DeleteTaskResponse
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+func (x *RestoreTaskRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
 }
 
-func init() { file_base_v1_tasks_proto_init() }
-func file_base_v1_tasks_proto_init() {
-	if File_base_v1_tasks_proto != nil {
-		return
+func (x *RestoreTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type RestoreTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Task          *Task                  `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskResponse) Reset() {
+	*x = RestoreTaskResponse{}
+	mi := &file_base_v1_tasks_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskResponse) ProtoMessage() {}
+
+func (x *RestoreTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTaskResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreTaskResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *RestoreTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ArchiveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskRequest) Reset() {
+	*x = ArchiveTaskRequest{}
+	mi := &file_base_v1_tasks_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskRequest) ProtoMessage() {}
+
+func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ArchiveTaskRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ArchiveTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type ArchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Task          *Task                  `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskResponse) Reset() {
+	*x = ArchiveTaskResponse{}
+	mi := &file_base_v1_tasks_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskResponse) ProtoMessage() {}
+
+func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArchiveTaskResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ArchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type UnarchiveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ColumnId      string                 `protobuf:"bytes,3,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"` // пустой — прежняя колонка
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskRequest) Reset() {
+	*x = UnarchiveTaskRequest{}
+	mi := &file_base_v1_tasks_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskRequest) ProtoMessage() {}
+
+func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnarchiveTaskRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnarchiveTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UnarchiveTaskRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+type UnarchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Task          *Task                  `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskResponse) Reset() {
+	*x = UnarchiveTaskResponse{}
+	mi := &file_base_v1_tasks_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskResponse) ProtoMessage() {}
+
+func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnarchiveTaskResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnarchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type SetTaskLabelsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId          string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	LabelIds        []string               `protobuf:"bytes,3,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`                       // полный новый набор меток
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // из If-Match; 0 — без проверки
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetTaskLabelsRequest) Reset() {
+	*x = SetTaskLabelsRequest{}
+	mi := &file_base_v1_tasks_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaskLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaskLabelsRequest) ProtoMessage() {}
+
+func (x *SetTaskLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaskLabelsRequest.ProtoReflect.Descriptor instead.
+func (*SetTaskLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetTaskLabelsRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SetTaskLabelsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SetTaskLabelsRequest) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *SetTaskLabelsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type SetTaskLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Task          *Task                  `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaskLabelsResponse) Reset() {
+	*x = SetTaskLabelsResponse{}
+	mi := &file_base_v1_tasks_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaskLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaskLabelsResponse) ProtoMessage() {}
+
+func (x *SetTaskLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaskLabelsResponse.ProtoReflect.Descriptor instead.
+func (*SetTaskLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetTaskLabelsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SetTaskLabelsResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type SearchTasksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Query           string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	BoardId         string                 `protobuf:"bytes,3,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	ColumnId        string                 `protobuf:"bytes,4,opt,name=column_id,json=columnId,proto3" json:"column_id,omitempty"`
+	AssigneeId      string                 `protobuf:"bytes,5,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	CreatedFrom     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // включительно
+	CreatedTo       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // не включительно
+	PageSize        int32                  `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor          string                 `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,10,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // вместе с задачами из архива
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_base_v1_tasks_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchTasksRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetColumnId() string {
+	if x != nil {
+		return x.ColumnId
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *SearchTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type TaskSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Rank          float32                `protobuf:"fixed32,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string                 `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"` // HTML-экранированный текст, совпадения обёрнуты в <mark></mark>
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskSearchHit) Reset() {
+	*x = TaskSearchHit{}
+	mi := &file_base_v1_tasks_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSearchHit) ProtoMessage() {}
+
+func (x *TaskSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSearchHit.ProtoReflect.Descriptor instead.
+func (*TaskSearchHit) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TaskSearchHit) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TaskSearchHit) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *TaskSearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TaskSearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Hits          []*TaskSearchHit       `protobuf:"bytes,2,rep,name=hits,proto3" json:"hits,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_base_v1_tasks_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksResponse) ProtoMessage() {}
+
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchTasksResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *SearchTasksResponse) GetHits() []*TaskSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchTasksResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type AssignedTask struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Task           *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	BoardId        string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	BoardTitle     string                 `protobuf:"bytes,3,opt,name=board_title,json=boardTitle,proto3" json:"board_title,omitempty"`
+	ColumnPosition int32                  `protobuf:"varint,4,opt,name=column_position,json=columnPosition,proto3" json:"column_position,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignedTask) Reset() {
+	*x = AssignedTask{}
+	mi := &file_base_v1_tasks_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignedTask) ProtoMessage() {}
+
+func (x *AssignedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignedTask.ProtoReflect.Descriptor instead.
+func (*AssignedTask) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AssignedTask) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *AssignedTask) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *AssignedTask) GetBoardTitle() string {
+	if x != nil {
+		return x.BoardTitle
+	}
+	return ""
+}
+
+func (x *AssignedTask) GetColumnPosition() int32 {
+	if x != nil {
+		return x.ColumnPosition
+	}
+	return 0
+}
+
+type AssignedTaskGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       string                 `protobuf:"bytes,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	BoardTitle    string                 `protobuf:"bytes,2,opt,name=board_title,json=boardTitle,proto3" json:"board_title,omitempty"`
+	Tasks         []*AssignedTask        `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignedTaskGroup) Reset() {
+	*x = AssignedTaskGroup{}
+	mi := &file_base_v1_tasks_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignedTaskGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignedTaskGroup) ProtoMessage() {}
+
+func (x *AssignedTaskGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignedTaskGroup.ProtoReflect.Descriptor instead.
+func (*AssignedTaskGroup) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AssignedTaskGroup) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *AssignedTaskGroup) GetBoardTitle() string {
+	if x != nil {
+		return x.BoardTitle
+	}
+	return ""
+}
+
+func (x *AssignedTaskGroup) GetTasks() []*AssignedTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type ListTasksByAssigneeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Base            *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	AssigneeId      string                 `protobuf:"bytes,2,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"` // чужие задачи отдаются только с досок base.requester_id
+	Sort            string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`                               // "updated_at" (по умолчанию) или "due_at"
+	GroupByBoard    bool                   `protobuf:"varint,4,opt,name=group_by_board,json=groupByBoard,proto3" json:"group_by_board,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // вместе с задачами из архива
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListTasksByAssigneeRequest) Reset() {
+	*x = ListTasksByAssigneeRequest{}
+	mi := &file_base_v1_tasks_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksByAssigneeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksByAssigneeRequest) ProtoMessage() {}
+
+func (x *ListTasksByAssigneeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksByAssigneeRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksByAssigneeRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTasksByAssigneeRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTasksByAssigneeRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *ListTasksByAssigneeRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListTasksByAssigneeRequest) GetGroupByBoard() bool {
+	if x != nil {
+		return x.GroupByBoard
+	}
+	return false
+}
+
+func (x *ListTasksByAssigneeRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListTasksByAssigneeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Tasks         []*AssignedTask        `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`   // заполняется без group_by_board
+	Groups        []*AssignedTaskGroup   `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"` // заполняется с group_by_board
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksByAssigneeResponse) Reset() {
+	*x = ListTasksByAssigneeResponse{}
+	mi := &file_base_v1_tasks_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksByAssigneeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksByAssigneeResponse) ProtoMessage() {}
+
+func (x *ListTasksByAssigneeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksByAssigneeResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksByAssigneeResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTasksByAssigneeResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTasksByAssigneeResponse) GetTasks() []*AssignedTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksByAssigneeResponse) GetGroups() []*AssignedTaskGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type AssignTaskOperation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,2,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTaskOperation) Reset() {
+	*x = AssignTaskOperation{}
+	mi := &file_base_v1_tasks_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTaskOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTaskOperation) ProtoMessage() {}
+
+func (x *AssignTaskOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTaskOperation.ProtoReflect.Descriptor instead.
+func (*AssignTaskOperation) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AssignTaskOperation) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AssignTaskOperation) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+// Одна операция пачки; base во вложенных запросах не используется.
+type BulkTaskOperation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Op:
+	//
+	//	*BulkTaskOperation_Move
+	//	*BulkTaskOperation_Update
+	//	*BulkTaskOperation_Delete
+	//	*BulkTaskOperation_Assign
+	Op            isBulkTaskOperation_Op `protobuf_oneof:"op"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTaskOperation) Reset() {
+	*x = BulkTaskOperation{}
+	mi := &file_base_v1_tasks_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTaskOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTaskOperation) ProtoMessage() {}
+
+func (x *BulkTaskOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTaskOperation.ProtoReflect.Descriptor instead.
+func (*BulkTaskOperation) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BulkTaskOperation) GetOp() isBulkTaskOperation_Op {
+	if x != nil {
+		return x.Op
+	}
+	return nil
+}
+
+func (x *BulkTaskOperation) GetMove() *MoveTaskRequest {
+	if x != nil {
+		if x, ok := x.Op.(*BulkTaskOperation_Move); ok {
+			return x.Move
+		}
+	}
+	return nil
+}
+
+func (x *BulkTaskOperation) GetUpdate() *UpdateTaskRequest {
+	if x != nil {
+		if x, ok := x.Op.(*BulkTaskOperation_Update); ok {
+			return x.Update
+		}
+	}
+	return nil
+}
+
+func (x *BulkTaskOperation) GetDelete() *DeleteTaskRequest {
+	if x != nil {
+		if x, ok := x.Op.(*BulkTaskOperation_Delete); ok {
+			return x.Delete
+		}
+	}
+	return nil
+}
+
+func (x *BulkTaskOperation) GetAssign() *AssignTaskOperation {
+	if x != nil {
+		if x, ok := x.Op.(*BulkTaskOperation_Assign); ok {
+			return x.Assign
+		}
+	}
+	return nil
+}
+
+type isBulkTaskOperation_Op interface {
+	isBulkTaskOperation_Op()
+}
+
+type BulkTaskOperation_Move struct {
+	Move *MoveTaskRequest `protobuf:"bytes,1,opt,name=move,proto3,oneof"`
+}
+
+type BulkTaskOperation_Update struct {
+	Update *UpdateTaskRequest `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+type BulkTaskOperation_Delete struct {
+	Delete *DeleteTaskRequest `protobuf:"bytes,3,opt,name=delete,proto3,oneof"`
+}
+
+type BulkTaskOperation_Assign struct {
+	Assign *AssignTaskOperation `protobuf:"bytes,4,opt,name=assign,proto3,oneof"`
+}
+
+func (*BulkTaskOperation_Move) isBulkTaskOperation_Op() {}
+
+func (*BulkTaskOperation_Update) isBulkTaskOperation_Op() {}
+
+func (*BulkTaskOperation_Delete) isBulkTaskOperation_Op() {}
+
+func (*BulkTaskOperation_Assign) isBulkTaskOperation_Op() {}
+
+type BulkTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Operations    []*BulkTaskOperation   `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	BestEffort    bool                   `protobuf:"varint,3,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"` // по умолчанию пачка применяется целиком или не применяется вовсе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTasksRequest) Reset() {
+	*x = BulkTasksRequest{}
+	mi := &file_base_v1_tasks_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTasksRequest) ProtoMessage() {}
+
+func (x *BulkTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTasksRequest.ProtoReflect.Descriptor instead.
+func (*BulkTasksRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkTasksRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BulkTasksRequest) GetOperations() []*BulkTaskOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *BulkTasksRequest) GetBestEffort() bool {
+	if x != nil {
+		return x.BestEffort
+	}
+	return false
+}
+
+type BulkTaskResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // номер операции в запросе
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`   // google.rpc.Code; 0 — операция выполнена
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Task          *Task                  `protobuf:"bytes,4,opt,name=task,proto3" json:"task,omitempty"` // задача после операции; пусто для удаления и при ошибке
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTaskResult) Reset() {
+	*x = BulkTaskResult{}
+	mi := &file_base_v1_tasks_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTaskResult) ProtoMessage() {}
+
+func (x *BulkTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTaskResult.ProtoReflect.Descriptor instead.
+func (*BulkTaskResult) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BulkTaskResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkTaskResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BulkTaskResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkTaskResult) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type BulkTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Results       []*BulkTaskResult      `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTasksResponse) Reset() {
+	*x = BulkTasksResponse{}
+	mi := &file_base_v1_tasks_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkTasksResponse) ProtoMessage() {}
+
+func (x *BulkTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_tasks_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkTasksResponse.ProtoReflect.Descriptor instead.
+func (*BulkTasksResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_tasks_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BulkTasksResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BulkTasksResponse) GetResults() []*BulkTaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_base_v1_tasks_proto protoreflect.FileDescriptor
+
+const file_base_v1_tasks_proto_rawDesc = "" +
+	"\n" +
+	"\x13base/v1/tasks.proto\x12\ftaskboard.v1\x1a\x18base/v1/checklists.proto\x1a\x14base/v1/common.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd8\x04\n" +
+	"\x04Task\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vassignee_id\x18\x06 \x01(\tR\n" +
+	"assigneeId\x125\n" +
+	"\bstart_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06due_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x1b\n" +
+	"\tlabel_ids\x18\t \x03(\tR\blabelIds\x12=\n" +
+	"\tchecklist\x18\n" +
+	" \x01(\v2\x1f.taskboard.v1.ChecklistProgressR\tchecklist\x12+\n" +
+	"\x11attachments_count\x18\v \x01(\x05R\x10attachmentsCount\x126\n" +
+	"\bpriority\x18\f \x01(\x0e2\x1a.taskboard.v1.TaskPriorityR\bpriority\x12\x1f\n" +
+	"\bestimate\x18\r \x01(\x01H\x00R\bestimate\x88\x01\x01\x12;\n" +
+	"\varchived_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x03R\aversionB\v\n" +
+	"\t_estimate\"\xa4\x03\n" +
+	"\x11CreateTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x1b\n" +
+	"\tcolumn_id\x18\x02 \x01(\tR\bcolumnId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vassignee_id\x18\x06 \x01(\tR\n" +
+	"assigneeId\x125\n" +
+	"\bstart_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06due_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x126\n" +
+	"\bpriority\x18\t \x01(\x0e2\x1a.taskboard.v1.TaskPriorityR\bpriority\x12\x1f\n" +
+	"\bestimate\x18\n" +
+	" \x01(\x01H\x00R\bestimate\x88\x01\x01B\v\n" +
+	"\t_estimate\"l\n" +
+	"\x12CreateTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"X\n" +
+	"\x0eGetTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"i\n" +
+	"\x0fGetTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"\xec\x03\n" +
+	"\x11UpdateTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vassignee_id\x18\x05 \x01(\tR\n" +
+	"assigneeId\x125\n" +
+	"\bstart_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06due_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x126\n" +
+	"\bpriority\x18\b \x01(\x0e2\x1a.taskboard.v1.TaskPriorityR\bpriority\x12\x1f\n" +
+	"\bestimate\x18\t \x01(\x01H\x00R\bestimate\x88\x01\x01\x12)\n" +
+	"\x10expected_version\x18\n" +
+	" \x01(\x03R\x0fexpectedVersion\x12;\n" +
+	"\vupdate_mask\x18\v \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\v\n" +
+	"\t_estimate\"l\n" +
+	"\x12UpdateTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"\xc7\x01\n" +
+	"\x0fMoveTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12 \n" +
+	"\fto_column_id\x18\x03 \x01(\tR\n" +
+	"toColumnId\x12\x1f\n" +
+	"\vto_position\x18\x04 \x01(\x05R\n" +
+	"toPosition\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\"j\n" +
+	"\x10MoveTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"[\n" +
+	"\x11DeleteTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"D\n" +
+	"\x12DeleteTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\"\\\n" +
+	"\x12RestoreTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"m\n" +
+	"\x13RestoreTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"\\\n" +
+	"\x12ArchiveTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"m\n" +
+	"\x13ArchiveTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"{\n" +
+	"\x14UnarchiveTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tcolumn_id\x18\x03 \x01(\tR\bcolumnId\"o\n" +
+	"\x15UnarchiveTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"\xa6\x01\n" +
+	"\x14SetTaskLabelsRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tlabel_ids\x18\x03 \x03(\tR\blabelIds\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"o\n" +
+	"\x15SetTaskLabelsResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12&\n" +
+	"\x04task\x18\x02 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"\x8c\x03\n" +
+	"\x12SearchTasksRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x19\n" +
+	"\bboard_id\x18\x03 \x01(\tR\aboardId\x12\x1b\n" +
+	"\tcolumn_id\x18\x04 \x01(\tR\bcolumnId\x12\x1f\n" +
+	"\vassignee_id\x18\x05 \x01(\tR\n" +
+	"assigneeId\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1b\n" +
+	"\tpage_size\x18\b \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\x12)\n" +
+	"\x10include_archived\x18\n" +
+	" \x01(\bR\x0fincludeArchived\"\x80\x01\n" +
+	"\rTaskSearchHit\x12&\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.taskboard.v1.TaskR\x04task\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x02R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\"\x97\x01\n" +
+	"\x13SearchTasksResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12/\n" +
+	"\x04hits\x18\x02 \x03(\v2\x1b.taskboard.v1.TaskSearchHitR\x04hits\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x9b\x01\n" +
+	"\fAssignedTask\x12&\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.taskboard.v1.TaskR\x04task\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\x12\x1f\n" +
+	"\vboard_title\x18\x03 \x01(\tR\n" +
+	"boardTitle\x12'\n" +
+	"\x0fcolumn_position\x18\x04 \x01(\x05R\x0ecolumnPosition\"\x81\x01\n" +
+	"\x11AssignedTaskGroup\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\tR\aboardId\x12\x1f\n" +
+	"\vboard_title\x18\x02 \x01(\tR\n" +
+	"boardTitle\x120\n" +
+	"\x05tasks\x18\x03 \x03(\v2\x1a.taskboard.v1.AssignedTaskR\x05tasks\"\xd1\x01\n" +
+	"\x1aListTasksByAssigneeRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x1f\n" +
+	"\vassignee_id\x18\x02 \x01(\tR\n" +
+	"assigneeId\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12$\n" +
+	"\x0egroup_by_board\x18\x04 \x01(\bR\fgroupByBoard\x12)\n" +
+	"\x10include_archived\x18\x05 \x01(\bR\x0fincludeArchived\"\xb8\x01\n" +
+	"\x1bListTasksByAssigneeResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x120\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x1a.taskboard.v1.AssignedTaskR\x05tasks\x127\n" +
+	"\x06groups\x18\x03 \x03(\v2\x1f.taskboard.v1.AssignedTaskGroupR\x06groups\"O\n" +
+	"\x13AssignTaskOperation\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1f\n" +
+	"\vassignee_id\x18\x02 \x01(\tR\n" +
+	"assigneeId\"\x81\x02\n" +
+	"\x11BulkTaskOperation\x123\n" +
+	"\x04move\x18\x01 \x01(\v2\x1d.taskboard.v1.MoveTaskRequestH\x00R\x04move\x129\n" +
+	"\x06update\x18\x02 \x01(\v2\x1f.taskboard.v1.UpdateTaskRequestH\x00R\x06update\x129\n" +
+	"\x06delete\x18\x03 \x01(\v2\x1f.taskboard.v1.DeleteTaskRequestH\x00R\x06delete\x12;\n" +
+	"\x06assign\x18\x04 \x01(\v2!.taskboard.v1.AssignTaskOperationH\x00R\x06assignB\x04\n" +
+	"\x02op\"\xa3\x01\n" +
+	"\x10BulkTasksRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12?\n" +
+	"\n" +
+	"operations\x18\x02 \x03(\v2\x1f.taskboard.v1.BulkTaskOperationR\n" +
+	"operations\x12\x1f\n" +
+	"\vbest_effort\x18\x03 \x01(\bR\n" +
+	"bestEffort\"x\n" +
+	"\x0eBulkTaskResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12&\n" +
+	"\x04task\x18\x04 \x01(\v2\x12.taskboard.v1.TaskR\x04task\"{\n" +
+	"\x11BulkTasksResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x126\n" +
+	"\aresults\x18\x02 \x03(\v2\x1c.taskboard.v1.BulkTaskResultR\aresults*\x90\x01\n" +
+	"\fTaskPriority\x12\x1d\n" +
+	"\x19TASK_PRIORITY_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TASK_PRIORITY_LOW\x10\x01\x12\x18\n" +
+	"\x14TASK_PRIORITY_MEDIUM\x10\x02\x12\x16\n" +
+	"\x12TASK_PRIORITY_HIGH\x10\x03\x12\x18\n" +
+	"\x14TASK_PRIORITY_URGENT\x10\x042\xfe\a\n" +
+	"\fTasksService\x12O\n" +
+	"\n" +
+	"CreateTask\x12\x1f.taskboard.v1.CreateTaskRequest\x1a .taskboard.v1.CreateTaskResponse\x12F\n" +
+	"\aGetTask\x12\x1c.taskboard.v1.GetTaskRequest\x1a\x1d.taskboard.v1.GetTaskResponse\x12O\n" +
+	"\n" +
+	"UpdateTask\x12\x1f.taskboard.v1.UpdateTaskRequest\x1a .taskboard.v1.UpdateTaskResponse\x12I\n" +
+	"\bMoveTask\x12\x1d.taskboard.v1.MoveTaskRequest\x1a\x1e.taskboard.v1.MoveTaskResponse\x12O\n" +
+	"\n" +
+	"DeleteTask\x12\x1f.taskboard.v1.DeleteTaskRequest\x1a .taskboard.v1.DeleteTaskResponse\x12R\n" +
+	"\vRestoreTask\x12 .taskboard.v1.RestoreTaskRequest\x1a!.taskboard.v1.RestoreTaskResponse\x12R\n" +
+	"\vArchiveTask\x12 .taskboard.v1.ArchiveTaskRequest\x1a!.taskboard.v1.ArchiveTaskResponse\x12X\n" +
+	"\rUnarchiveTask\x12\".taskboard.v1.UnarchiveTaskRequest\x1a#.taskboard.v1.UnarchiveTaskResponse\x12X\n" +
+	"\rSetTaskLabels\x12\".taskboard.v1.SetTaskLabelsRequest\x1a#.taskboard.v1.SetTaskLabelsResponse\x12R\n" +
+	"\vSearchTasks\x12 .taskboard.v1.SearchTasksRequest\x1a!.taskboard.v1.SearchTasksResponse\x12j\n" +
+	"\x13ListTasksByAssignee\x12(.taskboard.v1.ListTasksByAssigneeRequest\x1a).taskboard.v1.ListTasksByAssigneeResponse\x12L\n" +
+	"\tBulkTasks\x12\x1e.taskboard.v1.BulkTasksRequest\x1a\x1f.taskboard.v1.BulkTasksResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
+
+var (
+	file_base_v1_tasks_proto_rawDescOnce sync.Once
+	file_base_v1_tasks_proto_rawDescData []byte
+)
+
+func file_base_v1_tasks_proto_rawDescGZIP() []byte {
+	file_base_v1_tasks_proto_rawDescOnce.Do(func() {
+		file_base_v1_tasks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_base_v1_tasks_proto_rawDesc), len(file_base_v1_tasks_proto_rawDesc)))
+	})
+	return file_base_v1_tasks_proto_rawDescData
+}
+
+var file_base_v1_tasks_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_base_v1_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_base_v1_tasks_proto_goTypes = []any{
+	(TaskPriority)(0),                   // 0: taskboard.v1.TaskPriority
+	(*Task)(nil),                        // 1: taskboard.v1.Task
+	(*CreateTaskRequest)(nil),           // 2: taskboard.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),          // 3: taskboard.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),              // 4: taskboard.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),             // 5: taskboard.v1.GetTaskResponse
+	(*UpdateTaskRequest)(nil),           // 6: taskboard.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),          // 7: taskboard.v1.UpdateTaskResponse
+	(*MoveTaskRequest)(nil),             // 8: taskboard.v1.MoveTaskRequest
+	(*MoveTaskResponse)(nil),            // 9: taskboard.v1.MoveTaskResponse
+	(*DeleteTaskRequest)(nil),           // 10: taskboard.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),          // 11: taskboard.v1.DeleteTaskResponse
+	(*RestoreTaskRequest)(nil),          // 12: taskboard.v1.RestoreTaskRequest
+	(*RestoreTaskResponse)(nil),         // 13: taskboard.v1.RestoreTaskResponse
+	(*ArchiveTaskRequest)(nil),          // 14: taskboard.v1.ArchiveTaskRequest
+	(*ArchiveTaskResponse)(nil),         // 15: taskboard.v1.ArchiveTaskResponse
+	(*UnarchiveTaskRequest)(nil),        // 16: taskboard.v1.UnarchiveTaskRequest
+	(*UnarchiveTaskResponse)(nil),       // 17: taskboard.v1.UnarchiveTaskResponse
+	(*SetTaskLabelsRequest)(nil),        // 18: taskboard.v1.SetTaskLabelsRequest
+	(*SetTaskLabelsResponse)(nil),       // 19: taskboard.v1.SetTaskLabelsResponse
+	(*SearchTasksRequest)(nil),          // 20: taskboard.v1.SearchTasksRequest
+	(*TaskSearchHit)(nil),               // 21: taskboard.v1.TaskSearchHit
+	(*SearchTasksResponse)(nil),         // 22: taskboard.v1.SearchTasksResponse
+	(*AssignedTask)(nil),                // 23: taskboard.v1.AssignedTask
+	(*AssignedTaskGroup)(nil),           // 24: taskboard.v1.AssignedTaskGroup
+	(*ListTasksByAssigneeRequest)(nil),  // 25: taskboard.v1.ListTasksByAssigneeRequest
+	(*ListTasksByAssigneeResponse)(nil), // 26: taskboard.v1.ListTasksByAssigneeResponse
+	(*AssignTaskOperation)(nil),         // 27: taskboard.v1.AssignTaskOperation
+	(*BulkTaskOperation)(nil),           // 28: taskboard.v1.BulkTaskOperation
+	(*BulkTasksRequest)(nil),            // 29: taskboard.v1.BulkTasksRequest
+	(*BulkTaskResult)(nil),              // 30: taskboard.v1.BulkTaskResult
+	(*BulkTasksResponse)(nil),           // 31: taskboard.v1.BulkTasksResponse
+	(*timestamppb.Timestamp)(nil),       // 32: google.protobuf.Timestamp
+	(*ChecklistProgress)(nil),           // 33: taskboard.v1.ChecklistProgress
+	(*BaseRequest)(nil),                 // 34: taskboard.v1.BaseRequest
+	(*BaseResponse)(nil),                // 35: taskboard.v1.BaseResponse
+	(*fieldmaskpb.FieldMask)(nil),       // 36: google.protobuf.FieldMask
+}
+var file_base_v1_tasks_proto_depIdxs = []int32{
+	32, // 0: taskboard.v1.Task.start_at:type_name -> google.protobuf.Timestamp
+	32, // 1: taskboard.v1.Task.due_at:type_name -> google.protobuf.Timestamp
+	33, // 2: taskboard.v1.Task.checklist:type_name -> taskboard.v1.ChecklistProgress
+	0,  // 3: taskboard.v1.Task.priority:type_name -> taskboard.v1.TaskPriority
+	32, // 4: taskboard.v1.Task.archived_at:type_name -> google.protobuf.Timestamp
+	34, // 5: taskboard.v1.CreateTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	32, // 6: taskboard.v1.CreateTaskRequest.start_at:type_name -> google.protobuf.Timestamp
+	32, // 7: taskboard.v1.CreateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: taskboard.v1.CreateTaskRequest.priority:type_name -> taskboard.v1.TaskPriority
+	35, // 9: taskboard.v1.CreateTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 10: taskboard.v1.CreateTaskResponse.task:type_name -> taskboard.v1.Task
+	34, // 11: taskboard.v1.GetTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	35, // 12: taskboard.v1.GetTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 13: taskboard.v1.GetTaskResponse.task:type_name -> taskboard.v1.Task
+	34, // 14: taskboard.v1.UpdateTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	32, // 15: taskboard.v1.UpdateTaskRequest.start_at:type_name -> google.protobuf.Timestamp
+	32, // 16: taskboard.v1.UpdateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	0,  // 17: taskboard.v1.UpdateTaskRequest.priority:type_name -> taskboard.v1.TaskPriority
+	36, // 18: taskboard.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	35, // 19: taskboard.v1.UpdateTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 20: taskboard.v1.UpdateTaskResponse.task:type_name -> taskboard.v1.Task
+	34, // 21: taskboard.v1.MoveTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	35, // 22: taskboard.v1.MoveTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 23: taskboard.v1.MoveTaskResponse.task:type_name -> taskboard.v1.Task
+	34, // 24: taskboard.v1.DeleteTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	35, // 25: taskboard.v1.DeleteTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	34, // 26: taskboard.v1.RestoreTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	35, // 27: taskboard.v1.RestoreTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 28: taskboard.v1.RestoreTaskResponse.task:type_name -> taskboard.v1.Task
+	34, // 29: taskboard.v1.ArchiveTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	35, // 30: taskboard.v1.ArchiveTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 31: taskboard.v1.ArchiveTaskResponse.task:type_name -> taskboard.v1.Task
+	34, // 32: taskboard.v1.UnarchiveTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	35, // 33: taskboard.v1.UnarchiveTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 34: taskboard.v1.UnarchiveTaskResponse.task:type_name -> taskboard.v1.Task
+	34, // 35: taskboard.v1.SetTaskLabelsRequest.base:type_name -> taskboard.v1.BaseRequest
+	35, // 36: taskboard.v1.SetTaskLabelsResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 37: taskboard.v1.SetTaskLabelsResponse.task:type_name -> taskboard.v1.Task
+	34, // 38: taskboard.v1.SearchTasksRequest.base:type_name -> taskboard.v1.BaseRequest
+	32, // 39: taskboard.v1.SearchTasksRequest.created_from:type_name -> google.protobuf.Timestamp
+	32, // 40: taskboard.v1.SearchTasksRequest.created_to:type_name -> google.protobuf.Timestamp
+	1,  // 41: taskboard.v1.TaskSearchHit.task:type_name -> taskboard.v1.Task
+	35, // 42: taskboard.v1.SearchTasksResponse.base:type_name -> taskboard.v1.BaseResponse
+	21, // 43: taskboard.v1.SearchTasksResponse.hits:type_name -> taskboard.v1.TaskSearchHit
+	1,  // 44: taskboard.v1.AssignedTask.task:type_name -> taskboard.v1.Task
+	23, // 45: taskboard.v1.AssignedTaskGroup.tasks:type_name -> taskboard.v1.AssignedTask
+	34, // 46: taskboard.v1.ListTasksByAssigneeRequest.base:type_name -> taskboard.v1.BaseRequest
+	35, // 47: taskboard.v1.ListTasksByAssigneeResponse.base:type_name -> taskboard.v1.BaseResponse
+	23, // 48: taskboard.v1.ListTasksByAssigneeResponse.tasks:type_name -> taskboard.v1.AssignedTask
+	24, // 49: taskboard.v1.ListTasksByAssigneeResponse.groups:type_name -> taskboard.v1.AssignedTaskGroup
+	8,  // 50: taskboard.v1.BulkTaskOperation.move:type_name -> taskboard.v1.MoveTaskRequest
+	6,  // 51: taskboard.v1.BulkTaskOperation.update:type_name -> taskboard.v1.UpdateTaskRequest
+	10, // 52: taskboard.v1.BulkTaskOperation.delete:type_name -> taskboard.v1.DeleteTaskRequest
+	27, // 53: taskboard.v1.BulkTaskOperation.assign:type_name -> taskboard.v1.AssignTaskOperation
+	34, // 54: taskboard.v1.BulkTasksRequest.base:type_name -> taskboard.v1.BaseRequest
+	28, // 55: taskboard.v1.BulkTasksRequest.operations:type_name -> taskboard.v1.BulkTaskOperation
+	1,  // 56: taskboard.v1.BulkTaskResult.task:type_name -> taskboard.v1.Task
+	35, // 57: taskboard.v1.BulkTasksResponse.base:type_name -> taskboard.v1.BaseResponse
+	30, // 58: taskboard.v1.BulkTasksResponse.results:type_name -> taskboard.v1.BulkTaskResult
+	2,  // 59: taskboard.v1.TasksService.CreateTask:input_type -> taskboard.v1.CreateTaskRequest
+	4,  // 60: taskboard.v1.TasksService.GetTask:input_type -> taskboard.v1.GetTaskRequest
+	6,  // 61: taskboard.v1.TasksService.UpdateTask:input_type -> taskboard.v1.UpdateTaskRequest
+	8,  // 62: taskboard.v1.TasksService.MoveTask:input_type -> taskboard.v1.MoveTaskRequest
+	10, // 63: taskboard.v1.TasksService.DeleteTask:input_type -> taskboard.v1.DeleteTaskRequest
+	12, // 64: taskboard.v1.TasksService.RestoreTask:input_type -> taskboard.v1.RestoreTaskRequest
+	14, // 65: taskboard.v1.TasksService.ArchiveTask:input_type -> taskboard.v1.ArchiveTaskRequest
+	16, // 66: taskboard.v1.TasksService.UnarchiveTask:input_type -> taskboard.v1.UnarchiveTaskRequest
+	18, // 67: taskboard.v1.TasksService.SetTaskLabels:input_type -> taskboard.v1.SetTaskLabelsRequest
+	20, // 68: taskboard.v1.TasksService.SearchTasks:input_type -> taskboard.v1.SearchTasksRequest
+	25, // 69: taskboard.v1.TasksService.ListTasksByAssignee:input_type -> taskboard.v1.ListTasksByAssigneeRequest
+	29, // 70: taskboard.v1.TasksService.BulkTasks:input_type -> taskboard.v1.BulkTasksRequest
+	3,  // 71: taskboard.v1.TasksService.CreateTask:output_type -> taskboard.v1.CreateTaskResponse
+	5,  // 72: taskboard.v1.TasksService.GetTask:output_type -> taskboard.v1.GetTaskResponse
+	7,  // 73: taskboard.v1.TasksService.UpdateTask:output_type -> taskboard.v1.UpdateTaskResponse
+	9,  // 74: taskboard.v1.TasksService.MoveTask:output_type -> taskboard.v1.MoveTaskResponse
+	11, // 75: taskboard.v1.TasksService.DeleteTask:output_type -> taskboard.v1.DeleteTaskResponse
+	13, // 76: taskboard.v1.TasksService.RestoreTask:output_type -> taskboard.v1.RestoreTaskResponse
+	15, // 77: taskboard.v1.TasksService.ArchiveTask:output_type -> taskboard.v1.ArchiveTaskResponse
+	17, // 78: taskboard.v1.TasksService.UnarchiveTask:output_type -> taskboard.v1.UnarchiveTaskResponse
+	19, // 79: taskboard.v1.TasksService.SetTaskLabels:output_type -> taskboard.v1.SetTaskLabelsResponse
+	22, // 80: taskboard.v1.TasksService.SearchTasks:output_type -> taskboard.v1.SearchTasksResponse
+	26, // 81: taskboard.v1.TasksService.ListTasksByAssignee:output_type -> taskboard.v1.ListTasksByAssigneeResponse
+	31, // 82: taskboard.v1.TasksService.BulkTasks:output_type -> taskboard.v1.BulkTasksResponse
+	71, // [71:83] is the sub-list for method output_type
+	59, // [59:71] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_base_v1_tasks_proto_init() }
+func file_base_v1_tasks_proto_init() {
+	if File_base_v1_tasks_proto != nil {
+		return
+	}
+	file_base_v1_checklists_proto_init()
+	file_base_v1_common_proto_init()
+	file_base_v1_tasks_proto_msgTypes[0].OneofWrappers = []any{}
+	file_base_v1_tasks_proto_msgTypes[1].OneofWrappers = []any{}
+	file_base_v1_tasks_proto_msgTypes[5].OneofWrappers = []any{}
+	file_base_v1_tasks_proto_msgTypes[27].OneofWrappers = []any{
+		(*BulkTaskOperation_Move)(nil),
+		(*BulkTaskOperation_Update)(nil),
+		(*BulkTaskOperation_Delete)(nil),
+		(*BulkTaskOperation_Assign)(nil),
 	}
-	file_base_v1_common_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_base_v1_tasks_proto_rawDesc), len(file_base_v1_tasks_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_base_v1_tasks_proto_goTypes,
 		DependencyIndexes: file_base_v1_tasks_proto_depIdxs,
+		EnumInfos:         file_base_v1_tasks_proto_enumTypes,
 		MessageInfos:      file_base_v1_tasks_proto_msgTypes,
 	}.Build()
 	File_base_v1_tasks_proto = out.File
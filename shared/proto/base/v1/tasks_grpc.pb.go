@@ -19,11 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TasksService_CreateTask_FullMethodName = "/taskboard.v1.TasksService/CreateTask"
-	TasksService_GetTask_FullMethodName    = "/taskboard.v1.TasksService/GetTask"
-	TasksService_UpdateTask_FullMethodName = "/taskboard.v1.TasksService/UpdateTask"
-	TasksService_MoveTask_FullMethodName   = "/taskboard.v1.TasksService/MoveTask"
-	TasksService_DeleteTask_FullMethodName = "/taskboard.v1.TasksService/DeleteTask"
+	TasksService_CreateTask_FullMethodName          = "/taskboard.v1.TasksService/CreateTask"
+	TasksService_GetTask_FullMethodName             = "/taskboard.v1.TasksService/GetTask"
+	TasksService_UpdateTask_FullMethodName          = "/taskboard.v1.TasksService/UpdateTask"
+	TasksService_MoveTask_FullMethodName            = "/taskboard.v1.TasksService/MoveTask"
+	TasksService_DeleteTask_FullMethodName          = "/taskboard.v1.TasksService/DeleteTask"
+	TasksService_RestoreTask_FullMethodName         = "/taskboard.v1.TasksService/RestoreTask"
+	TasksService_ArchiveTask_FullMethodName         = "/taskboard.v1.TasksService/ArchiveTask"
+	TasksService_UnarchiveTask_FullMethodName       = "/taskboard.v1.TasksService/UnarchiveTask"
+	TasksService_SetTaskLabels_FullMethodName       = "/taskboard.v1.TasksService/SetTaskLabels"
+	TasksService_SearchTasks_FullMethodName         = "/taskboard.v1.TasksService/SearchTasks"
+	TasksService_ListTasksByAssignee_FullMethodName = "/taskboard.v1.TasksService/ListTasksByAssignee"
+	TasksService_BulkTasks_FullMethodName           = "/taskboard.v1.TasksService/BulkTasks"
 )
 
 // TasksServiceClient is the client API for TasksService service.
@@ -35,6 +42,13 @@ type TasksServiceClient interface {
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error)
 	MoveTask(ctx context.Context, in *MoveTaskRequest, opts ...grpc.CallOption) (*MoveTaskResponse, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*RestoreTaskResponse, error)
+	ArchiveTask(ctx context.Context, in *ArchiveTaskRequest, opts ...grpc.CallOption) (*ArchiveTaskResponse, error)
+	UnarchiveTask(ctx context.Context, in *UnarchiveTaskRequest, opts ...grpc.CallOption) (*UnarchiveTaskResponse, error)
+	SetTaskLabels(ctx context.Context, in *SetTaskLabelsRequest, opts ...grpc.CallOption) (*SetTaskLabelsResponse, error)
+	SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error)
+	ListTasksByAssignee(ctx context.Context, in *ListTasksByAssigneeRequest, opts ...grpc.CallOption) (*ListTasksByAssigneeResponse, error)
+	BulkTasks(ctx context.Context, in *BulkTasksRequest, opts ...grpc.CallOption) (*BulkTasksResponse, error)
 }
 
 type tasksServiceClient struct {
@@ -95,6 +109,76 @@ func (c *tasksServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskReque
 	return out, nil
 }
 
+func (c *tasksServiceClient) RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*RestoreTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTaskResponse)
+	err := c.cc.Invoke(ctx, TasksService_RestoreTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) ArchiveTask(ctx context.Context, in *ArchiveTaskRequest, opts ...grpc.CallOption) (*ArchiveTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveTaskResponse)
+	err := c.cc.Invoke(ctx, TasksService_ArchiveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) UnarchiveTask(ctx context.Context, in *UnarchiveTaskRequest, opts ...grpc.CallOption) (*UnarchiveTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveTaskResponse)
+	err := c.cc.Invoke(ctx, TasksService_UnarchiveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) SetTaskLabels(ctx context.Context, in *SetTaskLabelsRequest, opts ...grpc.CallOption) (*SetTaskLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTaskLabelsResponse)
+	err := c.cc.Invoke(ctx, TasksService_SetTaskLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTasksResponse)
+	err := c.cc.Invoke(ctx, TasksService_SearchTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) ListTasksByAssignee(ctx context.Context, in *ListTasksByAssigneeRequest, opts ...grpc.CallOption) (*ListTasksByAssigneeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksByAssigneeResponse)
+	err := c.cc.Invoke(ctx, TasksService_ListTasksByAssignee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksServiceClient) BulkTasks(ctx context.Context, in *BulkTasksRequest, opts ...grpc.CallOption) (*BulkTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkTasksResponse)
+	err := c.cc.Invoke(ctx, TasksService_BulkTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TasksServiceServer is the server API for TasksService service.
 // All implementations must embed UnimplementedTasksServiceServer
 // for forward compatibility.
@@ -104,6 +188,13 @@ type TasksServiceServer interface {
 	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
 	MoveTask(context.Context, *MoveTaskRequest) (*MoveTaskResponse, error)
 	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	RestoreTask(context.Context, *RestoreTaskRequest) (*RestoreTaskResponse, error)
+	ArchiveTask(context.Context, *ArchiveTaskRequest) (*ArchiveTaskResponse, error)
+	UnarchiveTask(context.Context, *UnarchiveTaskRequest) (*UnarchiveTaskResponse, error)
+	SetTaskLabels(context.Context, *SetTaskLabelsRequest) (*SetTaskLabelsResponse, error)
+	SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error)
+	ListTasksByAssignee(context.Context, *ListTasksByAssigneeRequest) (*ListTasksByAssigneeResponse, error)
+	BulkTasks(context.Context, *BulkTasksRequest) (*BulkTasksResponse, error)
 	mustEmbedUnimplementedTasksServiceServer()
 }
 
@@ -129,6 +220,27 @@ func (UnimplementedTasksServiceServer) MoveTask(context.Context, *MoveTaskReques
 func (UnimplementedTasksServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedTasksServiceServer) RestoreTask(context.Context, *RestoreTaskRequest) (*RestoreTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTask not implemented")
+}
+func (UnimplementedTasksServiceServer) ArchiveTask(context.Context, *ArchiveTaskRequest) (*ArchiveTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveTask not implemented")
+}
+func (UnimplementedTasksServiceServer) UnarchiveTask(context.Context, *UnarchiveTaskRequest) (*UnarchiveTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnarchiveTask not implemented")
+}
+func (UnimplementedTasksServiceServer) SetTaskLabels(context.Context, *SetTaskLabelsRequest) (*SetTaskLabelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTaskLabels not implemented")
+}
+func (UnimplementedTasksServiceServer) SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchTasks not implemented")
+}
+func (UnimplementedTasksServiceServer) ListTasksByAssignee(context.Context, *ListTasksByAssigneeRequest) (*ListTasksByAssigneeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTasksByAssignee not implemented")
+}
+func (UnimplementedTasksServiceServer) BulkTasks(context.Context, *BulkTasksRequest) (*BulkTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkTasks not implemented")
+}
 func (UnimplementedTasksServiceServer) mustEmbedUnimplementedTasksServiceServer() {}
 func (UnimplementedTasksServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,6 +352,132 @@ func _TasksService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TasksService_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_RestoreTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).RestoreTask(ctx, req.(*RestoreTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_ArchiveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).ArchiveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_ArchiveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).ArchiveTask(ctx, req.(*ArchiveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_UnarchiveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).UnarchiveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_UnarchiveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).UnarchiveTask(ctx, req.(*UnarchiveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_SetTaskLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).SetTaskLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_SetTaskLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).SetTaskLabels(ctx, req.(*SetTaskLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_SearchTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).SearchTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_SearchTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).SearchTasks(ctx, req.(*SearchTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_ListTasksByAssignee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksByAssigneeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).ListTasksByAssignee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_ListTasksByAssignee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).ListTasksByAssignee(ctx, req.(*ListTasksByAssigneeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TasksService_BulkTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServiceServer).BulkTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TasksService_BulkTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServiceServer).BulkTasks(ctx, req.(*BulkTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TasksService_ServiceDesc is the grpc.ServiceDesc for TasksService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +505,34 @@ var TasksService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _TasksService_DeleteTask_Handler,
 		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _TasksService_RestoreTask_Handler,
+		},
+		{
+			MethodName: "ArchiveTask",
+			Handler:    _TasksService_ArchiveTask_Handler,
+		},
+		{
+			MethodName: "UnarchiveTask",
+			Handler:    _TasksService_UnarchiveTask_Handler,
+		},
+		{
+			MethodName: "SetTaskLabels",
+			Handler:    _TasksService_SetTaskLabels_Handler,
+		},
+		{
+			MethodName: "SearchTasks",
+			Handler:    _TasksService_SearchTasks_Handler,
+		},
+		{
+			MethodName: "ListTasksByAssignee",
+			Handler:    _TasksService_ListTasksByAssignee_Handler,
+		},
+		{
+			MethodName: "BulkTasks",
+			Handler:    _TasksService_BulkTasks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/v1/tasks.proto",
@@ -0,0 +1,609 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: base/v1/templates.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TemplateLabel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"` // #rrggbb
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateLabel) Reset() {
+	*x = TemplateLabel{}
+	mi := &file_base_v1_templates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateLabel) ProtoMessage() {}
+
+func (x *TemplateLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_templates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateLabel.ProtoReflect.Descriptor instead.
+func (*TemplateLabel) Descriptor() ([]byte, []int) {
+	return file_base_v1_templates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TemplateLabel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateLabel) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type BoardTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // пустой — встроенный шаблон
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Columns       []string               `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"` // названия колонок по порядку
+	Labels        []*TemplateLabel       `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardTemplate) Reset() {
+	*x = BoardTemplate{}
+	mi := &file_base_v1_templates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardTemplate) ProtoMessage() {}
+
+func (x *BoardTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_templates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardTemplate.ProtoReflect.Descriptor instead.
+func (*BoardTemplate) Descriptor() ([]byte, []int) {
+	return file_base_v1_templates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BoardTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardTemplate) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *BoardTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BoardTemplate) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *BoardTemplate) GetLabels() []*TemplateLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	BoardId       string                 `protobuf:"bytes,3,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"` // доска, с которой снимается раскладка
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_base_v1_templates_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_templates_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_templates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTemplateRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateTemplateRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Template      *BoardTemplate         `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_base_v1_templates_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_templates_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_templates_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTemplateResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *BoardTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_base_v1_templates_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_templates_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_templates_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTemplatesRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTemplatesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Templates     []*BoardTemplate       `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"` // встроенные и шаблоны пользователя
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_base_v1_templates_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_templates_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_templates_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTemplatesResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*BoardTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_base_v1_templates_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_templates_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_templates_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTemplateRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DeleteTemplateRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *DeleteTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_base_v1_templates_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_templates_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_templates_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteTemplateResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_base_v1_templates_proto protoreflect.FileDescriptor
+
+const file_base_v1_templates_proto_rawDesc = "" +
+	"\n" +
+	"\x17base/v1/templates.proto\x12\ftaskboard.v1\x1a\x14base/v1/common.proto\"9\n" +
+	"\rTemplateLabel\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\"\xbf\x01\n" +
+	"\rBoardTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\acolumns\x18\x05 \x03(\tR\acolumns\x123\n" +
+	"\x06labels\x18\x06 \x03(\v2\x1b.taskboard.v1.TemplateLabelR\x06labels\"\xb2\x01\n" +
+	"\x15CreateTemplateRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x19\n" +
+	"\bboard_id\x18\x03 \x01(\tR\aboardId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x81\x01\n" +
+	"\x16CreateTemplateResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x127\n" +
+	"\btemplate\x18\x02 \x01(\v2\x1b.taskboard.v1.BoardTemplateR\btemplate\"`\n" +
+	"\x14ListTemplatesRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\"\x82\x01\n" +
+	"\x15ListTemplatesResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x129\n" +
+	"\ttemplates\x18\x02 \x03(\v2\x1b.taskboard.v1.BoardTemplateR\ttemplates\"\x82\x01\n" +
+	"\x15DeleteTemplateRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\tR\n" +
+	"templateId\"H\n" +
+	"\x16DeleteTemplateResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base2\xa6\x02\n" +
+	"\x10TemplatesService\x12[\n" +
+	"\x0eCreateTemplate\x12#.taskboard.v1.CreateTemplateRequest\x1a$.taskboard.v1.CreateTemplateResponse\x12X\n" +
+	"\rListTemplates\x12\".taskboard.v1.ListTemplatesRequest\x1a#.taskboard.v1.ListTemplatesResponse\x12[\n" +
+	"\x0eDeleteTemplate\x12#.taskboard.v1.DeleteTemplateRequest\x1a$.taskboard.v1.DeleteTemplateResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
+
+var (
+	file_base_v1_templates_proto_rawDescOnce sync.Once
+	file_base_v1_templates_proto_rawDescData []byte
+)
+
+func file_base_v1_templates_proto_rawDescGZIP() []byte {
+	file_base_v1_templates_proto_rawDescOnce.Do(func() {
+		file_base_v1_templates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_base_v1_templates_proto_rawDesc), len(file_base_v1_templates_proto_rawDesc)))
+	})
+	return file_base_v1_templates_proto_rawDescData
+}
+
+var file_base_v1_templates_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_base_v1_templates_proto_goTypes = []any{
+	(*TemplateLabel)(nil),          // 0: taskboard.v1.TemplateLabel
+	(*BoardTemplate)(nil),          // 1: taskboard.v1.BoardTemplate
+	(*CreateTemplateRequest)(nil),  // 2: taskboard.v1.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil), // 3: taskboard.v1.CreateTemplateResponse
+	(*ListTemplatesRequest)(nil),   // 4: taskboard.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),  // 5: taskboard.v1.ListTemplatesResponse
+	(*DeleteTemplateRequest)(nil),  // 6: taskboard.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil), // 7: taskboard.v1.DeleteTemplateResponse
+	(*BaseRequest)(nil),            // 8: taskboard.v1.BaseRequest
+	(*BaseResponse)(nil),           // 9: taskboard.v1.BaseResponse
+}
+var file_base_v1_templates_proto_depIdxs = []int32{
+	0,  // 0: taskboard.v1.BoardTemplate.labels:type_name -> taskboard.v1.TemplateLabel
+	8,  // 1: taskboard.v1.CreateTemplateRequest.base:type_name -> taskboard.v1.BaseRequest
+	9,  // 2: taskboard.v1.CreateTemplateResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 3: taskboard.v1.CreateTemplateResponse.template:type_name -> taskboard.v1.BoardTemplate
+	8,  // 4: taskboard.v1.ListTemplatesRequest.base:type_name -> taskboard.v1.BaseRequest
+	9,  // 5: taskboard.v1.ListTemplatesResponse.base:type_name -> taskboard.v1.BaseResponse
+	1,  // 6: taskboard.v1.ListTemplatesResponse.templates:type_name -> taskboard.v1.BoardTemplate
+	8,  // 7: taskboard.v1.DeleteTemplateRequest.base:type_name -> taskboard.v1.BaseRequest
+	9,  // 8: taskboard.v1.DeleteTemplateResponse.base:type_name -> taskboard.v1.BaseResponse
+	2,  // 9: taskboard.v1.TemplatesService.CreateTemplate:input_type -> taskboard.v1.CreateTemplateRequest
+	4,  // 10: taskboard.v1.TemplatesService.ListTemplates:input_type -> taskboard.v1.ListTemplatesRequest
+	6,  // 11: taskboard.v1.TemplatesService.DeleteTemplate:input_type -> taskboard.v1.DeleteTemplateRequest
+	3,  // 12: taskboard.v1.TemplatesService.CreateTemplate:output_type -> taskboard.v1.CreateTemplateResponse
+	5,  // 13: taskboard.v1.TemplatesService.ListTemplates:output_type -> taskboard.v1.ListTemplatesResponse
+	7,  // 14: taskboard.v1.TemplatesService.DeleteTemplate:output_type -> taskboard.v1.DeleteTemplateResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_base_v1_templates_proto_init() }
+func file_base_v1_templates_proto_init() {
+	if File_base_v1_templates_proto != nil {
+		return
+	}
+	file_base_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_base_v1_templates_proto_rawDesc), len(file_base_v1_templates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_base_v1_templates_proto_goTypes,
+		DependencyIndexes: file_base_v1_templates_proto_depIdxs,
+		MessageInfos:      file_base_v1_templates_proto_msgTypes,
+	}.Build()
+	File_base_v1_templates_proto = out.File
+	file_base_v1_templates_proto_goTypes = nil
+	file_base_v1_templates_proto_depIdxs = nil
+}
@@ -0,0 +1,746 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.2
+// source: base/v1/watchers.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskRequest) Reset() {
+	*x = WatchTaskRequest{}
+	mi := &file_base_v1_watchers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskRequest) ProtoMessage() {}
+
+func (x *WatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*WatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchTaskRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *WatchTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type WatchTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskResponse) Reset() {
+	*x = WatchTaskResponse{}
+	mi := &file_base_v1_watchers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskResponse) ProtoMessage() {}
+
+func (x *WatchTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskResponse.ProtoReflect.Descriptor instead.
+func (*WatchTaskResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchTaskResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type UnwatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchTaskRequest) Reset() {
+	*x = UnwatchTaskRequest{}
+	mi := &file_base_v1_watchers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchTaskRequest) ProtoMessage() {}
+
+func (x *UnwatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnwatchTaskRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnwatchTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type UnwatchTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchTaskResponse) Reset() {
+	*x = UnwatchTaskResponse{}
+	mi := &file_base_v1_watchers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchTaskResponse) ProtoMessage() {}
+
+func (x *UnwatchTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchTaskResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnwatchTaskResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListTaskWatchersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskWatchersRequest) Reset() {
+	*x = ListTaskWatchersRequest{}
+	mi := &file_base_v1_watchers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskWatchersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskWatchersRequest) ProtoMessage() {}
+
+func (x *ListTaskWatchersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskWatchersRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskWatchersRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTaskWatchersRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTaskWatchersRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type ListTaskWatchersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // включая подписчиков доски
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskWatchersResponse) Reset() {
+	*x = ListTaskWatchersResponse{}
+	mi := &file_base_v1_watchers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskWatchersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskWatchersResponse) ProtoMessage() {}
+
+func (x *ListTaskWatchersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskWatchersResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskWatchersResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTaskWatchersResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListTaskWatchersResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type WatchBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBoardRequest) Reset() {
+	*x = WatchBoardRequest{}
+	mi := &file_base_v1_watchers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBoardRequest) ProtoMessage() {}
+
+func (x *WatchBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBoardRequest.ProtoReflect.Descriptor instead.
+func (*WatchBoardRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchBoardRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *WatchBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+type WatchBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBoardResponse) Reset() {
+	*x = WatchBoardResponse{}
+	mi := &file_base_v1_watchers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBoardResponse) ProtoMessage() {}
+
+func (x *WatchBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBoardResponse.ProtoReflect.Descriptor instead.
+func (*WatchBoardResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchBoardResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type UnwatchBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchBoardRequest) Reset() {
+	*x = UnwatchBoardRequest{}
+	mi := &file_base_v1_watchers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchBoardRequest) ProtoMessage() {}
+
+func (x *UnwatchBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchBoardRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchBoardRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnwatchBoardRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *UnwatchBoardRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+type UnwatchBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchBoardResponse) Reset() {
+	*x = UnwatchBoardResponse{}
+	mi := &file_base_v1_watchers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchBoardResponse) ProtoMessage() {}
+
+func (x *UnwatchBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchBoardResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchBoardResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnwatchBoardResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListBoardWatchersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseRequest           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BoardId       string                 `protobuf:"bytes,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardWatchersRequest) Reset() {
+	*x = ListBoardWatchersRequest{}
+	mi := &file_base_v1_watchers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardWatchersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardWatchersRequest) ProtoMessage() {}
+
+func (x *ListBoardWatchersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardWatchersRequest.ProtoReflect.Descriptor instead.
+func (*ListBoardWatchersRequest) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListBoardWatchersRequest) GetBase() *BaseRequest {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListBoardWatchersRequest) GetBoardId() string {
+	if x != nil {
+		return x.BoardId
+	}
+	return ""
+}
+
+type ListBoardWatchersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardWatchersResponse) Reset() {
+	*x = ListBoardWatchersResponse{}
+	mi := &file_base_v1_watchers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardWatchersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardWatchersResponse) ProtoMessage() {}
+
+func (x *ListBoardWatchersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_base_v1_watchers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardWatchersResponse.ProtoReflect.Descriptor instead.
+func (*ListBoardWatchersResponse) Descriptor() ([]byte, []int) {
+	return file_base_v1_watchers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBoardWatchersResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListBoardWatchersResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+var File_base_v1_watchers_proto protoreflect.FileDescriptor
+
+const file_base_v1_watchers_proto_rawDesc = "" +
+	"\n" +
+	"\x16base/v1/watchers.proto\x12\ftaskboard.v1\x1a\x14base/v1/common.proto\"Z\n" +
+	"\x10WatchTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"C\n" +
+	"\x11WatchTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\"\\\n" +
+	"\x12UnwatchTaskRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"E\n" +
+	"\x13UnwatchTaskResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\"a\n" +
+	"\x17ListTaskWatchersRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"e\n" +
+	"\x18ListTaskWatchersResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"]\n" +
+	"\x11WatchBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\"D\n" +
+	"\x12WatchBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\"_\n" +
+	"\x13UnwatchBoardRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\"F\n" +
+	"\x14UnwatchBoardResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\"d\n" +
+	"\x18ListBoardWatchersRequest\x12-\n" +
+	"\x04base\x18\x01 \x01(\v2\x19.taskboard.v1.BaseRequestR\x04base\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\tR\aboardId\"f\n" +
+	"\x19ListBoardWatchersResponse\x12.\n" +
+	"\x04base\x18\x01 \x01(\v2\x1a.taskboard.v1.BaseResponseR\x04base\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds2\xa4\x04\n" +
+	"\x0fWatchersService\x12L\n" +
+	"\tWatchTask\x12\x1e.taskboard.v1.WatchTaskRequest\x1a\x1f.taskboard.v1.WatchTaskResponse\x12R\n" +
+	"\vUnwatchTask\x12 .taskboard.v1.UnwatchTaskRequest\x1a!.taskboard.v1.UnwatchTaskResponse\x12a\n" +
+	"\x10ListTaskWatchers\x12%.taskboard.v1.ListTaskWatchersRequest\x1a&.taskboard.v1.ListTaskWatchersResponse\x12O\n" +
+	"\n" +
+	"WatchBoard\x12\x1f.taskboard.v1.WatchBoardRequest\x1a .taskboard.v1.WatchBoardResponse\x12U\n" +
+	"\fUnwatchBoard\x12!.taskboard.v1.UnwatchBoardRequest\x1a\".taskboard.v1.UnwatchBoardResponse\x12d\n" +
+	"\x11ListBoardWatchers\x12&.taskboard.v1.ListBoardWatchersRequest\x1a'.taskboard.v1.ListBoardWatchersResponseB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
+
+var (
+	file_base_v1_watchers_proto_rawDescOnce sync.Once
+	file_base_v1_watchers_proto_rawDescData []byte
+)
+
+func file_base_v1_watchers_proto_rawDescGZIP() []byte {
+	file_base_v1_watchers_proto_rawDescOnce.Do(func() {
+		file_base_v1_watchers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_base_v1_watchers_proto_rawDesc), len(file_base_v1_watchers_proto_rawDesc)))
+	})
+	return file_base_v1_watchers_proto_rawDescData
+}
+
+var file_base_v1_watchers_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_base_v1_watchers_proto_goTypes = []any{
+	(*WatchTaskRequest)(nil),          // 0: taskboard.v1.WatchTaskRequest
+	(*WatchTaskResponse)(nil),         // 1: taskboard.v1.WatchTaskResponse
+	(*UnwatchTaskRequest)(nil),        // 2: taskboard.v1.UnwatchTaskRequest
+	(*UnwatchTaskResponse)(nil),       // 3: taskboard.v1.UnwatchTaskResponse
+	(*ListTaskWatchersRequest)(nil),   // 4: taskboard.v1.ListTaskWatchersRequest
+	(*ListTaskWatchersResponse)(nil),  // 5: taskboard.v1.ListTaskWatchersResponse
+	(*WatchBoardRequest)(nil),         // 6: taskboard.v1.WatchBoardRequest
+	(*WatchBoardResponse)(nil),        // 7: taskboard.v1.WatchBoardResponse
+	(*UnwatchBoardRequest)(nil),       // 8: taskboard.v1.UnwatchBoardRequest
+	(*UnwatchBoardResponse)(nil),      // 9: taskboard.v1.UnwatchBoardResponse
+	(*ListBoardWatchersRequest)(nil),  // 10: taskboard.v1.ListBoardWatchersRequest
+	(*ListBoardWatchersResponse)(nil), // 11: taskboard.v1.ListBoardWatchersResponse
+	(*BaseRequest)(nil),               // 12: taskboard.v1.BaseRequest
+	(*BaseResponse)(nil),              // 13: taskboard.v1.BaseResponse
+}
+var file_base_v1_watchers_proto_depIdxs = []int32{
+	12, // 0: taskboard.v1.WatchTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	13, // 1: taskboard.v1.WatchTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	12, // 2: taskboard.v1.UnwatchTaskRequest.base:type_name -> taskboard.v1.BaseRequest
+	13, // 3: taskboard.v1.UnwatchTaskResponse.base:type_name -> taskboard.v1.BaseResponse
+	12, // 4: taskboard.v1.ListTaskWatchersRequest.base:type_name -> taskboard.v1.BaseRequest
+	13, // 5: taskboard.v1.ListTaskWatchersResponse.base:type_name -> taskboard.v1.BaseResponse
+	12, // 6: taskboard.v1.WatchBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	13, // 7: taskboard.v1.WatchBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	12, // 8: taskboard.v1.UnwatchBoardRequest.base:type_name -> taskboard.v1.BaseRequest
+	13, // 9: taskboard.v1.UnwatchBoardResponse.base:type_name -> taskboard.v1.BaseResponse
+	12, // 10: taskboard.v1.ListBoardWatchersRequest.base:type_name -> taskboard.v1.BaseRequest
+	13, // 11: taskboard.v1.ListBoardWatchersResponse.base:type_name -> taskboard.v1.BaseResponse
+	0,  // 12: taskboard.v1.WatchersService.WatchTask:input_type -> taskboard.v1.WatchTaskRequest
+	2,  // 13: taskboard.v1.WatchersService.UnwatchTask:input_type -> taskboard.v1.UnwatchTaskRequest
+	4,  // 14: taskboard.v1.WatchersService.ListTaskWatchers:input_type -> taskboard.v1.ListTaskWatchersRequest
+	6,  // 15: taskboard.v1.WatchersService.WatchBoard:input_type -> taskboard.v1.WatchBoardRequest
+	8,  // 16: taskboard.v1.WatchersService.UnwatchBoard:input_type -> taskboard.v1.UnwatchBoardRequest
+	10, // 17: taskboard.v1.WatchersService.ListBoardWatchers:input_type -> taskboard.v1.ListBoardWatchersRequest
+	1,  // 18: taskboard.v1.WatchersService.WatchTask:output_type -> taskboard.v1.WatchTaskResponse
+	3,  // 19: taskboard.v1.WatchersService.UnwatchTask:output_type -> taskboard.v1.UnwatchTaskResponse
+	5,  // 20: taskboard.v1.WatchersService.ListTaskWatchers:output_type -> taskboard.v1.ListTaskWatchersResponse
+	7,  // 21: taskboard.v1.WatchersService.WatchBoard:output_type -> taskboard.v1.WatchBoardResponse
+	9,  // 22: taskboard.v1.WatchersService.UnwatchBoard:output_type -> taskboard.v1.UnwatchBoardResponse
+	11, // 23: taskboard.v1.WatchersService.ListBoardWatchers:output_type -> taskboard.v1.ListBoardWatchersResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_base_v1_watchers_proto_init() }
+func file_base_v1_watchers_proto_init() {
+	if File_base_v1_watchers_proto != nil {
+		return
+	}
+	file_base_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_base_v1_watchers_proto_rawDesc), len(file_base_v1_watchers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_base_v1_watchers_proto_goTypes,
+		DependencyIndexes: file_base_v1_watchers_proto_depIdxs,
+		MessageInfos:      file_base_v1_watchers_proto_msgTypes,
+	}.Build()
+	File_base_v1_watchers_proto = out.File
+	file_base_v1_watchers_proto_goTypes = nil
+	file_base_v1_watchers_proto_depIdxs = nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: base/v1/watchers.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WatchersService_WatchTask_FullMethodName         = "/taskboard.v1.WatchersService/WatchTask"
+	WatchersService_UnwatchTask_FullMethodName       = "/taskboard.v1.WatchersService/UnwatchTask"
+	WatchersService_ListTaskWatchers_FullMethodName  = "/taskboard.v1.WatchersService/ListTaskWatchers"
+	WatchersService_WatchBoard_FullMethodName        = "/taskboard.v1.WatchersService/WatchBoard"
+	WatchersService_UnwatchBoard_FullMethodName      = "/taskboard.v1.WatchersService/UnwatchBoard"
+	WatchersService_ListBoardWatchers_FullMethodName = "/taskboard.v1.WatchersService/ListBoardWatchers"
+)
+
+// WatchersServiceClient is the client API for WatchersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WatchersServiceClient interface {
+	WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (*WatchTaskResponse, error)
+	UnwatchTask(ctx context.Context, in *UnwatchTaskRequest, opts ...grpc.CallOption) (*UnwatchTaskResponse, error)
+	ListTaskWatchers(ctx context.Context, in *ListTaskWatchersRequest, opts ...grpc.CallOption) (*ListTaskWatchersResponse, error)
+	WatchBoard(ctx context.Context, in *WatchBoardRequest, opts ...grpc.CallOption) (*WatchBoardResponse, error)
+	UnwatchBoard(ctx context.Context, in *UnwatchBoardRequest, opts ...grpc.CallOption) (*UnwatchBoardResponse, error)
+	ListBoardWatchers(ctx context.Context, in *ListBoardWatchersRequest, opts ...grpc.CallOption) (*ListBoardWatchersResponse, error)
+}
+
+type watchersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchersServiceClient(cc grpc.ClientConnInterface) WatchersServiceClient {
+	return &watchersServiceClient{cc}
+}
+
+func (c *watchersServiceClient) WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (*WatchTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchTaskResponse)
+	err := c.cc.Invoke(ctx, WatchersService_WatchTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchersServiceClient) UnwatchTask(ctx context.Context, in *UnwatchTaskRequest, opts ...grpc.CallOption) (*UnwatchTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnwatchTaskResponse)
+	err := c.cc.Invoke(ctx, WatchersService_UnwatchTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchersServiceClient) ListTaskWatchers(ctx context.Context, in *ListTaskWatchersRequest, opts ...grpc.CallOption) (*ListTaskWatchersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskWatchersResponse)
+	err := c.cc.Invoke(ctx, WatchersService_ListTaskWatchers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchersServiceClient) WatchBoard(ctx context.Context, in *WatchBoardRequest, opts ...grpc.CallOption) (*WatchBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchBoardResponse)
+	err := c.cc.Invoke(ctx, WatchersService_WatchBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchersServiceClient) UnwatchBoard(ctx context.Context, in *UnwatchBoardRequest, opts ...grpc.CallOption) (*UnwatchBoardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnwatchBoardResponse)
+	err := c.cc.Invoke(ctx, WatchersService_UnwatchBoard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchersServiceClient) ListBoardWatchers(ctx context.Context, in *ListBoardWatchersRequest, opts ...grpc.CallOption) (*ListBoardWatchersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBoardWatchersResponse)
+	err := c.cc.Invoke(ctx, WatchersService_ListBoardWatchers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WatchersServiceServer is the server API for WatchersService service.
+// All implementations must embed UnimplementedWatchersServiceServer
+// for forward compatibility.
+type WatchersServiceServer interface {
+	WatchTask(context.Context, *WatchTaskRequest) (*WatchTaskResponse, error)
+	UnwatchTask(context.Context, *UnwatchTaskRequest) (*UnwatchTaskResponse, error)
+	ListTaskWatchers(context.Context, *ListTaskWatchersRequest) (*ListTaskWatchersResponse, error)
+	WatchBoard(context.Context, *WatchBoardRequest) (*WatchBoardResponse, error)
+	UnwatchBoard(context.Context, *UnwatchBoardRequest) (*UnwatchBoardResponse, error)
+	ListBoardWatchers(context.Context, *ListBoardWatchersRequest) (*ListBoardWatchersResponse, error)
+	mustEmbedUnimplementedWatchersServiceServer()
+}
+
+// UnimplementedWatchersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWatchersServiceServer struct{}
+
+func (UnimplementedWatchersServiceServer) WatchTask(context.Context, *WatchTaskRequest) (*WatchTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WatchTask not implemented")
+}
+func (UnimplementedWatchersServiceServer) UnwatchTask(context.Context, *UnwatchTaskRequest) (*UnwatchTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnwatchTask not implemented")
+}
+func (UnimplementedWatchersServiceServer) ListTaskWatchers(context.Context, *ListTaskWatchersRequest) (*ListTaskWatchersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaskWatchers not implemented")
+}
+func (UnimplementedWatchersServiceServer) WatchBoard(context.Context, *WatchBoardRequest) (*WatchBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WatchBoard not implemented")
+}
+func (UnimplementedWatchersServiceServer) UnwatchBoard(context.Context, *UnwatchBoardRequest) (*UnwatchBoardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnwatchBoard not implemented")
+}
+func (UnimplementedWatchersServiceServer) ListBoardWatchers(context.Context, *ListBoardWatchersRequest) (*ListBoardWatchersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBoardWatchers not implemented")
+}
+func (UnimplementedWatchersServiceServer) mustEmbedUnimplementedWatchersServiceServer() {}
+func (UnimplementedWatchersServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeWatchersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchersServiceServer will
+// result in compilation errors.
+type UnsafeWatchersServiceServer interface {
+	mustEmbedUnimplementedWatchersServiceServer()
+}
+
+func RegisterWatchersServiceServer(s grpc.ServiceRegistrar, srv WatchersServiceServer) {
+	// If the following call panics, it indicates UnimplementedWatchersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WatchersService_ServiceDesc, srv)
+}
+
+func _WatchersService_WatchTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchersServiceServer).WatchTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchersService_WatchTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchersServiceServer).WatchTask(ctx, req.(*WatchTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchersService_UnwatchTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchersServiceServer).UnwatchTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchersService_UnwatchTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchersServiceServer).UnwatchTask(ctx, req.(*UnwatchTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchersService_ListTaskWatchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskWatchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchersServiceServer).ListTaskWatchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchersService_ListTaskWatchers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchersServiceServer).ListTaskWatchers(ctx, req.(*ListTaskWatchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchersService_WatchBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchersServiceServer).WatchBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchersService_WatchBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchersServiceServer).WatchBoard(ctx, req.(*WatchBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchersService_UnwatchBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwatchBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchersServiceServer).UnwatchBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchersService_UnwatchBoard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchersServiceServer).UnwatchBoard(ctx, req.(*UnwatchBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchersService_ListBoardWatchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardWatchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchersServiceServer).ListBoardWatchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchersService_ListBoardWatchers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchersServiceServer).ListBoardWatchers(ctx, req.(*ListBoardWatchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WatchersService_ServiceDesc is the grpc.ServiceDesc for WatchersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatchersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "taskboard.v1.WatchersService",
+	HandlerType: (*WatchersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WatchTask",
+			Handler:    _WatchersService_WatchTask_Handler,
+		},
+		{
+			MethodName: "UnwatchTask",
+			Handler:    _WatchersService_UnwatchTask_Handler,
+		},
+		{
+			MethodName: "ListTaskWatchers",
+			Handler:    _WatchersService_ListTaskWatchers_Handler,
+		},
+		{
+			MethodName: "WatchBoard",
+			Handler:    _WatchersService_WatchBoard_Handler,
+		},
+		{
+			MethodName: "UnwatchBoard",
+			Handler:    _WatchersService_UnwatchBoard_Handler,
+		},
+		{
+			MethodName: "ListBoardWatchers",
+			Handler:    _WatchersService_ListBoardWatchers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "base/v1/watchers.proto",
+}
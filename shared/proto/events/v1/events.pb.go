@@ -328,6 +328,7 @@ type TaskMoved struct {
 	Snapshot      *TaskSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 	ToColumnId    int64                  `protobuf:"varint,2,opt,name=to_column_id,json=toColumnId,proto3" json:"to_column_id,omitempty"`
 	ToBoardId     int64                  `protobuf:"varint,3,opt,name=to_board_id,json=toBoardId,proto3" json:"to_board_id,omitempty"`
+	FromBoardId   int64                  `protobuf:"varint,4,opt,name=from_board_id,json=fromBoardId,proto3" json:"from_board_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -383,6 +384,13 @@ func (x *TaskMoved) GetToBoardId() int64 {
 	return 0
 }
 
+func (x *TaskMoved) GetFromBoardId() int64 {
+	if x != nil {
+		return x.FromBoardId
+	}
+	return 0
+}
+
 var File_events_v1_events_proto protoreflect.FileDescriptor
 
 const file_events_v1_events_proto_rawDesc = "" +
@@ -410,12 +418,13 @@ const file_events_v1_events_proto_rawDesc = "" +
 	"\vTaskCreated\x126\n" +
 	"\bsnapshot\x18\x01 \x01(\v2\x1a.taskboard.v1.TaskSnapshotR\bsnapshot\"E\n" +
 	"\vTaskUpdated\x126\n" +
-	"\bsnapshot\x18\x01 \x01(\v2\x1a.taskboard.v1.TaskSnapshotR\bsnapshot\"\x85\x01\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1a.taskboard.v1.TaskSnapshotR\bsnapshot\"\xa9\x01\n" +
 	"\tTaskMoved\x126\n" +
 	"\bsnapshot\x18\x01 \x01(\v2\x1a.taskboard.v1.TaskSnapshotR\bsnapshot\x12 \n" +
 	"\fto_column_id\x18\x02 \x01(\x03R\n" +
 	"toColumnId\x12\x1e\n" +
-	"\vto_board_id\x18\x03 \x01(\x03R\ttoBoardIdB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
+	"\vto_board_id\x18\x03 \x01(\x03R\ttoBoardId\x12\"\n" +
+	"\rfrom_board_id\x18\x04 \x01(\x03R\vfromBoardIdB7Z5github.com/smarrog/task-board/shared/proto/base/v1;v1b\x06proto3"
 
 var (
 	file_events_v1_events_proto_rawDescOnce sync.Once